@@ -2,6 +2,8 @@ package delivery_auth_grpc
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
@@ -9,7 +11,12 @@ import (
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcserver"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
+	"github.com/go-redis/redis/v8"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
 )
@@ -23,10 +30,11 @@ type server struct {
 	pb.UnimplementedAuthorizationServer
 	userRepo    *profile.RepoPostgre
 	sessionRepo *session.SessionRepo
+	keys        *token.KeyRing
 	lg          *slog.Logger
 }
 
-func NewServer(l *slog.Logger) (*authGrpc, error) {
+func NewServer(l *slog.Logger, keys *token.KeyRing) (*authGrpc, error) {
 	config, err := configs.ReadConfig()
 	if err != nil {
 		l.Error("read config error", "err", err.Error())
@@ -52,26 +60,34 @@ func NewServer(l *slog.Logger) (*authGrpc, error) {
 		return nil, fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
-	s := grpc.NewServer()
+	s := grpcserver.NewServer(l, grpcserver.Internal{Secret: config.GrpcClient.Secret})
 	pb.RegisterAuthorizationServer(s, &server{
 		lg:          l,
 		sessionRepo: session,
 		userRepo:    users,
+		keys:        keys,
 	})
 
 	return &authGrpc{grpcServ: s, lg: l}, nil
 }
 
 func (s *server) GetId(ctx context.Context, req *pb.FindIdRequest) (*pb.FindIdResponse, error) {
-	login, err := s.sessionRepo.GetUserLogin(ctx, req.Sid, s.lg)
+	login, err := s.sessionRepo.GetUserLogin(ctx, req.Sid, grpcserver.Logger(ctx, s.lg))
+	if errors.Is(err, redis.Nil) || (err == nil && login == "") {
+		return nil, status.Error(codes.Unauthenticated, "session not found")
+	}
 	if err != nil {
-		return nil, err
+		grpcserver.Logger(ctx, s.lg).Error("failed get user login", "err", err.Error())
+		return nil, status.Error(codes.Internal, "get user login error")
 	}
 
 	id, err := s.userRepo.GetUserProfileId(login)
+	if errors.Is(err, profile.ErrUserNotFound) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
-		s.lg.Error("failed get user profile id: %v", err)
-		return nil, err
+		grpcserver.Logger(ctx, s.lg).Error("failed get user profile id", "err", err.Error())
+		return nil, status.Error(codes.Internal, "get user profile id error")
 	}
 	return &pb.FindIdResponse{
 		Value: id,
@@ -81,8 +97,8 @@ func (s *server) GetId(ctx context.Context, req *pb.FindIdRequest) (*pb.FindIdRe
 func (s *server) GetIdsAndPaths(ctx context.Context, req *pb.NamesAndPathsListRequest) (*pb.NamesAndPathsResponse, error) {
 	names, paths, err := s.userRepo.GetNamesAndPaths(req.Ids)
 	if err != nil {
-		s.lg.Error("failed get users ids and photo: %v", err)
-		return nil, err
+		grpcserver.Logger(ctx, s.lg).Error("failed get users ids and photo", "err", err.Error())
+		return nil, status.Error(codes.Internal, "get names and paths error")
 	}
 	return &pb.NamesAndPathsResponse{
 		Names: names,
@@ -91,43 +107,110 @@ func (s *server) GetIdsAndPaths(ctx context.Context, req *pb.NamesAndPathsListRe
 }
 
 func (s *server) GetAuthorizationStatus(ctx context.Context, req *pb.AuthorizationCheckRequest) (*pb.AuthorizationCheckResponse, error) {
-	status, err := s.sessionRepo.CheckActiveSession(ctx, req.Sid, s.lg)
+	active, err := s.sessionRepo.CheckActiveSession(ctx, req.Sid, grpcserver.Logger(ctx, s.lg))
 	if err != nil {
-		s.lg.Error("failed to check auth status: %v", err)
-		return nil, err
+		grpcserver.Logger(ctx, s.lg).Error("failed to check auth status", "err", err.Error())
+		return nil, status.Error(codes.Internal, "check auth status error")
 	}
 	return &pb.AuthorizationCheckResponse{
-		Status: status,
+		Status: active,
 	}, nil
 }
 
 func (s *server) GetRole(ctx context.Context, req *pb.RoleRequest) (*pb.RoleResponse, error) {
-    role, err := s.userRepo.GetUserRole(req.Login)
+	role, err := s.userRepo.GetUserRole(req.Login)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
-		s.lg.Error("failed to get user role: %v", err)
-		return nil, err
+		grpcserver.Logger(ctx, s.lg).Error("failed to get user role", "err", err.Error())
+		return nil, status.Error(codes.Internal, "get user role error")
 	}
 
 	return &pb.RoleResponse{
 		Role: role,
-	},nil
+	}, nil
+}
+
+// GetSigningKeys publishes the public keys access tokens are signed with,
+// including the keys kept after a rotation.
+func (s *server) GetSigningKeys(ctx context.Context, req *pb.SigningKeysRequest) (*pb.SigningKeysResponse, error) {
+	keys := make([]*pb.SigningKey, 0, len(s.keys.Keys))
+	for _, key := range s.keys.Keys {
+		keys = append(keys, &pb.SigningKey{
+			Kid:       key.Kid,
+			Alg:       token.Algorithm,
+			PublicKey: key.Public,
+		})
+	}
+
+	return &pb.SigningKeysResponse{Keys: keys}, nil
+}
+
+// CheckPermission reports whether the role of the user grants the
+// permission. The user is given by login or, if login is empty, by id.
+func (s *server) CheckPermission(ctx context.Context, req *pb.PermissionRequest) (*pb.PermissionResponse, error) {
+	login := req.Login
+	if login == "" {
+		var found bool
+		var err error
+		login, found, err = s.userRepo.GetUserLogin(req.UserId)
+		if err != nil {
+			grpcserver.Logger(ctx, s.lg).Error("failed to get user login", "err", err.Error())
+			return nil, status.Error(codes.Internal, "get user login error")
+		}
+		if !found {
+			return &pb.PermissionResponse{Allowed: false}, nil
+		}
+	}
+
+	allowed, err := s.userRepo.HasPermission(login, req.Permission)
+	if err != nil {
+		grpcserver.Logger(ctx, s.lg).Error("failed to check permission", "err", err.Error())
+		return nil, status.Error(codes.Internal, "check permission error")
+	}
+
+	return &pb.PermissionResponse{Allowed: allowed}, nil
+}
+
+// GetUsers returns the public data of the users keyed by id, so that
+// callers do not depend on the order of the answer.
+func (s *server) GetUsers(ctx context.Context, req *pb.UsersRequest) (*pb.UsersResponse, error) {
+	users, err := s.userRepo.GetUsersByIds(req.Ids)
+	if err != nil {
+		grpcserver.Logger(ctx, s.lg).Error("failed to get users", "err", err.Error())
+		return nil, status.Error(codes.Internal, "get users error")
+	}
+
+	response := &pb.UsersResponse{Users: make(map[uint64]*pb.User, len(users))}
+	for _, user := range users {
+		response.Users[user.Id] = &pb.User{
+			Id:    user.Id,
+			Login: user.Login,
+			Name:  user.Name,
+			Photo: user.Photo,
+			Role:  user.Role,
+		}
+	}
+
+	return response, nil
 }
 
 func (s *authGrpc) ListenAndServeGrpc() error {
 	grpcConfig, err := configs.ReadGrpcConfig()
 	if err != nil {
-		s.lg.Error("failed to parse grpc config file: %v", err)
+		s.lg.Error("failed to parse grpc config file", "err", err.Error())
 		return fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
 	lis, err := net.Listen(grpcConfig.ConnectionType, ":"+grpcConfig.Port)
 	if err != nil {
-		s.lg.Error("failed to listen: %v", err)
+		s.lg.Error("failed to listen", "err", err.Error())
 		return fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
 	if err := s.grpcServ.Serve(lis); err != nil {
-		s.lg.Error("failed to serve: %v", err)
+		s.lg.Error("failed to serve", "err", err.Error())
 		return fmt.Errorf("listen and serve grpc error: %w", err)
 	}
 
@@ -0,0 +1,126 @@
+package delivery
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/mailru/easyjson"
+)
+
+// ExportAccount returns everything the services keep about the user.
+func (a *API) ExportAccount(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	export, err := a.core.ExportAccount(r.Context(), login)
+	if err != nil {
+		a.lg.Error("export account error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="account.json"`)
+	response.Body = export
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// DeleteAccount deletes the user everywhere. The password or a 2FA code is
+// asked again, unless the session was just created, so that a stolen
+// session is not enough to do it.
+func (a *API) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.DeleteAccountRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	retryAfter, err := a.core.DeleteAccount(r.Context(), login, session.Value, request.Password, request.Code, a.clientIP(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTooManyAttempts):
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+		case errors.Is(err, usecase.ErrWrongPassword), errors.Is(err, usecase.ErrInvalidCode), errors.Is(err, usecase.ErrReauthRequired):
+			response.Status = http.StatusForbidden
+		default:
+			a.lg.Error("delete account error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session.Expires = time.Now().AddDate(0, 0, -1)
+	http.SetCookie(w, session)
+	http.SetCookie(w, &http.Cookie{
+		Name:    "access_token",
+		Path:    "/",
+		Expires: time.Now().AddDate(0, 0, -1),
+	})
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
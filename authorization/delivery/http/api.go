@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/rbac"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/mailru/easyjson"
 
@@ -27,14 +32,17 @@ type IApi interface {
 }
 
 type API struct {
-	core  usecase.ICore
+	core usecase.ICore
 	lg   *slog.Logger
 	ct   *requests.Collector
 	mx   *http.ServeMux
+	// proxies are the peers allowed to pass the client address in
+	// X-Real-IP.
+	proxies []*net.IPNet
 }
 
 func (a *API) ListenAndServe() error {
-	err := http.ListenAndServe(":8081", a.mx)
+	err := http.ListenAndServe(":8081", middleware.RequestID(a.mx))
 	if err != nil {
 		a.lg.Error("ListenAndServe error", "err", err.Error())
 		return fmt.Errorf("listen and serve error: %w", err)
@@ -43,17 +51,19 @@ func (a *API) ListenAndServe() error {
 	return nil
 }
 
-func GetApi(c *usecase.Core, l *slog.Logger) *API {
+func GetApi(c *usecase.Core, l *slog.Logger, cfg configs.LimiterCfg) *API {
 	api := &API{
-		core: c,
-		lg:   l.With("module", "api"),
-		ct:   requests.GetCollector(),
-		mx:   http.NewServeMux(),
+		core:    c,
+		lg:      l.With("module", "api"),
+		ct:      requests.GetCollector(),
+		mx:      http.NewServeMux(),
+		proxies: parseProxies(cfg.TrustedProxies, l),
 	}
 
 	api.mx.Handle("/metrics", promhttp.Handler())
 	api.mx.HandleFunc("/signin", api.Signin)
 	api.mx.HandleFunc("/signup", api.Signup)
+	api.mx.HandleFunc("/signin/mfa", api.SigninMfa)
 	api.mx.HandleFunc("/logout", api.LogoutSession)
 	api.mx.HandleFunc("/authcheck", api.AuthAccept)
 	api.mx.HandleFunc("/api/v1/csrf", api.GetCsrfToken)
@@ -62,10 +72,96 @@ func GetApi(c *usecase.Core, l *slog.Logger) *API {
 	api.mx.HandleFunc("/api/v1/user/isSubscribed", api.IsSubcribed)
 	api.mx.HandleFunc("/api/v1/users/list", api.GetUsers)
 	api.mx.HandleFunc("/api/v1/users/updateRole", api.ChangeUserRole)
+	api.mx.HandleFunc("/api/v1/sessions", api.Sessions)
+	api.mx.HandleFunc("/api/v1/sessions/revoke", api.RevokeSession)
+	api.mx.HandleFunc("/api/v1/sessions/revoke_all", api.RevokeAllSessions)
+	api.mx.HandleFunc("/api/v1/verify_email", api.VerifyEmail)
+	api.mx.HandleFunc("/api/v1/verify_email/resend", api.ResendVerificationEmail)
+	api.mx.HandleFunc("/api/v1/password/forgot", api.ForgotPassword)
+	api.mx.HandleFunc("/api/v1/password/reset", api.ResetPassword)
+	api.mx.HandleFunc("/api/v1/token/refresh", api.RefreshToken)
+	api.mx.HandleFunc("/api/v1/mfa/enroll", api.EnrollTotp)
+	api.mx.HandleFunc("/api/v1/mfa/confirm", api.ConfirmTotp)
+	api.mx.HandleFunc("/api/v1/mfa/disable", api.DisableTotp)
+	api.mx.HandleFunc("/api/v1/mfa/roles", api.SetRoleMfaRequired)
+	api.mx.HandleFunc("/api/v1/oauth/", api.OAuth)
+	api.mx.HandleFunc("/api/v1/account/export", api.ExportAccount)
+	api.mx.HandleFunc("/api/v1/account/delete", api.DeleteAccount)
 
 	return api
 }
 
+// sessionID returns the session cookie value, or an empty string for
+// anonymous requests.
+func sessionID(r *http.Request) string {
+	session, err := r.Cookie("session_id")
+	if err != nil {
+		return ""
+	}
+
+	return session.Value
+}
+
+// parseProxies parses the trusted proxies given as IPs or CIDRs. Invalid
+// entries are logged and skipped.
+func parseProxies(proxies []string, lg *slog.Logger) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			lg.Error("invalid trusted proxy", "proxy", proxy, "err", err.Error())
+			continue
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return nets
+}
+
+// clientIP returns the address of the client. The service runs behind
+// nginx, which passes the original address in X-Real-IP; the header is
+// honoured only when the request comes from a trusted proxy, otherwise any
+// client could pick the address it is rate limited by.
+func (a *API) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	peer := net.ParseIP(host)
+	if peer == nil {
+		return host
+	}
+
+	for _, proxy := range a.proxies {
+		if !proxy.Contains(peer) {
+			continue
+		}
+
+		if ip := net.ParseIP(r.Header.Get("X-Real-IP")); ip != nil {
+			return ip.String()
+		}
+		break
+	}
+
+	return host
+}
+
+// setRetryAfter sets the Retry-After header in whole seconds, rounded up.
+func setRetryAfter(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+}
+
 func (a *API) LogoutSession(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 
@@ -90,6 +186,11 @@ func (a *API) LogoutSession(w http.ResponseWriter, r *http.Request) {
 		}
 		session.Expires = time.Now().AddDate(0, 0, -1)
 		http.SetCookie(w, session)
+		http.SetCookie(w, &http.Cookie{
+			Name:    "access_token",
+			Path:    "/",
+			Expires: time.Now().AddDate(0, 0, -1),
+		})
 	}
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
@@ -143,8 +244,8 @@ func (a *API) Signin(w http.ResponseWriter, r *http.Request) {
 
 	csrfToken := r.Header.Get("x-csrf-token")
 
-	_, err := a.core.CheckCsrfToken(r.Context(), csrfToken)
-	if err != nil {
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
 		w.Header().Set("X-CSRF-Token", "null")
 		response.Status = http.StatusPreconditionFailed
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -166,6 +267,22 @@ func (a *API) Signin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ip := a.clientIP(r)
+
+	retryAfter, err := a.core.SigninRetryAfter(r.Context(), request.Login, ip)
+	if err != nil {
+		a.lg.Error("Signin limiter error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if retryAfter > 0 {
+		setRetryAfter(w, retryAfter)
+		response.Status = http.StatusTooManyRequests
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
 	user, found, err := a.core.FindUserAccount(request.Login, request.Password)
 	if err != nil {
 		a.lg.Error("Signin error", "err", err.Error())
@@ -174,23 +291,122 @@ func (a *API) Signin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if !found {
+		retryAfter, err := a.core.SigninFailed(r.Context(), request.Login, ip)
+		if err != nil {
+			a.lg.Error("Signin limiter error", "err", err.Error())
+		}
+		if retryAfter > 0 {
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		response.Status = http.StatusUnauthorized
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	} else {
-		sid, session, _ := a.core.CreateSession(r.Context(), user.Login)
-		cookie := &http.Cookie{
-			Name:     "session_id",
-			Value:    sid,
-			Path:     "/",
-			Expires:  session.ExpiresAt,
-			HttpOnly: true,
+		mfaEnabled, enrollmentRequired, err := a.core.MfaStatus(user.Login)
+		if err != nil {
+			a.lg.Error("Signin mfa error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		if mfaEnabled {
+			mfaToken, err := a.core.StartMfa(r.Context(), user.Login, request.RememberMe)
+			if err != nil {
+				a.lg.Error("Signin mfa error", "err", err.Error())
+				response.Status = http.StatusInternalServerError
+				a.ct.SendResponse(w, r, response, a.lg, start)
+				return
+			}
+
+			response.Body = requests.SigninResponse{MfaRequired: true, MfaToken: mfaToken}
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		pair, err := a.startSession(w, r, user.Login, request.RememberMe, ip)
+		if err != nil {
+			a.lg.Error("Signin session error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+
+		if enrollmentRequired || pair != nil {
+			signinResponse := requests.SigninResponse{MfaEnrollmentRequired: enrollmentRequired}
+			if pair != nil {
+				signinResponse.AccessToken = pair.Access
+				signinResponse.RefreshToken = pair.Refresh
+				signinResponse.ExpiresAt = pair.ExpiresAt.Format(time.RFC3339)
+			}
+			response.Body = signinResponse
 		}
-		http.SetCookie(w, cookie)
 	}
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// startSession creates a session for the login, sets its cookie and a CSRF
+// token bound to it, and forgets the sign in failures of the login. When
+// access tokens are enabled it also issues a token pair for the session.
+func (a *API) startSession(w http.ResponseWriter, r *http.Request, login string, remember bool, ip string) (*usecase.TokenPair, error) {
+	err := a.core.SigninSucceeded(r.Context(), login)
+	if err != nil {
+		a.lg.Error("Signin limiter error", "err", err.Error())
+	}
+
+	sid, session, err := a.core.CreateSession(r.Context(), login, r.UserAgent(), ip, remember)
+	if err != nil {
+		return nil, err
+	}
+	if sid == "" {
+		return nil, errors.New("session was not created")
+	}
+
+	// The cookie lives as long as the absolute session lifetime; the
+	// idle timeout is enforced by the session storage alone.
+	cookie := &http.Cookie{
+		Name:     "session_id",
+		Value:    sid,
+		Path:     "/",
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+	}
+	http.SetCookie(w, cookie)
+
+	token, err := a.core.CreateCsrfToken(r.Context(), sid)
+	if err != nil {
+		a.lg.Error("Signin csrf error", "err", err.Error())
+	}
+	w.Header().Set("X-CSRF-Token", token)
+
+	if !a.core.TokensEnabled() {
+		return nil, nil
+	}
+
+	pair, err := a.core.IssueTokens(r.Context(), login, sid)
+	if err != nil {
+		return nil, err
+	}
+	setAccessCookie(w, pair)
+
+	return pair, nil
+}
+
+// setAccessCookie passes the access token to the browser, so requests to
+// the other services carry it without any work on the frontend.
+func setAccessCookie(w http.ResponseWriter, pair *usecase.TokenPair) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "access_token",
+		Value:    pair.Access,
+		Path:     "/",
+		Expires:  pair.ExpiresAt,
+		HttpOnly: true,
+	})
+}
+
 func (a *API) Signup(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 
@@ -203,8 +419,8 @@ func (a *API) Signup(w http.ResponseWriter, r *http.Request) {
 
 	csrfToken := r.Header.Get("x-csrf-token")
 
-	_, err := a.core.CheckCsrfToken(r.Context(), csrfToken)
-	if err != nil {
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
 		w.Header().Set("X-CSRF-Token", "null")
 		response.Status = http.StatusPreconditionFailed
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -229,7 +445,7 @@ func (a *API) Signup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	found, err := a.core.FindUserByLogin(request.Login)
+	found, err = a.core.FindUserByLogin(request.Login)
 	if err != nil {
 		a.lg.Error("Signup error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -243,7 +459,7 @@ func (a *API) Signup(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = a.core.CreateUserAccount(request.Login, request.Password, request.Name, request.BirthDate, request.Email)
+	err = a.core.CreateUserAccount(r.Context(), request.Login, request.Password, request.Name, request.BirthDate, request.Email)
 	if err == usecase.InvalideEmail {
 		a.lg.Error("create user error", "err", err.Error())
 		response.Status = http.StatusBadRequest
@@ -262,20 +478,20 @@ func (a *API) GetCsrfToken(w http.ResponseWriter, r *http.Request) {
 
 	csrfToken := r.Header.Get("x-csrf-token")
 
-	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken)
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
 	if err != nil {
 		w.Header().Set("X-CSRF-Token", "null")
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-	if csrfToken != "" && found {
+	if found {
 		w.Header().Set("X-CSRF-Token", csrfToken)
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	token, err := a.core.CreateCsrfToken(r.Context())
+	token, err := a.core.CreateCsrfToken(r.Context(), sessionID(r))
 	if err != nil {
 		w.Header().Set("X-CSRF-Token", "null")
 		response.Status = http.StatusInternalServerError
@@ -297,26 +513,54 @@ func (a *API) GetUsers(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	login := r.URL.Query().Get("login")
-	role := r.URL.Query().Get("role")
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userName, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || userName == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
 
-	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	allowed, err := a.core.CheckPermission(userName, rbac.ReadUsers)
 	if err != nil {
-		page = 1
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	if !allowed {
+		response.Status = http.StatusForbidden
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
-	pageSize, err := strconv.ParseUint(r.URL.Query().Get("per_page"), 10, 64)
+	login := r.URL.Query().Get("login")
+	role := r.URL.Query().Get("role")
+
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 10)
 	if err != nil {
-		pageSize = 10
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
-	users, err := a.core.FindUsers(login, role, (page-1)*pageSize, pageSize)
+	users, err := a.core.FindUsers(login, role, page)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
 			a.ct.SendResponse(w, r, response, a.lg, start)
 			return
 		}
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("get users error", "err:", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -339,8 +583,8 @@ func (a *API) ChangeUserRole(w http.ResponseWriter, r *http.Request) {
 
 	csrfToken := r.Header.Get("x-csrf-token")
 
-	_, err := a.core.CheckCsrfToken(r.Context(), csrfToken)
-	if err != nil {
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
 		w.Header().Set("X-CSRF-Token", "null")
 		response.Status = http.StatusPreconditionFailed
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -380,17 +624,9 @@ func (a *API) ChangeUserRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userRole, err := a.core.GetUserRole(userName)
+	err = a.core.ChangeUsersRole(request.Login, request.Role, userName)
 	if err != nil {
-		a.lg.Error("User role not found", "err", err.Error())
-		response.Status = http.StatusInternalServerError
-		a.ct.SendResponse(w, r, response, a.lg, start)
-		return
-	}
-
-	err = a.core.ChangeUsersRole(request.Login, request.Role, userRole)
-	if err != nil {
-		if errors.Is(err, usecase.ErrNotAllowed) {
+		if errors.Is(err, usecase.ErrNotAllowed) || errors.Is(err, usecase.ErrMfaRequired) {
 			response.Status = http.StatusForbidden
 			a.ct.SendResponse(w, r, response, a.lg, start)
 			return
@@ -434,6 +670,8 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 			Login:     profile.Login,
 			Photo:     profile.Photo,
 			BirthDate: profile.Birthdate,
+
+			EmailVerified: profile.EmailVerified,
 		}
 
 		response.Body = profileResponse
@@ -490,7 +728,7 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 	if handler == nil {
 		filename = ""
 
-		err = a.core.EditProfile(prevLogin, login, password, email, birthDate, filename)
+		err = a.core.EditProfile(r.Context(), session.Value, prevLogin, login, password, email, birthDate, filename)
 		if err != nil {
 			a.lg.Error("Post profile error", "err", err.Error())
 			response.Status = http.StatusInternalServerError
@@ -527,7 +765,7 @@ func (a *API) Profile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = a.core.EditProfile(prevLogin, login, password, email, birthDate, filename)
+	err = a.core.EditProfile(r.Context(), session.Value, prevLogin, login, password, email, birthDate, filename)
 	if err != nil {
 		a.lg.Error("Post profile error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -563,6 +801,11 @@ func (a *API) SubcribePush(w http.ResponseWriter, r *http.Request) {
 
 	isSubcribed, err := a.core.Subscribe(userName)
 	if err != nil {
+		if errors.Is(err, usecase.ErrNotVerified) {
+			response.Status = http.StatusForbidden
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("subcribe push error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -610,3 +853,420 @@ func (a *API) IsSubcribed(w http.ResponseWriter, r *http.Request) {
 	response.Body = subResponse
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
+
+func (a *API) Sessions(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	sessions, err := a.core.GetSessions(r.Context(), login)
+	if err != nil {
+		a.lg.Error("get sessions error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	sessionsResponse := requests.SessionsResponse{Sessions: make([]requests.SessionItem, 0, len(sessions))}
+	for _, active := range sessions {
+		sessionsResponse.Sessions = append(sessionsResponse.Sessions, requests.SessionItem{
+			Id:        active.PublicID(),
+			CreatedAt: active.CreatedAt.Format(time.RFC3339),
+			LastSeen:  active.LastSeen.Format(time.RFC3339),
+			UserAgent: active.UserAgent,
+			Ip:        active.IP,
+			Current:   active.SID == session.Value,
+		})
+	}
+
+	response.Body = sessionsResponse
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.RevokeSessionRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Id == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.RevokeSession(r.Context(), login, request.Id)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("revoke session error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// RevokeAllSessions logs the user out everywhere except the current session.
+func (a *API) RevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	revoked, err := a.core.RevokeOtherSessions(r.Context(), login, session.Value)
+	if err != nil {
+		a.lg.Error("revoke all sessions error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.RevokeSessionsResponse{Revoked: revoked}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.VerifyEmailRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.VerifyEmail(r.Context(), request.Token)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidToken) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("verify email error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	retryAfter, err := a.core.SendVerificationEmail(r.Context(), login, a.clientIP(r))
+	if err != nil {
+		if errors.Is(err, usecase.ErrAlreadyVerified) {
+			response.Status = http.StatusConflict
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		if errors.Is(err, usecase.ErrTooManyAttempts) {
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("resend verification error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ForgotPasswordRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Email == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	retryAfter, err := a.core.ForgotPassword(r.Context(), request.Email, a.clientIP(r))
+	if err != nil {
+		if errors.Is(err, usecase.ErrTooManyAttempts) {
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("forgot password error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ResetPasswordRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Password == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.ResetPassword(r.Context(), request.Token, request.Password)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidToken) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("reset password error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// RefreshToken exchanges a refresh token for a new token pair. It needs no
+// CSRF token: the refresh token in the body is a secret of its own.
+func (a *API) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if !a.core.TokensEnabled() {
+		response.Status = http.StatusNotFound
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.RefreshTokenRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	pair, err := a.core.RefreshTokens(r.Context(), request.RefreshToken)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidToken) {
+			response.Status = http.StatusUnauthorized
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("refresh token error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	setAccessCookie(w, pair)
+
+	response.Body = requests.TokenResponse{
+		AccessToken:  pair.Access,
+		RefreshToken: pair.Refresh,
+		ExpiresAt:    pair.ExpiresAt.Format(time.RFC3339),
+	}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
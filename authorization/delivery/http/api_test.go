@@ -0,0 +1,477 @@
+package delivery
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/golang/mock/gomock"
+	"github.com/mailru/easyjson"
+)
+
+func getExpectedResult(res *requests.Response) *requests.Response {
+	jsonResponse, _ := easyjson.Marshal(res)
+	var response requests.Response
+	err := easyjson.Unmarshal(jsonResponse, &response)
+	if err != nil {
+		fmt.Println("unexpected error")
+	}
+	return &response
+}
+
+func getResponse(w *httptest.ResponseRecorder) (*requests.Response, error) {
+	var response requests.Response
+
+	body, _ := io.ReadAll(w.Body)
+	err := easyjson.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("cant unmarshal jsone")
+	}
+
+	return &response, nil
+}
+
+func createRevokeBody(req requests.RevokeSessionRequest) io.Reader {
+	jsonReq, _ := easyjson.Marshal(req)
+
+	body := bytes.NewBuffer(jsonReq)
+	return body
+}
+
+var collector *requests.Collector = requests.GetCollector()
+
+func TestSessions(t *testing.T) {
+	created := time.Unix(1700000000, 0)
+	current := session.Session{Login: "l1", SID: "sid1", CreatedAt: created, LastSeen: created, UserAgent: "ua1", IP: "ip1"}
+	other := session.Session{Login: "l1", SID: "sid2", CreatedAt: created, LastSeen: created, UserAgent: "ua2", IP: "ip2"}
+	expectedResponse := requests.SessionsResponse{Sessions: []requests.SessionItem{
+		{Id: current.PublicID(), CreatedAt: created.Format(time.RFC3339), LastSeen: created.Format(time.RFC3339), UserAgent: "ua1", Ip: "ip1", Current: true},
+		{Id: other.PublicID(), CreatedAt: created.Format(time.RFC3339), LastSeen: created.Format(time.RFC3339), UserAgent: "ua2", Ip: "ip2", Current: false},
+	}}
+
+	testCases := map[string]struct {
+		method string
+		sid    string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"No cookie": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusUnauthorized, Body: nil},
+		},
+		"Unknown session": {
+			method: http.MethodGet,
+			sid:    "sid0",
+			result: &requests.Response{Status: http.StatusUnauthorized, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodGet,
+			sid:    "sid3",
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodGet,
+			sid:    "sid1",
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid0").Return("", fmt.Errorf("not found")).Times(1)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid3").Return("l3", nil).Times(1)
+	mockCore.EXPECT().GetSessions(gomock.Any(), "l3").Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid1").Return("l1", nil).Times(1)
+	mockCore.EXPECT().GetSessions(gomock.Any(), "l1").Return([]session.Session{current, other}, nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/sessions", nil)
+		if curr.sid != "" {
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: curr.sid})
+		}
+		w := httptest.NewRecorder()
+
+		api.Sessions(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		csrf   string
+		sid    string
+		body   requests.RevokeSessionRequest
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"Bad csrf": {
+			method: http.MethodPost,
+			csrf:   "bad",
+			sid:    "sid1",
+			result: &requests.Response{Status: http.StatusPreconditionFailed, Body: nil},
+		},
+		"Empty id": {
+			method: http.MethodPost,
+			csrf:   "csrf1",
+			sid:    "sid1",
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Not found": {
+			method: http.MethodPost,
+			csrf:   "csrf1",
+			sid:    "sid1",
+			body:   requests.RevokeSessionRequest{Id: "unknown"},
+			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodPost,
+			csrf:   "csrf1",
+			sid:    "sid1",
+			body:   requests.RevokeSessionRequest{Id: "broken"},
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			csrf:   "csrf1",
+			sid:    "sid1",
+			body:   requests.RevokeSessionRequest{Id: "abcdef"},
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "bad", "sid1").Return(false, nil).Times(1)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "csrf1", "sid1").Return(true, nil).Times(4)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid1").Return("l1", nil).Times(4)
+	mockCore.EXPECT().RevokeSession(gomock.Any(), "l1", "unknown").Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().RevokeSession(gomock.Any(), "l1", "broken").Return(fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().RevokeSession(gomock.Any(), "l1", "abcdef").Return(nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/sessions/revoke", createRevokeBody(curr.body))
+		r.Header.Set("x-csrf-token", curr.csrf)
+		if curr.sid != "" {
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: curr.sid})
+		}
+		w := httptest.NewRecorder()
+
+		api.RevokeSession(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		sid    string
+		result *requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"Core error": {
+			method: http.MethodPost,
+			sid:    "sid2",
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			sid:    "sid1",
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: requests.RevokeSessionsResponse{Revoked: 2}}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "csrf", gomock.Any()).Return(true, nil).Times(2)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid2").Return("l2", nil).Times(1)
+	mockCore.EXPECT().RevokeOtherSessions(gomock.Any(), "l2", "sid2").Return(0, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid1").Return("l1", nil).Times(1)
+	mockCore.EXPECT().RevokeOtherSessions(gomock.Any(), "l1", "sid1").Return(2, nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/sessions/revoke_all", nil)
+		r.Header.Set("x-csrf-token", "csrf")
+		if curr.sid != "" {
+			r.AddCookie(&http.Cookie{Name: "session_id", Value: curr.sid})
+		}
+		w := httptest.NewRecorder()
+
+		api.RevokeAllSessions(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	api := API{lg: logger, proxies: parseProxies([]string{"127.0.0.1", "10.0.0.0/8", "bad"}, logger)}
+
+	testCases := map[string]struct {
+		remoteAddr string
+		realIP     string
+		result     string
+	}{
+		"No proxy":           {remoteAddr: "1.2.3.4:5000", result: "1.2.3.4"},
+		"Spoofed header":     {remoteAddr: "1.2.3.4:5000", realIP: "5.6.7.8", result: "1.2.3.4"},
+		"Trusted proxy":      {remoteAddr: "127.0.0.1:5000", realIP: "5.6.7.8", result: "5.6.7.8"},
+		"Trusted network":    {remoteAddr: "10.1.2.3:5000", realIP: "5.6.7.8", result: "5.6.7.8"},
+		"Proxy without ip":   {remoteAddr: "127.0.0.1:5000", result: "127.0.0.1"},
+		"Proxy with garbage": {remoteAddr: "127.0.0.1:5000", realIP: "garbage", result: "127.0.0.1"},
+	}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/signin", nil)
+		r.RemoteAddr = curr.remoteAddr
+		if curr.realIP != "" {
+			r.Header.Set("X-Real-IP", curr.realIP)
+		}
+
+		if ip := api.clientIP(r); ip != curr.result {
+			t.Errorf("%s: wanted %s, got %s", name, curr.result, ip)
+			return
+		}
+	}
+}
+
+func TestForgotPassword(t *testing.T) {
+	testCases := map[string]struct {
+		body       string
+		result     *requests.Response
+		retryAfter string
+	}{
+		"Bad body": {
+			body:   "{",
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Throttled": {
+			body:       `{"email":"spam@mail.ru"}`,
+			result:     &requests.Response{Status: http.StatusTooManyRequests, Body: nil},
+			retryAfter: "60",
+		},
+		"Core error": {
+			body:   `{"email":"broken@mail.ru"}`,
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Ok": {
+			body:   `{"email":"user@mail.ru"}`,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "csrf", "").Return(true, nil).Times(4)
+	mockCore.EXPECT().ForgotPassword(gomock.Any(), "spam@mail.ru", "1.2.3.4").Return(time.Minute, usecase.ErrTooManyAttempts).Times(1)
+	mockCore.EXPECT().ForgotPassword(gomock.Any(), "broken@mail.ru", "1.2.3.4").Return(time.Duration(0), fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().ForgotPassword(gomock.Any(), "user@mail.ru", "1.2.3.4").Return(time.Duration(0), nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/password/forgot", bytes.NewBufferString(curr.body))
+		r.RemoteAddr = "1.2.3.4:5000"
+		r.Header.Set("x-csrf-token", "csrf")
+		w := httptest.NewRecorder()
+
+		api.ForgotPassword(w, r)
+		if retryAfter := w.Header().Get("Retry-After"); retryAfter != curr.retryAfter {
+			t.Errorf("%s: wanted Retry-After %q, got %q", name, curr.retryAfter, retryAfter)
+			return
+		}
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
+
+func TestConfirmTotp(t *testing.T) {
+	testCases := map[string]struct {
+		body       string
+		result     *requests.Response
+		retryAfter string
+	}{
+		"Throttled": {
+			body:       `{"code":"000000"}`,
+			result:     &requests.Response{Status: http.StatusTooManyRequests, Body: nil},
+			retryAfter: "30",
+		},
+		"Invalid code": {
+			body:   `{"code":"111111"}`,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Ok": {
+			body:   `{"code":"222222"}`,
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: requests.RecoveryCodesResponse{RecoveryCodes: []string{"c1"}}}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "csrf", "sid1").Return(true, nil).Times(3)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid1").Return("l1", nil).Times(3)
+	mockCore.EXPECT().ConfirmTotp(gomock.Any(), "l1", "000000", "1.2.3.4").Return(nil, 30*time.Second, usecase.ErrTooManyAttempts).Times(1)
+	mockCore.EXPECT().ConfirmTotp(gomock.Any(), "l1", "111111", "1.2.3.4").Return(nil, time.Duration(0), usecase.ErrInvalidCode).Times(1)
+	mockCore.EXPECT().ConfirmTotp(gomock.Any(), "l1", "222222", "1.2.3.4").Return([]string{"c1"}, time.Duration(0), nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/mfa/confirm", bytes.NewBufferString(curr.body))
+		r.RemoteAddr = "1.2.3.4:5000"
+		r.Header.Set("x-csrf-token", "csrf")
+		r.AddCookie(&http.Cookie{Name: "session_id", Value: "sid1"})
+		w := httptest.NewRecorder()
+
+		api.ConfirmTotp(w, r)
+		if retryAfter := w.Header().Get("Retry-After"); retryAfter != curr.retryAfter {
+			t.Errorf("%s: wanted Retry-After %q, got %q", name, curr.retryAfter, retryAfter)
+			return
+		}
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	testCases := map[string]struct {
+		body   string
+		result *requests.Response
+	}{
+		"Bad body": {
+			body:   `{"password":`,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Old session": {
+			body:   `{}`,
+			result: &requests.Response{Status: http.StatusForbidden, Body: nil},
+		},
+		"Wrong password": {
+			body:   `{"password":"bad"}`,
+			result: &requests.Response{Status: http.StatusForbidden, Body: nil},
+		},
+		"Wrong code": {
+			body:   `{"code":"000000"}`,
+			result: &requests.Response{Status: http.StatusForbidden, Body: nil},
+		},
+		"Ok": {
+			body:   `{"code":"222222"}`,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().CheckCsrfToken(gomock.Any(), "csrf", "sid1").Return(true, nil).Times(5)
+	mockCore.EXPECT().GetUserName(gomock.Any(), "sid1").Return("l1", nil).Times(5)
+	mockCore.EXPECT().DeleteAccount(gomock.Any(), "l1", "sid1", "", "", "1.2.3.4").Return(time.Duration(0), usecase.ErrReauthRequired).Times(1)
+	mockCore.EXPECT().DeleteAccount(gomock.Any(), "l1", "sid1", "bad", "", "1.2.3.4").Return(time.Duration(0), usecase.ErrWrongPassword).Times(1)
+	mockCore.EXPECT().DeleteAccount(gomock.Any(), "l1", "sid1", "", "000000", "1.2.3.4").Return(time.Duration(0), usecase.ErrInvalidCode).Times(1)
+	mockCore.EXPECT().DeleteAccount(gomock.Any(), "l1", "sid1", "", "222222", "1.2.3.4").Return(time.Duration(0), nil).Times(1)
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1/account/delete", bytes.NewBufferString(curr.body))
+		r.RemoteAddr = "1.2.3.4:5000"
+		r.Header.Set("x-csrf-token", "csrf")
+		r.AddCookie(&http.Cookie{Name: "session_id", Value: "sid1"})
+		w := httptest.NewRecorder()
+
+		api.DeleteAccount(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			return
+		}
+		if !reflect.DeepEqual(response, curr.result) {
+			t.Errorf("%s: wanted %v, got %v", name, curr.result, response)
+			return
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package delivery
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/mailru/easyjson"
+)
+
+// SigninMfa is the second step of the sign in for users with 2FA: it
+// exchanges the token returned by Signin and a code for a session.
+func (a *API) SigninMfa(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.SigninMfaRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	ip := a.clientIP(r)
+
+	login, remember, retryAfter, err := a.core.FinishMfa(r.Context(), request.MfaToken, request.Code, ip)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTooManyAttempts):
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+		case errors.Is(err, usecase.ErrInvalidToken), errors.Is(err, usecase.ErrInvalidCode):
+			response.Status = http.StatusUnauthorized
+		default:
+			a.lg.Error("Signin mfa error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	pair, err := a.startSession(w, r, login, remember, ip)
+	if err != nil {
+		a.lg.Error("Signin mfa session error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if pair != nil {
+		response.Body = requests.TokenResponse{
+			AccessToken:  pair.Access,
+			RefreshToken: pair.Refresh,
+			ExpiresAt:    pair.ExpiresAt.Format(time.RFC3339),
+		}
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) EnrollTotp(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	secret, uri, err := a.core.EnrollTotp(login)
+	if err != nil {
+		if errors.Is(err, usecase.ErrMfaAlreadyEnabled) {
+			response.Status = http.StatusConflict
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("enroll totp error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.TotpEnrollResponse{Secret: secret, Uri: uri}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) ConfirmTotp(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.TotpCodeRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	codes, retryAfter, err := a.core.ConfirmTotp(r.Context(), login, request.Code, a.clientIP(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTooManyAttempts):
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+		case errors.Is(err, usecase.ErrMfaAlreadyEnabled):
+			response.Status = http.StatusConflict
+		case errors.Is(err, usecase.ErrMfaNotEnabled), errors.Is(err, usecase.ErrInvalidCode):
+			response.Status = http.StatusBadRequest
+		default:
+			a.lg.Error("confirm totp error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.RecoveryCodesResponse{RecoveryCodes: codes}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) DisableTotp(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.TotpCodeRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	retryAfter, err := a.core.DisableTotp(r.Context(), login, request.Code, a.clientIP(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrTooManyAttempts):
+			setRetryAfter(w, retryAfter)
+			response.Status = http.StatusTooManyRequests
+		case errors.Is(err, usecase.ErrMfaRequired):
+			response.Status = http.StatusForbidden
+		case errors.Is(err, usecase.ErrMfaNotEnabled), errors.Is(err, usecase.ErrInvalidCode):
+			response.Status = http.StatusBadRequest
+		default:
+			a.lg.Error("disable totp error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+func (a *API) SetRoleMfaRequired(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	csrfToken := r.Header.Get("x-csrf-token")
+
+	found, err := a.core.CheckCsrfToken(r.Context(), csrfToken, sessionID(r))
+	if err != nil || !found {
+		w.Header().Set("X-CSRF-Token", "null")
+		response.Status = http.StatusPreconditionFailed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	session, err := r.Cookie("session_id")
+	if errors.Is(err, http.ErrNoCookie) {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	login, err := a.core.GetUserName(r.Context(), session.Value)
+	if err != nil || login == "" {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.RoleMfaRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Role == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.SetRoleMfaRequired(request.Role, request.Required, login)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotAllowed) {
+			response.Status = http.StatusForbidden
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("set role mfa required error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
@@ -0,0 +1,160 @@
+package delivery
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+)
+
+// OAuth serves /api/v1/oauth/{provider}/start and /callback. Both are
+// visited by the browser itself, so they answer with redirects.
+func (a *API) OAuth(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	provider, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/oauth/"), "/")
+	switch action {
+	case "start":
+		a.oauthStart(w, r, provider)
+	case "callback":
+		a.oauthCallback(w, r, provider)
+	default:
+		response.Status = http.StatusNotFound
+		a.ct.SendResponse(w, r, response, a.lg, start)
+	}
+}
+
+func (a *API) oauthStart(w http.ResponseWriter, r *http.Request, provider string) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	// A signed in user links the identity to the current profile.
+	var linkLogin string
+	if sid := sessionID(r); sid != "" {
+		login, err := a.core.GetUserName(r.Context(), sid)
+		if err == nil {
+			linkLogin = login
+		}
+	}
+
+	remember := r.URL.Query().Get("remember") == "true"
+
+	authURL, err := a.core.OAuthStart(r.Context(), provider, remember, linkLogin)
+	if err != nil {
+		if errors.Is(err, usecase.ErrUnknownProvider) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("oauth start error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	// The state is also kept in a cookie, so that a callback started in
+	// another browser is not accepted in this one.
+	http.SetCookie(w, &http.Cookie{
+		Name:     "oauth_state",
+		Value:    oauthState(authURL),
+		Path:     "/api/v1/oauth/",
+		Expires:  time.Now().Add(10 * time.Minute),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+func oauthState(authURL string) string {
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Query().Get("state")
+}
+
+func (a *API) oauthCallback(w http.ResponseWriter, r *http.Request, provider string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:    "oauth_state",
+		Path:    "/api/v1/oauth/",
+		Expires: time.Now().AddDate(0, 0, -1),
+	})
+
+	state, err := r.Cookie("oauth_state")
+	if err != nil || state.Value == "" || state.Value != r.URL.Query().Get("state") {
+		a.oauthRedirect(w, r, url.Values{"oauth_error": {"invalid_state"}})
+		return
+	}
+
+	login, remember, err := a.core.OAuthCallback(r.Context(), provider, r.URL.Query())
+	if err != nil {
+		var reason string
+		switch {
+		case errors.Is(err, usecase.ErrUnknownProvider):
+			reason = "unknown_provider"
+		case errors.Is(err, usecase.ErrInvalidToken):
+			reason = "invalid_state"
+		case errors.Is(err, usecase.ErrOAuthDenied):
+			reason = "denied"
+		case errors.Is(err, usecase.ErrIdentityConflict):
+			reason = "conflict"
+		default:
+			a.lg.Error("oauth callback error", "err", err.Error())
+			reason = "internal"
+		}
+		a.oauthRedirect(w, r, url.Values{"oauth_error": {reason}})
+		return
+	}
+
+	mfaEnabled, _, err := a.core.MfaStatus(login)
+	if err != nil {
+		a.lg.Error("oauth mfa error", "err", err.Error())
+		a.oauthRedirect(w, r, url.Values{"oauth_error": {"internal"}})
+		return
+	}
+
+	if mfaEnabled {
+		mfaToken, err := a.core.StartMfa(r.Context(), login, remember)
+		if err != nil {
+			a.lg.Error("oauth mfa error", "err", err.Error())
+			a.oauthRedirect(w, r, url.Values{"oauth_error": {"internal"}})
+			return
+		}
+		a.oauthRedirect(w, r, url.Values{"mfa_token": {mfaToken}})
+		return
+	}
+
+	_, err = a.startSession(w, r, login, remember, a.clientIP(r))
+	if err != nil {
+		a.lg.Error("oauth session error", "err", err.Error())
+		a.oauthRedirect(w, r, url.Values{"oauth_error": {"internal"}})
+		return
+	}
+
+	a.oauthRedirect(w, r, nil)
+}
+
+func (a *API) oauthRedirect(w http.ResponseWriter, r *http.Request, query url.Values) {
+	target := a.core.OAuthRedirect()
+	if target == "" {
+		target = "/"
+	}
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	http.Redirect(w, r, target, http.StatusFound)
+}
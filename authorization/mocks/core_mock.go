@@ -0,0 +1,684 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: core.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	url "net/url"
+	reflect "reflect"
+	time "time"
+
+	session "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
+	usecase "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	requests "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockICore is a mock of ICore interface.
+type MockICore struct {
+	ctrl     *gomock.Controller
+	recorder *MockICoreMockRecorder
+}
+
+// MockICoreMockRecorder is the mock recorder for MockICore.
+type MockICoreMockRecorder struct {
+	mock *MockICore
+}
+
+// NewMockICore creates a new mock instance.
+func NewMockICore(ctrl *gomock.Controller) *MockICore {
+	mock := &MockICore{ctrl: ctrl}
+	mock.recorder = &MockICoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICore) EXPECT() *MockICoreMockRecorder {
+	return m.recorder
+}
+
+// ChangeUsersRole mocks base method.
+func (m *MockICore) ChangeUsersRole(login, role, currentUserLogin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUsersRole", login, role, currentUserLogin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeUsersRole indicates an expected call of ChangeUsersRole.
+func (mr *MockICoreMockRecorder) ChangeUsersRole(login, role, currentUserLogin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUsersRole", reflect.TypeOf((*MockICore)(nil).ChangeUsersRole), login, role, currentUserLogin)
+}
+
+// CheckCsrfToken mocks base method.
+func (m *MockICore) CheckCsrfToken(ctx context.Context, token, sid string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCsrfToken", ctx, token, sid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCsrfToken indicates an expected call of CheckCsrfToken.
+func (mr *MockICoreMockRecorder) CheckCsrfToken(ctx, token, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCsrfToken", reflect.TypeOf((*MockICore)(nil).CheckCsrfToken), ctx, token, sid)
+}
+
+// CheckPassword mocks base method.
+func (m *MockICore) CheckPassword(login, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPassword", login, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPassword indicates an expected call of CheckPassword.
+func (mr *MockICoreMockRecorder) CheckPassword(login, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockICore)(nil).CheckPassword), login, password)
+}
+
+// CheckPermission mocks base method.
+func (m *MockICore) CheckPermission(login, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPermission", login, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPermission indicates an expected call of CheckPermission.
+func (mr *MockICoreMockRecorder) CheckPermission(login, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockICore)(nil).CheckPermission), login, permission)
+}
+
+// ConfirmTotp mocks base method.
+func (m *MockICore) ConfirmTotp(ctx context.Context, login, code, ip string) ([]string, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTotp", ctx, login, code, ip)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ConfirmTotp indicates an expected call of ConfirmTotp.
+func (mr *MockICoreMockRecorder) ConfirmTotp(ctx, login, code, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTotp", reflect.TypeOf((*MockICore)(nil).ConfirmTotp), ctx, login, code, ip)
+}
+
+// CreateCsrfToken mocks base method.
+func (m *MockICore) CreateCsrfToken(ctx context.Context, sid string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCsrfToken", ctx, sid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCsrfToken indicates an expected call of CreateCsrfToken.
+func (mr *MockICoreMockRecorder) CreateCsrfToken(ctx, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCsrfToken", reflect.TypeOf((*MockICore)(nil).CreateCsrfToken), ctx, sid)
+}
+
+// CreateSession mocks base method.
+func (m *MockICore) CreateSession(ctx context.Context, login, userAgent, ip string, remember bool) (string, session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, login, userAgent, ip, remember)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(session.Session)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockICoreMockRecorder) CreateSession(ctx, login, userAgent, ip, remember interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockICore)(nil).CreateSession), ctx, login, userAgent, ip, remember)
+}
+
+// CreateUserAccount mocks base method.
+func (m *MockICore) CreateUserAccount(ctx context.Context, login, password, name, birthDate, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserAccount", ctx, login, password, name, birthDate, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserAccount indicates an expected call of CreateUserAccount.
+func (mr *MockICoreMockRecorder) CreateUserAccount(ctx, login, password, name, birthDate, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAccount", reflect.TypeOf((*MockICore)(nil).CreateUserAccount), ctx, login, password, name, birthDate, email)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockICore) DeleteAccount(ctx context.Context, login, sid, password, code, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, login, sid, password, code, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockICoreMockRecorder) DeleteAccount(ctx, login, sid, password, code, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockICore)(nil).DeleteAccount), ctx, login, sid, password, code, ip)
+}
+
+// DisableTotp mocks base method.
+func (m *MockICore) DisableTotp(ctx context.Context, login, code, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTotp", ctx, login, code, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTotp indicates an expected call of DisableTotp.
+func (mr *MockICoreMockRecorder) DisableTotp(ctx, login, code, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTotp", reflect.TypeOf((*MockICore)(nil).DisableTotp), ctx, login, code, ip)
+}
+
+// EditProfile mocks base method.
+func (m *MockICore) EditProfile(ctx context.Context, sid, prevLogin, login, password, email, birthDate, photo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditProfile", ctx, sid, prevLogin, login, password, email, birthDate, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditProfile indicates an expected call of EditProfile.
+func (mr *MockICoreMockRecorder) EditProfile(ctx, sid, prevLogin, login, password, email, birthDate, photo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditProfile", reflect.TypeOf((*MockICore)(nil).EditProfile), ctx, sid, prevLogin, login, password, email, birthDate, photo)
+}
+
+// EnrollTotp mocks base method.
+func (m *MockICore) EnrollTotp(login string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTotp", login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EnrollTotp indicates an expected call of EnrollTotp.
+func (mr *MockICoreMockRecorder) EnrollTotp(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTotp", reflect.TypeOf((*MockICore)(nil).EnrollTotp), login)
+}
+
+// ExportAccount mocks base method.
+func (m *MockICore) ExportAccount(ctx context.Context, login string) (*requests.AccountExportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAccount", ctx, login)
+	ret0, _ := ret[0].(*requests.AccountExportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAccount indicates an expected call of ExportAccount.
+func (mr *MockICoreMockRecorder) ExportAccount(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAccount", reflect.TypeOf((*MockICore)(nil).ExportAccount), ctx, login)
+}
+
+// FindActiveSession mocks base method.
+func (m *MockICore) FindActiveSession(ctx context.Context, sid string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveSession", ctx, sid)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveSession indicates an expected call of FindActiveSession.
+func (mr *MockICoreMockRecorder) FindActiveSession(ctx, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveSession", reflect.TypeOf((*MockICore)(nil).FindActiveSession), ctx, sid)
+}
+
+// FindUserAccount mocks base method.
+func (m *MockICore) FindUserAccount(login, password string) (*models.UserItem, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserAccount", login, password)
+	ret0, _ := ret[0].(*models.UserItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindUserAccount indicates an expected call of FindUserAccount.
+func (mr *MockICoreMockRecorder) FindUserAccount(login, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserAccount", reflect.TypeOf((*MockICore)(nil).FindUserAccount), login, password)
+}
+
+// FindUserByLogin mocks base method.
+func (m *MockICore) FindUserByLogin(login string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserByLogin", login)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserByLogin indicates an expected call of FindUserByLogin.
+func (mr *MockICoreMockRecorder) FindUserByLogin(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserByLogin", reflect.TypeOf((*MockICore)(nil).FindUserByLogin), login)
+}
+
+// FindUsers mocks base method.
+func (m *MockICore) FindUsers(login, role string, page pagination.Page) (*requests.UsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsers", login, role, page)
+	ret0, _ := ret[0].(*requests.UsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsers indicates an expected call of FindUsers.
+func (mr *MockICoreMockRecorder) FindUsers(login, role, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsers", reflect.TypeOf((*MockICore)(nil).FindUsers), login, role, page)
+}
+
+// FinishMfa mocks base method.
+func (m *MockICore) FinishMfa(ctx context.Context, token, code, ip string) (string, bool, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishMfa", ctx, token, code, ip)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(time.Duration)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// FinishMfa indicates an expected call of FinishMfa.
+func (mr *MockICoreMockRecorder) FinishMfa(ctx, token, code, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishMfa", reflect.TypeOf((*MockICore)(nil).FinishMfa), ctx, token, code, ip)
+}
+
+// ForgotPassword mocks base method.
+func (m *MockICore) ForgotPassword(ctx context.Context, email, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForgotPassword", ctx, email, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForgotPassword indicates an expected call of ForgotPassword.
+func (mr *MockICoreMockRecorder) ForgotPassword(ctx, email, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForgotPassword", reflect.TypeOf((*MockICore)(nil).ForgotPassword), ctx, email, ip)
+}
+
+// GetSessions mocks base method.
+func (m *MockICore) GetSessions(ctx context.Context, login string) ([]session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, login)
+	ret0, _ := ret[0].([]session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockICoreMockRecorder) GetSessions(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockICore)(nil).GetSessions), ctx, login)
+}
+
+// GetUserName mocks base method.
+func (m *MockICore) GetUserName(ctx context.Context, sid string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserName", ctx, sid)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserName indicates an expected call of GetUserName.
+func (mr *MockICoreMockRecorder) GetUserName(ctx, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserName", reflect.TypeOf((*MockICore)(nil).GetUserName), ctx, sid)
+}
+
+// GetUserProfile mocks base method.
+func (m *MockICore) GetUserProfile(login string) (*models.UserItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", login)
+	ret0, _ := ret[0].(*models.UserItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockICoreMockRecorder) GetUserProfile(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockICore)(nil).GetUserProfile), login)
+}
+
+// GetUserRole mocks base method.
+func (m *MockICore) GetUserRole(login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole.
+func (mr *MockICoreMockRecorder) GetUserRole(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockICore)(nil).GetUserRole), login)
+}
+
+// IsSubscribed mocks base method.
+func (m *MockICore) IsSubscribed(userName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSubscribed", userName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSubscribed indicates an expected call of IsSubscribed.
+func (mr *MockICoreMockRecorder) IsSubscribed(userName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSubscribed", reflect.TypeOf((*MockICore)(nil).IsSubscribed), userName)
+}
+
+// IssueTokens mocks base method.
+func (m *MockICore) IssueTokens(ctx context.Context, login, sid string) (*usecase.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTokens", ctx, login, sid)
+	ret0, _ := ret[0].(*usecase.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueTokens indicates an expected call of IssueTokens.
+func (mr *MockICoreMockRecorder) IssueTokens(ctx, login, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTokens", reflect.TypeOf((*MockICore)(nil).IssueTokens), ctx, login, sid)
+}
+
+// KillSession mocks base method.
+func (m *MockICore) KillSession(ctx context.Context, sid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KillSession", ctx, sid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KillSession indicates an expected call of KillSession.
+func (mr *MockICoreMockRecorder) KillSession(ctx, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KillSession", reflect.TypeOf((*MockICore)(nil).KillSession), ctx, sid)
+}
+
+// MfaStatus mocks base method.
+func (m *MockICore) MfaStatus(login string) (bool, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MfaStatus", login)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MfaStatus indicates an expected call of MfaStatus.
+func (mr *MockICoreMockRecorder) MfaStatus(login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MfaStatus", reflect.TypeOf((*MockICore)(nil).MfaStatus), login)
+}
+
+// OAuthCallback mocks base method.
+func (m *MockICore) OAuthCallback(ctx context.Context, provider string, callback url.Values) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthCallback", ctx, provider, callback)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OAuthCallback indicates an expected call of OAuthCallback.
+func (mr *MockICoreMockRecorder) OAuthCallback(ctx, provider, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthCallback", reflect.TypeOf((*MockICore)(nil).OAuthCallback), ctx, provider, callback)
+}
+
+// OAuthRedirect mocks base method.
+func (m *MockICore) OAuthRedirect() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthRedirect")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// OAuthRedirect indicates an expected call of OAuthRedirect.
+func (mr *MockICoreMockRecorder) OAuthRedirect() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthRedirect", reflect.TypeOf((*MockICore)(nil).OAuthRedirect))
+}
+
+// OAuthStart mocks base method.
+func (m *MockICore) OAuthStart(ctx context.Context, provider string, remember bool, linkLogin string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OAuthStart", ctx, provider, remember, linkLogin)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OAuthStart indicates an expected call of OAuthStart.
+func (mr *MockICoreMockRecorder) OAuthStart(ctx, provider, remember, linkLogin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OAuthStart", reflect.TypeOf((*MockICore)(nil).OAuthStart), ctx, provider, remember, linkLogin)
+}
+
+// RefreshTokens mocks base method.
+func (m *MockICore) RefreshTokens(ctx context.Context, refresh string) (*usecase.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokens", ctx, refresh)
+	ret0, _ := ret[0].(*usecase.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokens indicates an expected call of RefreshTokens.
+func (mr *MockICoreMockRecorder) RefreshTokens(ctx, refresh interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokens", reflect.TypeOf((*MockICore)(nil).RefreshTokens), ctx, refresh)
+}
+
+// ResetPassword mocks base method.
+func (m *MockICore) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockICoreMockRecorder) ResetPassword(ctx, token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockICore)(nil).ResetPassword), ctx, token, password)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockICore) RevokeOtherSessions(ctx context.Context, login, sid string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx, login, sid)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockICoreMockRecorder) RevokeOtherSessions(ctx, login, sid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockICore)(nil).RevokeOtherSessions), ctx, login, sid)
+}
+
+// RevokeSession mocks base method.
+func (m *MockICore) RevokeSession(ctx context.Context, login, publicId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, login, publicId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockICoreMockRecorder) RevokeSession(ctx, login, publicId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockICore)(nil).RevokeSession), ctx, login, publicId)
+}
+
+// SendVerificationEmail mocks base method.
+func (m *MockICore) SendVerificationEmail(ctx context.Context, login, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendVerificationEmail", ctx, login, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendVerificationEmail indicates an expected call of SendVerificationEmail.
+func (mr *MockICoreMockRecorder) SendVerificationEmail(ctx, login, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendVerificationEmail", reflect.TypeOf((*MockICore)(nil).SendVerificationEmail), ctx, login, ip)
+}
+
+// SetRoleMfaRequired mocks base method.
+func (m *MockICore) SetRoleMfaRequired(role string, required bool, currentUserLogin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleMfaRequired", role, required, currentUserLogin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoleMfaRequired indicates an expected call of SetRoleMfaRequired.
+func (mr *MockICoreMockRecorder) SetRoleMfaRequired(role, required, currentUserLogin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleMfaRequired", reflect.TypeOf((*MockICore)(nil).SetRoleMfaRequired), role, required, currentUserLogin)
+}
+
+// SigninFailed mocks base method.
+func (m *MockICore) SigninFailed(ctx context.Context, login, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SigninFailed", ctx, login, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SigninFailed indicates an expected call of SigninFailed.
+func (mr *MockICoreMockRecorder) SigninFailed(ctx, login, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SigninFailed", reflect.TypeOf((*MockICore)(nil).SigninFailed), ctx, login, ip)
+}
+
+// SigninRetryAfter mocks base method.
+func (m *MockICore) SigninRetryAfter(ctx context.Context, login, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SigninRetryAfter", ctx, login, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SigninRetryAfter indicates an expected call of SigninRetryAfter.
+func (mr *MockICoreMockRecorder) SigninRetryAfter(ctx, login, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SigninRetryAfter", reflect.TypeOf((*MockICore)(nil).SigninRetryAfter), ctx, login, ip)
+}
+
+// SigninSucceeded mocks base method.
+func (m *MockICore) SigninSucceeded(ctx context.Context, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SigninSucceeded", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SigninSucceeded indicates an expected call of SigninSucceeded.
+func (mr *MockICoreMockRecorder) SigninSucceeded(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SigninSucceeded", reflect.TypeOf((*MockICore)(nil).SigninSucceeded), ctx, login)
+}
+
+// StartMfa mocks base method.
+func (m *MockICore) StartMfa(ctx context.Context, login string, remember bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMfa", ctx, login, remember)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMfa indicates an expected call of StartMfa.
+func (mr *MockICoreMockRecorder) StartMfa(ctx, login, remember interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMfa", reflect.TypeOf((*MockICore)(nil).StartMfa), ctx, login, remember)
+}
+
+// Subscribe mocks base method.
+func (m *MockICore) Subscribe(userName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockICoreMockRecorder) Subscribe(userName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockICore)(nil).Subscribe), userName)
+}
+
+// TokensEnabled mocks base method.
+func (m *MockICore) TokensEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokensEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// TokensEnabled indicates an expected call of TokensEnabled.
+func (mr *MockICoreMockRecorder) TokensEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokensEnabled", reflect.TypeOf((*MockICore)(nil).TokensEnabled))
+}
+
+// VerifyEmail mocks base method.
+func (m *MockICore) VerifyEmail(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockICoreMockRecorder) VerifyEmail(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockICore)(nil).VerifyEmail), ctx, token)
+}
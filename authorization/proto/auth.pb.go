@@ -1,386 +1,1213 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v3.12.4
 // source: auth.proto
 
 package proto
 
 import (
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
-	math "math"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
 type FindIdRequest struct {
-	Sid                  string   `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *FindIdRequest) Reset() {
+	*x = FindIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *FindIdRequest) Reset()         { *m = FindIdRequest{} }
-func (m *FindIdRequest) String() string { return proto.CompactTextString(m) }
-func (*FindIdRequest) ProtoMessage()    {}
+func (*FindIdRequest) ProtoMessage() {}
+
+func (x *FindIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindIdRequest.ProtoReflect.Descriptor instead.
 func (*FindIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{0}
+	return file_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FindIdRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+type FindIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value int64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *FindIdResponse) Reset() {
+	*x = FindIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindIdResponse) ProtoMessage() {}
+
+func (x *FindIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindIdResponse.ProtoReflect.Descriptor instead.
+func (*FindIdResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{1}
 }
 
-func (m *FindIdRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIdRequest.Unmarshal(m, b)
+func (x *FindIdResponse) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
-func (m *FindIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIdRequest.Marshal(b, m, deterministic)
+
+type NamesAndPathsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
-func (m *FindIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIdRequest.Merge(m, src)
+
+func (x *NamesAndPathsListRequest) Reset() {
+	*x = NamesAndPathsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *FindIdRequest) XXX_Size() int {
-	return xxx_messageInfo_FindIdRequest.Size(m)
+
+func (x *NamesAndPathsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *FindIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIdRequest.DiscardUnknown(m)
+
+func (*NamesAndPathsListRequest) ProtoMessage() {}
+
+func (x *NamesAndPathsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_FindIdRequest proto.InternalMessageInfo
+// Deprecated: Use NamesAndPathsListRequest.ProtoReflect.Descriptor instead.
+func (*NamesAndPathsListRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{2}
+}
 
-func (m *FindIdRequest) GetSid() string {
-	if m != nil {
-		return m.Sid
+func (x *NamesAndPathsListRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type NamesAndPathsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *NamesAndPathsResponse) Reset() {
+	*x = NamesAndPathsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NamesAndPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamesAndPathsResponse) ProtoMessage() {}
+
+func (x *NamesAndPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamesAndPathsResponse.ProtoReflect.Descriptor instead.
+func (*NamesAndPathsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NamesAndPathsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *NamesAndPathsResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type AuthorizationCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+}
+
+func (x *AuthorizationCheckRequest) Reset() {
+	*x = AuthorizationCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationCheckRequest) ProtoMessage() {}
+
+func (x *AuthorizationCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationCheckRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizationCheckRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthorizationCheckRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
 	}
 	return ""
 }
 
-type FindIdResponse struct {
-	Value                int64    `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AuthorizationCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status bool `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (m *FindIdResponse) Reset()         { *m = FindIdResponse{} }
-func (m *FindIdResponse) String() string { return proto.CompactTextString(m) }
-func (*FindIdResponse) ProtoMessage()    {}
-func (*FindIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{1}
+func (x *AuthorizationCheckResponse) Reset() {
+	*x = AuthorizationCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationCheckResponse) ProtoMessage() {}
+
+func (x *AuthorizationCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (m *FindIdResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FindIdResponse.Unmarshal(m, b)
+// Deprecated: Use AuthorizationCheckResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizationCheckResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{5}
 }
-func (m *FindIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FindIdResponse.Marshal(b, m, deterministic)
+
+func (x *AuthorizationCheckResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type RoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
 }
-func (m *FindIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindIdResponse.Merge(m, src)
+
+func (x *RoleRequest) Reset() {
+	*x = RoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *FindIdResponse) XXX_Size() int {
-	return xxx_messageInfo_FindIdResponse.Size(m)
+
+func (x *RoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *FindIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindIdResponse.DiscardUnknown(m)
+
+func (*RoleRequest) ProtoMessage() {}
+
+func (x *RoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_FindIdResponse proto.InternalMessageInfo
+// Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
+func (*RoleRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{6}
+}
 
-func (m *FindIdResponse) GetValue() int64 {
-	if m != nil {
-		return m.Value
+func (x *RoleRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
 	}
-	return 0
+	return ""
 }
 
-type NamesAndPathsListRequest struct {
-	Ids                  []int32  `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (m *NamesAndPathsListRequest) Reset()         { *m = NamesAndPathsListRequest{} }
-func (m *NamesAndPathsListRequest) String() string { return proto.CompactTextString(m) }
-func (*NamesAndPathsListRequest) ProtoMessage()    {}
-func (*NamesAndPathsListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{2}
+func (x *RoleResponse) Reset() {
+	*x = RoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (m *NamesAndPathsListRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NamesAndPathsListRequest.Unmarshal(m, b)
+func (x *RoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *NamesAndPathsListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NamesAndPathsListRequest.Marshal(b, m, deterministic)
+
+func (*RoleResponse) ProtoMessage() {}
+
+func (x *RoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResponse.ProtoReflect.Descriptor instead.
+func (*RoleResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RoleResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
-func (m *NamesAndPathsListRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NamesAndPathsListRequest.Merge(m, src)
+
+type SigningKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
-func (m *NamesAndPathsListRequest) XXX_Size() int {
-	return xxx_messageInfo_NamesAndPathsListRequest.Size(m)
+
+func (x *SigningKeysRequest) Reset() {
+	*x = SigningKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *NamesAndPathsListRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NamesAndPathsListRequest.DiscardUnknown(m)
+
+func (x *SigningKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_NamesAndPathsListRequest proto.InternalMessageInfo
+func (*SigningKeysRequest) ProtoMessage() {}
 
-func (m *NamesAndPathsListRequest) GetIds() []int32 {
-	if m != nil {
-		return m.Ids
+func (x *SigningKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type NamesAndPathsResponse struct {
-	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
-	Paths                []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use SigningKeysRequest.ProtoReflect.Descriptor instead.
+func (*SigningKeysRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *NamesAndPathsResponse) Reset()         { *m = NamesAndPathsResponse{} }
-func (m *NamesAndPathsResponse) String() string { return proto.CompactTextString(m) }
-func (*NamesAndPathsResponse) ProtoMessage()    {}
-func (*NamesAndPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{3}
+type SigningKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kid       string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Alg       string `protobuf:"bytes,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 }
 
-func (m *NamesAndPathsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NamesAndPathsResponse.Unmarshal(m, b)
+func (x *SigningKey) Reset() {
+	*x = SigningKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *NamesAndPathsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NamesAndPathsResponse.Marshal(b, m, deterministic)
+
+func (x *SigningKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *NamesAndPathsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NamesAndPathsResponse.Merge(m, src)
+
+func (*SigningKey) ProtoMessage() {}
+
+func (x *SigningKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *NamesAndPathsResponse) XXX_Size() int {
-	return xxx_messageInfo_NamesAndPathsResponse.Size(m)
+
+// Deprecated: Use SigningKey.ProtoReflect.Descriptor instead.
+func (*SigningKey) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{9}
 }
-func (m *NamesAndPathsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NamesAndPathsResponse.DiscardUnknown(m)
+
+func (x *SigningKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
 }
 
-var xxx_messageInfo_NamesAndPathsResponse proto.InternalMessageInfo
+func (x *SigningKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
 
-func (m *NamesAndPathsResponse) GetNames() []string {
-	if m != nil {
-		return m.Names
+func (x *SigningKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
 	}
 	return nil
 }
 
-func (m *NamesAndPathsResponse) GetPaths() []string {
-	if m != nil {
-		return m.Paths
+type SigningKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*SigningKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SigningKeysResponse) Reset() {
+	*x = SigningKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type AuthorizationCheckRequest struct {
-	Sid                  string   `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *SigningKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (m *AuthorizationCheckRequest) Reset()         { *m = AuthorizationCheckRequest{} }
-func (m *AuthorizationCheckRequest) String() string { return proto.CompactTextString(m) }
-func (*AuthorizationCheckRequest) ProtoMessage()    {}
-func (*AuthorizationCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{4}
+func (*SigningKeysResponse) ProtoMessage() {}
+
+func (x *SigningKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (m *AuthorizationCheckRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthorizationCheckRequest.Unmarshal(m, b)
+// Deprecated: Use SigningKeysResponse.ProtoReflect.Descriptor instead.
+func (*SigningKeysResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{10}
 }
-func (m *AuthorizationCheckRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthorizationCheckRequest.Marshal(b, m, deterministic)
+
+func (x *SigningKeysResponse) GetKeys() []*SigningKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
 }
-func (m *AuthorizationCheckRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizationCheckRequest.Merge(m, src)
+
+type PermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login      string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	UserId     int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
 }
-func (m *AuthorizationCheckRequest) XXX_Size() int {
-	return xxx_messageInfo_AuthorizationCheckRequest.Size(m)
+
+func (x *PermissionRequest) Reset() {
+	*x = PermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *AuthorizationCheckRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizationCheckRequest.DiscardUnknown(m)
+
+func (x *PermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_AuthorizationCheckRequest proto.InternalMessageInfo
+func (*PermissionRequest) ProtoMessage() {}
 
-func (m *AuthorizationCheckRequest) GetSid() string {
-	if m != nil {
-		return m.Sid
+func (x *PermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type AuthorizationCheckResponse struct {
-	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// Deprecated: Use PermissionRequest.ProtoReflect.Descriptor instead.
+func (*PermissionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
-func (m *AuthorizationCheckResponse) Reset()         { *m = AuthorizationCheckResponse{} }
-func (m *AuthorizationCheckResponse) String() string { return proto.CompactTextString(m) }
-func (*AuthorizationCheckResponse) ProtoMessage()    {}
-func (*AuthorizationCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{5}
+func (x *PermissionRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
 }
 
-func (m *AuthorizationCheckResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuthorizationCheckResponse.Unmarshal(m, b)
+func (x *PermissionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
-func (m *AuthorizationCheckResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuthorizationCheckResponse.Marshal(b, m, deterministic)
+
+func (x *PermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
 }
-func (m *AuthorizationCheckResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuthorizationCheckResponse.Merge(m, src)
+
+type PermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
 }
-func (m *AuthorizationCheckResponse) XXX_Size() int {
-	return xxx_messageInfo_AuthorizationCheckResponse.Size(m)
+
+func (x *PermissionResponse) Reset() {
+	*x = PermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *AuthorizationCheckResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuthorizationCheckResponse.DiscardUnknown(m)
+
+func (x *PermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_AuthorizationCheckResponse proto.InternalMessageInfo
+func (*PermissionResponse) ProtoMessage() {}
 
-func (m *AuthorizationCheckResponse) GetStatus() bool {
-	if m != nil {
-		return m.Status
+func (x *PermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionResponse.ProtoReflect.Descriptor instead.
+func (*PermissionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
 	}
 	return false
 }
 
-type RoleRequest struct {
-	Login                string   `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type UsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (m *RoleRequest) Reset()         { *m = RoleRequest{} }
-func (m *RoleRequest) String() string { return proto.CompactTextString(m) }
-func (*RoleRequest) ProtoMessage()    {}
-func (*RoleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{6}
+func (x *UsersRequest) Reset() {
+	*x = UsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (m *RoleRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoleRequest.Unmarshal(m, b)
+func (x *UsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *RoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoleRequest.Marshal(b, m, deterministic)
+
+func (*UsersRequest) ProtoMessage() {}
+
+func (x *UsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *RoleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoleRequest.Merge(m, src)
+
+// Deprecated: Use UsersRequest.ProtoReflect.Descriptor instead.
+func (*UsersRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{13}
 }
-func (m *RoleRequest) XXX_Size() int {
-	return xxx_messageInfo_RoleRequest.Size(m)
+
+func (x *UsersRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
 }
-func (m *RoleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoleRequest.DiscardUnknown(m)
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Photo string `protobuf:"bytes,4,opt,name=photo,proto3" json:"photo,omitempty"`
+	Role  string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var xxx_messageInfo_RoleRequest proto.InternalMessageInfo
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *User) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
 
-func (m *RoleRequest) GetLogin() string {
-	if m != nil {
-		return m.Login
+func (x *User) GetLogin() string {
+	if x != nil {
+		return x.Login
 	}
 	return ""
 }
 
-type RoleResponse struct {
-	Role                 string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (m *RoleResponse) Reset()         { *m = RoleResponse{} }
-func (m *RoleResponse) String() string { return proto.CompactTextString(m) }
-func (*RoleResponse) ProtoMessage()    {}
-func (*RoleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8bbd6f3875b0e874, []int{7}
+func (x *User) GetPhoto() string {
+	if x != nil {
+		return x.Photo
+	}
+	return ""
 }
 
-func (m *RoleResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoleResponse.Unmarshal(m, b)
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
 }
-func (m *RoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoleResponse.Marshal(b, m, deterministic)
+
+// Users are keyed by id. Ids that do not exist are left out.
+type UsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users map[uint64]*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
-func (m *RoleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoleResponse.Merge(m, src)
+
+func (x *UsersResponse) Reset() {
+	*x = UsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auth_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *RoleResponse) XXX_Size() int {
-	return xxx_messageInfo_RoleResponse.Size(m)
+
+func (x *UsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *RoleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoleResponse.DiscardUnknown(m)
+
+func (*UsersResponse) ProtoMessage() {}
+
+func (x *UsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_RoleResponse proto.InternalMessageInfo
+// Deprecated: Use UsersResponse.ProtoReflect.Descriptor instead.
+func (*UsersResponse) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{15}
+}
 
-func (m *RoleResponse) GetRole() string {
-	if m != nil {
-		return m.Role
+func (x *UsersResponse) GetUsers() map[uint64]*User {
+	if x != nil {
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*FindIdRequest)(nil), "auth.FindIdRequest")
-	proto.RegisterType((*FindIdResponse)(nil), "auth.FindIdResponse")
-	proto.RegisterType((*NamesAndPathsListRequest)(nil), "auth.NamesAndPathsListRequest")
-	proto.RegisterType((*NamesAndPathsResponse)(nil), "auth.NamesAndPathsResponse")
-	proto.RegisterType((*AuthorizationCheckRequest)(nil), "auth.AuthorizationCheckRequest")
-	proto.RegisterType((*AuthorizationCheckResponse)(nil), "auth.AuthorizationCheckResponse")
-	proto.RegisterType((*RoleRequest)(nil), "auth.RoleRequest")
-	proto.RegisterType((*RoleResponse)(nil), "auth.RoleResponse")
-}
-
-func init() {
-	proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874)
-}
-
-var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
-	0x14, 0xc6, 0xe9, 0x74, 0x32, 0x30, 0x0f, 0x66, 0x04, 0xa6, 0x8c, 0x42, 0x90, 0xa0, 0x18, 0x09,
-	0xcd, 0x82, 0x69, 0x50, 0xda, 0x0b, 0x94, 0x4a, 0x54, 0x95, 0x10, 0x54, 0x46, 0x62, 0x81, 0x84,
-	0x2a, 0x83, 0x0d, 0xb1, 0x1a, 0xe2, 0x12, 0x3b, 0x48, 0xb0, 0xe2, 0x06, 0x6c, 0xb9, 0x02, 0x3b,
-	0x58, 0xf0, 0x67, 0x03, 0x67, 0x70, 0x6f, 0x34, 0xb2, 0x9d, 0x56, 0x89, 0xd4, 0xac, 0xf2, 0xbe,
-	0xe7, 0xdf, 0xfb, 0x6c, 0x7f, 0x0e, 0x00, 0x2d, 0x75, 0x3a, 0x58, 0x15, 0x52, 0x4b, 0xb4, 0x6f,
-	0x6b, 0x7c, 0x17, 0x8e, 0x1e, 0x8b, 0x9c, 0xcd, 0x18, 0xe1, 0x1f, 0x4a, 0xae, 0x34, 0xba, 0x0a,
-	0x5d, 0x25, 0x58, 0xd8, 0xe9, 0x77, 0x4e, 0x0f, 0x89, 0x2d, 0xf1, 0x7d, 0x38, 0xde, 0x20, 0x6a,
-	0x25, 0x73, 0xc5, 0x51, 0x0f, 0x82, 0x8f, 0x34, 0x2b, 0xb9, 0xa3, 0xba, 0xc4, 0x0b, 0xfc, 0x00,
-	0xc2, 0xa7, 0xf4, 0x3d, 0x57, 0xe3, 0x9c, 0xcd, 0xa9, 0x4e, 0xd5, 0x13, 0xa1, 0x74, 0xcd, 0x55,
-	0x30, 0x15, 0x76, 0xfa, 0xdd, 0xd3, 0x80, 0xd8, 0x12, 0x4f, 0xe0, 0x46, 0x83, 0xae, 0x9b, 0xe7,
-	0x76, 0xc1, 0xc1, 0x87, 0xc4, 0x0b, 0xdb, 0x5d, 0x59, 0x2c, 0xdc, 0xf3, 0x5d, 0x27, 0xf0, 0x19,
-	0xdc, 0x1c, 0x97, 0x3a, 0x95, 0x85, 0xf8, 0x4c, 0xb5, 0x90, 0xf9, 0x24, 0xe5, 0x6f, 0x96, 0xed,
-	0x37, 0x19, 0x41, 0xb4, 0x0b, 0xaf, 0x36, 0x3e, 0x81, 0x03, 0xa5, 0xa9, 0x2e, 0x95, 0x1b, 0xb9,
-	0x44, 0x2a, 0x85, 0xef, 0xc1, 0x65, 0x22, 0x33, 0xbe, 0xb1, 0xed, 0x41, 0x90, 0xc9, 0x77, 0x22,
-	0xaf, 0x8c, 0xbd, 0xc0, 0x18, 0xae, 0x78, 0xa8, 0x32, 0x43, 0xb0, 0x5f, 0xc8, 0x8c, 0x57, 0x90,
-	0xab, 0x93, 0xef, 0x7b, 0x70, 0xd4, 0xd8, 0x1f, 0x8d, 0x20, 0x98, 0x72, 0x3d, 0x63, 0xe8, 0xfa,
-	0xc0, 0xbd, 0x4c, 0xe3, 0x29, 0xa2, 0x5e, 0xb3, 0xe9, 0x9d, 0xf1, 0x05, 0xf4, 0x0c, 0x8e, 0xdd,
-	0xd4, 0x36, 0x3b, 0x74, 0xdb, 0x93, 0x6d, 0xf1, 0x47, 0xb7, 0x76, 0xac, 0xd7, 0x0c, 0x5f, 0xc1,
-	0xc9, 0x94, 0xeb, 0xc6, 0xd1, 0x9e, 0xbb, 0xbb, 0xa3, 0x3b, 0x7e, 0xb0, 0x35, 0xe4, 0xa8, 0xdf,
-	0x0e, 0x6c, 0xed, 0x13, 0xb8, 0x38, 0xe5, 0xda, 0xc6, 0x83, 0xae, 0x79, 0xbc, 0x96, 0x67, 0x84,
-	0xea, 0xad, 0xcd, 0xcc, 0x23, 0xf2, 0x72, 0x1e, 0x9b, 0x6f, 0xe6, 0x8f, 0xf9, 0x6f, 0x7e, 0xae,
-	0xbf, 0x98, 0x1f, 0xe6, 0xb7, 0xf9, 0x65, 0xfe, 0x99, 0xbf, 0xeb, 0xaf, 0x71, 0xf2, 0x30, 0x19,
-	0x2e, 0x92, 0xc5, 0x8b, 0x65, 0x46, 0xd9, 0x27, 0x95, 0x8a, 0x33, 0xca, 0x98, 0xb0, 0xfb, 0xd2,
-	0x6c, 0xf1, 0x96, 0x53, 0x5d, 0x16, 0x5c, 0xc5, 0xb4, 0x7e, 0xa0, 0xd8, 0xfd, 0xf1, 0xaf, 0x0f,
-	0xdc, 0x67, 0x78, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xad, 0x7f, 0x03, 0x4a, 0x06, 0x03, 0x00, 0x00,
+var File_auth_proto protoreflect.FileDescriptor
+
+var file_auth_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x22, 0x21, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c, 0x0a,
+	0x18, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x22, 0x2d, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x22,
+	0x34, 0x0a, 0x1a, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x22, 0x0a, 0x0c, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x14,
+	0x0a, 0x12, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x0a, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x3b, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x22, 0x62, 0x0a, 0x11, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2e, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x20, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x6a, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
+	0x6f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x44, 0x0a, 0x0a,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x32, 0xf1, 0x03, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x49, 0x64, 0x12, 0x13, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x49, 0x64, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1e, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x41, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x12, 0x2e, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_auth_proto_rawDescOnce sync.Once
+	file_auth_proto_rawDescData = file_auth_proto_rawDesc
+)
+
+func file_auth_proto_rawDescGZIP() []byte {
+	file_auth_proto_rawDescOnce.Do(func() {
+		file_auth_proto_rawDescData = protoimpl.X.CompressGZIP(file_auth_proto_rawDescData)
+	})
+	return file_auth_proto_rawDescData
+}
+
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_auth_proto_goTypes = []interface{}{
+	(*FindIdRequest)(nil),              // 0: auth.FindIdRequest
+	(*FindIdResponse)(nil),             // 1: auth.FindIdResponse
+	(*NamesAndPathsListRequest)(nil),   // 2: auth.NamesAndPathsListRequest
+	(*NamesAndPathsResponse)(nil),      // 3: auth.NamesAndPathsResponse
+	(*AuthorizationCheckRequest)(nil),  // 4: auth.AuthorizationCheckRequest
+	(*AuthorizationCheckResponse)(nil), // 5: auth.AuthorizationCheckResponse
+	(*RoleRequest)(nil),                // 6: auth.RoleRequest
+	(*RoleResponse)(nil),               // 7: auth.RoleResponse
+	(*SigningKeysRequest)(nil),         // 8: auth.SigningKeysRequest
+	(*SigningKey)(nil),                 // 9: auth.SigningKey
+	(*SigningKeysResponse)(nil),        // 10: auth.SigningKeysResponse
+	(*PermissionRequest)(nil),          // 11: auth.PermissionRequest
+	(*PermissionResponse)(nil),         // 12: auth.PermissionResponse
+	(*UsersRequest)(nil),               // 13: auth.UsersRequest
+	(*User)(nil),                       // 14: auth.User
+	(*UsersResponse)(nil),              // 15: auth.UsersResponse
+	nil,                                // 16: auth.UsersResponse.UsersEntry
+}
+var file_auth_proto_depIdxs = []int32{
+	9,  // 0: auth.SigningKeysResponse.keys:type_name -> auth.SigningKey
+	16, // 1: auth.UsersResponse.users:type_name -> auth.UsersResponse.UsersEntry
+	14, // 2: auth.UsersResponse.UsersEntry.value:type_name -> auth.User
+	0,  // 3: auth.Authorization.GetId:input_type -> auth.FindIdRequest
+	2,  // 4: auth.Authorization.GetIdsAndPaths:input_type -> auth.NamesAndPathsListRequest
+	4,  // 5: auth.Authorization.GetAuthorizationStatus:input_type -> auth.AuthorizationCheckRequest
+	6,  // 6: auth.Authorization.GetRole:input_type -> auth.RoleRequest
+	8,  // 7: auth.Authorization.GetSigningKeys:input_type -> auth.SigningKeysRequest
+	11, // 8: auth.Authorization.CheckPermission:input_type -> auth.PermissionRequest
+	13, // 9: auth.Authorization.GetUsers:input_type -> auth.UsersRequest
+	1,  // 10: auth.Authorization.GetId:output_type -> auth.FindIdResponse
+	3,  // 11: auth.Authorization.GetIdsAndPaths:output_type -> auth.NamesAndPathsResponse
+	5,  // 12: auth.Authorization.GetAuthorizationStatus:output_type -> auth.AuthorizationCheckResponse
+	7,  // 13: auth.Authorization.GetRole:output_type -> auth.RoleResponse
+	10, // 14: auth.Authorization.GetSigningKeys:output_type -> auth.SigningKeysResponse
+	12, // 15: auth.Authorization.CheckPermission:output_type -> auth.PermissionResponse
+	15, // 16: auth.Authorization.GetUsers:output_type -> auth.UsersResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_auth_proto_init() }
+func file_auth_proto_init() {
+	if File_auth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_auth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamesAndPathsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamesAndPathsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationCheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationCheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningKeysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SigningKeysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auth_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_auth_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_proto_goTypes,
+		DependencyIndexes: file_auth_proto_depIdxs,
+		MessageInfos:      file_auth_proto_msgTypes,
+	}.Build()
+	File_auth_proto = out.File
+	file_auth_proto_rawDesc = nil
+	file_auth_proto_goTypes = nil
+	file_auth_proto_depIdxs = nil
 }
@@ -23,6 +23,9 @@ const (
 	Authorization_GetIdsAndPaths_FullMethodName         = "/auth.Authorization/GetIdsAndPaths"
 	Authorization_GetAuthorizationStatus_FullMethodName = "/auth.Authorization/GetAuthorizationStatus"
 	Authorization_GetRole_FullMethodName                = "/auth.Authorization/GetRole"
+	Authorization_GetSigningKeys_FullMethodName         = "/auth.Authorization/GetSigningKeys"
+	Authorization_CheckPermission_FullMethodName        = "/auth.Authorization/CheckPermission"
+	Authorization_GetUsers_FullMethodName               = "/auth.Authorization/GetUsers"
 )
 
 // AuthorizationClient is the client API for Authorization service.
@@ -30,9 +33,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthorizationClient interface {
 	GetId(ctx context.Context, in *FindIdRequest, opts ...grpc.CallOption) (*FindIdResponse, error)
+	// Deprecated: use GetUsers, the lists are shorter than the ids when a
+	// user is missing.
 	GetIdsAndPaths(ctx context.Context, in *NamesAndPathsListRequest, opts ...grpc.CallOption) (*NamesAndPathsResponse, error)
 	GetAuthorizationStatus(ctx context.Context, in *AuthorizationCheckRequest, opts ...grpc.CallOption) (*AuthorizationCheckResponse, error)
 	GetRole(ctx context.Context, in *RoleRequest, opts ...grpc.CallOption) (*RoleResponse, error)
+	GetSigningKeys(ctx context.Context, in *SigningKeysRequest, opts ...grpc.CallOption) (*SigningKeysResponse, error)
+	CheckPermission(ctx context.Context, in *PermissionRequest, opts ...grpc.CallOption) (*PermissionResponse, error)
+	GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
 }
 
 type authorizationClient struct {
@@ -79,14 +87,46 @@ func (c *authorizationClient) GetRole(ctx context.Context, in *RoleRequest, opts
 	return out, nil
 }
 
+func (c *authorizationClient) GetSigningKeys(ctx context.Context, in *SigningKeysRequest, opts ...grpc.CallOption) (*SigningKeysResponse, error) {
+	out := new(SigningKeysResponse)
+	err := c.cc.Invoke(ctx, Authorization_GetSigningKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorizationClient) CheckPermission(ctx context.Context, in *PermissionRequest, opts ...grpc.CallOption) (*PermissionResponse, error) {
+	out := new(PermissionResponse)
+	err := c.cc.Invoke(ctx, Authorization_CheckPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authorizationClient) GetUsers(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error) {
+	out := new(UsersResponse)
+	err := c.cc.Invoke(ctx, Authorization_GetUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthorizationServer is the server API for Authorization service.
 // All implementations must embed UnimplementedAuthorizationServer
 // for forward compatibility
 type AuthorizationServer interface {
 	GetId(context.Context, *FindIdRequest) (*FindIdResponse, error)
+	// Deprecated: use GetUsers, the lists are shorter than the ids when a
+	// user is missing.
 	GetIdsAndPaths(context.Context, *NamesAndPathsListRequest) (*NamesAndPathsResponse, error)
 	GetAuthorizationStatus(context.Context, *AuthorizationCheckRequest) (*AuthorizationCheckResponse, error)
 	GetRole(context.Context, *RoleRequest) (*RoleResponse, error)
+	GetSigningKeys(context.Context, *SigningKeysRequest) (*SigningKeysResponse, error)
+	CheckPermission(context.Context, *PermissionRequest) (*PermissionResponse, error)
+	GetUsers(context.Context, *UsersRequest) (*UsersResponse, error)
 	mustEmbedUnimplementedAuthorizationServer()
 }
 
@@ -106,6 +146,15 @@ func (UnimplementedAuthorizationServer) GetAuthorizationStatus(context.Context,
 func (UnimplementedAuthorizationServer) GetRole(context.Context, *RoleRequest) (*RoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
+func (UnimplementedAuthorizationServer) GetSigningKeys(context.Context, *SigningKeysRequest) (*SigningKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSigningKeys not implemented")
+}
+func (UnimplementedAuthorizationServer) CheckPermission(context.Context, *PermissionRequest) (*PermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedAuthorizationServer) GetUsers(context.Context, *UsersRequest) (*UsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
 func (UnimplementedAuthorizationServer) mustEmbedUnimplementedAuthorizationServer() {}
 
 // UnsafeAuthorizationServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +240,60 @@ func _Authorization_GetRole_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authorization_GetSigningKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SigningKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).GetSigningKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authorization_GetSigningKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).GetSigningKeys(ctx, req.(*SigningKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authorization_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authorization_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).CheckPermission(ctx, req.(*PermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authorization_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthorizationServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authorization_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthorizationServer).GetUsers(ctx, req.(*UsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Authorization_ServiceDesc is the grpc.ServiceDesc for Authorization service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +317,18 @@ var Authorization_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRole",
 			Handler:    _Authorization_GetRole_Handler,
 		},
+		{
+			MethodName: "GetSigningKeys",
+			Handler:    _Authorization_GetSigningKeys_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Authorization_CheckPermission_Handler,
+		},
+		{
+			MethodName: "GetUsers",
+			Handler:    _Authorization_GetUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
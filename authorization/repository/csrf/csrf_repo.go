@@ -2,6 +2,7 @@ package csrf
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"sync"
 	"time"
@@ -13,6 +14,8 @@ import (
 
 var mutex sync.RWMutex
 
+var ErrConnectionLost = errors.New("redis csrf connection lost")
+
 type CsrfRepo struct {
 	csrfRedisClient *redis.Client
 	Connection      bool
@@ -62,9 +65,13 @@ func (redisRepo *CsrfRepo) AddCsrf(ctx context.Context, active models.Csrf, lg *
 		return false, nil
 	}
 
-	redisRepo.csrfRedisClient.Set(ctx, active.SID, active.SID, 3*time.Hour)
+	err := redisRepo.csrfRedisClient.Set(ctx, active.SID, active.Session, time.Until(active.ExpiresAt)).Err()
+	if err != nil {
+		lg.Error("Error, cannot create csrf token", "err", err.Error())
+		return false, err
+	}
 
-	csrfAdded, err_check := redisRepo.CheckActiveCsrf(ctx, active.SID, lg)
+	_, csrfAdded, err_check := redisRepo.GetCsrfSession(ctx, active.SID, lg)
 
 	if err_check != nil {
 		lg.Error("Error, cannot create csrf token " + err_check.Error())
@@ -74,32 +81,108 @@ func (redisRepo *CsrfRepo) AddCsrf(ctx context.Context, active models.Csrf, lg *
 	return csrfAdded, nil
 }
 
-func (redisRepo *CsrfRepo) CheckActiveCsrf(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
+// GetCsrfSession returns the session binding stored for the token.
+func (redisRepo *CsrfRepo) GetCsrfSession(ctx context.Context, sid string, lg *slog.Logger) (string, bool, error) {
 	if !redisRepo.Connection {
 		lg.Error("Redis csrf connection lost")
-		return false, nil
+		return "", false, nil
 	}
 
-	_, err := redisRepo.csrfRedisClient.Get(ctx, sid).Result()
+	session, err := redisRepo.csrfRedisClient.Get(ctx, sid).Result()
 	if err == redis.Nil {
-		lg.Error("Key " + sid + " not found")
-		return false, nil
+		lg.Error("csrf token not found")
+		return "", false, nil
 	}
 
 	if err != nil {
-		lg.Error("Get request could not be completed ", err)
-		return false, err
+		lg.Error("Get request could not be completed", "err", err.Error())
+		return "", false, err
 	}
 
-	return true, nil
+	return session, true, nil
 }
 
 func (redisRepo *CsrfRepo) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
 	_, err := redisRepo.csrfRedisClient.Del(ctx, sid).Result()
 	if err != nil {
-		lg.Error("Delete request could not be completed:", err)
+		lg.Error("Delete request could not be completed", "err", err.Error())
 		return false, err
 	}
 
 	return true, nil
 }
+
+// AddToken stores a single-use token with its value for ttl. Email
+// verification and password reset tokens live here next to CSRF tokens.
+func (redisRepo *CsrfRepo) AddToken(ctx context.Context, key string, value string, ttl time.Duration, lg *slog.Logger) error {
+	if !redisRepo.Connection {
+		lg.Error("Redis csrf connection lost")
+		return ErrConnectionLost
+	}
+
+	err := redisRepo.csrfRedisClient.Set(ctx, key, value, ttl).Err()
+	if err != nil {
+		lg.Error("Error, cannot create token", "err", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeToken returns the value of the token and deletes it, so the token
+// can be used only once.
+func (redisRepo *CsrfRepo) ConsumeToken(ctx context.Context, key string, lg *slog.Logger) (string, bool, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis csrf connection lost")
+		return "", false, ErrConnectionLost
+	}
+
+	value, err := redisRepo.csrfRedisClient.GetDel(ctx, key).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	}
+
+	if err != nil {
+		lg.Error("GetDel request could not be completed", "err", err.Error())
+		return "", false, err
+	}
+
+	return value, true, nil
+}
+
+// GetToken returns the value of the token without consuming it.
+func (redisRepo *CsrfRepo) GetToken(ctx context.Context, key string, lg *slog.Logger) (string, bool, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis csrf connection lost")
+		return "", false, ErrConnectionLost
+	}
+
+	value, err := redisRepo.csrfRedisClient.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	}
+
+	if err != nil {
+		lg.Error("Get request could not be completed", "err", err.Error())
+		return "", false, err
+	}
+
+	return value, true, nil
+}
+
+// MarkUsed records the key for ttl and reports false if it was already
+// recorded. It is used to reject replayed one-time codes.
+func (redisRepo *CsrfRepo) MarkUsed(ctx context.Context, key string, ttl time.Duration, lg *slog.Logger) (bool, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis csrf connection lost")
+		return false, ErrConnectionLost
+	}
+
+	added, err := redisRepo.csrfRedisClient.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		lg.Error("SetNX request could not be completed", "err", err.Error())
+		return false, err
+	}
+
+	return added, nil
+}
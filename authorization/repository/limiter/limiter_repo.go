@@ -0,0 +1,185 @@
+package limiter
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-redis/redis/v8"
+)
+
+var mutex sync.RWMutex
+
+// LimiterRepo keeps sign in failure counters and lockouts. For every
+// limited key (a login or a client IP) it stores the number of failures
+// under failuresKey(key) and, while the key is locked out, a marker under
+// lockKey(key) that expires together with the lockout. Fixed window quotas,
+// such as the number of letters per address, are counted under
+// quotaKey(key).
+type LimiterRepo struct {
+	limiterRedisClient *redis.Client
+	Connection         bool
+}
+
+func (redisRepo *LimiterRepo) CheckRedisLimiterConnection(limiterCfg configs.DbRedisCfg) {
+	ctx := context.Background()
+	for {
+		_, err := redisRepo.limiterRedisClient.Ping(ctx).Result()
+		mutex.Lock()
+		redisRepo.Connection = err == nil
+		mutex.Unlock()
+		time.Sleep(time.Duration(limiterCfg.Timer) * time.Second)
+	}
+}
+
+func GetLimiterRepo(limiterCfg configs.DbRedisCfg, lg *slog.Logger) (*LimiterRepo, error) {
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     limiterCfg.Host,
+		Password: limiterCfg.Password,
+		DB:       limiterCfg.DbNumber,
+	})
+
+	ctx := context.Background()
+	_, err := redisClient.Ping(ctx).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	limiterRepo := LimiterRepo{
+		limiterRedisClient: redisClient,
+		Connection:         true,
+	}
+
+	go limiterRepo.CheckRedisLimiterConnection(limiterCfg)
+
+	return &limiterRepo, nil
+}
+
+func failuresKey(key string) string {
+	return "signin_failures:" + key
+}
+
+func lockKey(key string) string {
+	return "signin_lock:" + key
+}
+
+func quotaKey(key string) string {
+	return "quota:" + key
+}
+
+// Hit counts one more use of the key and returns the number of uses within
+// the window together with the time left until the window ends. The window
+// starts with the first use.
+func (redisRepo *LimiterRepo) Hit(ctx context.Context, key string, window time.Duration, lg *slog.Logger) (int64, time.Duration, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis limiter connection lost")
+		return 0, 0, nil
+	}
+
+	hits, err := redisRepo.limiterRedisClient.Incr(ctx, quotaKey(key)).Result()
+	if err != nil {
+		lg.Error("Incr request could not be completed", "err", err.Error())
+		return 0, 0, err
+	}
+
+	ttl, err := redisRepo.limiterRedisClient.PTTL(ctx, quotaKey(key)).Result()
+	if err != nil {
+		lg.Error("PTTL request could not be completed", "err", err.Error())
+		return 0, 0, err
+	}
+
+	if hits == 1 || ttl < 0 {
+		err = redisRepo.limiterRedisClient.Expire(ctx, quotaKey(key), window).Err()
+		if err != nil {
+			lg.Error("Expire request could not be completed", "err", err.Error())
+			return 0, 0, err
+		}
+		ttl = window
+	}
+
+	return hits, ttl, nil
+}
+
+// AddFailure counts one more failure for the key and returns the total
+// within the window. The window starts with the first failure.
+func (redisRepo *LimiterRepo) AddFailure(ctx context.Context, key string, window time.Duration, lg *slog.Logger) (int64, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis limiter connection lost")
+		return 0, nil
+	}
+
+	failures, err := redisRepo.limiterRedisClient.Incr(ctx, failuresKey(key)).Result()
+	if err != nil {
+		lg.Error("Incr request could not be completed", "err", err.Error())
+		return 0, err
+	}
+
+	if failures == 1 {
+		err = redisRepo.limiterRedisClient.Expire(ctx, failuresKey(key), window).Err()
+		if err != nil {
+			lg.Error("Expire request could not be completed", "err", err.Error())
+			return 0, err
+		}
+	}
+
+	return failures, nil
+}
+
+// Lock locks the key out for lockout. The failure counter is kept at least
+// until the lockout ends, so the next failure backs off further.
+func (redisRepo *LimiterRepo) Lock(ctx context.Context, key string, lockout time.Duration, lg *slog.Logger) error {
+	if !redisRepo.Connection {
+		lg.Error("Redis limiter connection lost")
+		return nil
+	}
+
+	_, err := redisRepo.limiterRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, lockKey(key), 1, lockout)
+		pipe.Expire(ctx, failuresKey(key), 2*lockout)
+		return nil
+	})
+	if err != nil {
+		lg.Error("Lock request could not be completed", "err", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// LockedFor returns how long the key stays locked out, or zero.
+func (redisRepo *LimiterRepo) LockedFor(ctx context.Context, key string, lg *slog.Logger) (time.Duration, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis limiter connection lost")
+		return 0, nil
+	}
+
+	ttl, err := redisRepo.limiterRedisClient.PTTL(ctx, lockKey(key)).Result()
+	if err != nil {
+		lg.Error("PTTL request could not be completed", "err", err.Error())
+		return 0, err
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// Reset forgets the failures of the key and lifts its lockout.
+func (redisRepo *LimiterRepo) Reset(ctx context.Context, key string, lg *slog.Logger) error {
+	if !redisRepo.Connection {
+		lg.Error("Redis limiter connection lost")
+		return nil
+	}
+
+	err := redisRepo.limiterRedisClient.Del(ctx, failuresKey(key), lockKey(key)).Err()
+	if err != nil {
+		lg.Error("Delete request could not be completed", "err", err.Error())
+		return err
+	}
+
+	return nil
+}
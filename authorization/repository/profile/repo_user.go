@@ -11,26 +11,48 @@ import (
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/lib/pq"
 )
 
 type IUserRepo interface {
-	GetUser(login string, password string) (*models.UserItem, bool, error)
+	GetUser(login string) (*models.UserItem, bool, error)
 	GetUserProfileId(login string) (int64, error)
 	FindUser(login string) (bool, error)
 	CreateUser(login string, password string, name string, birthDate string, email string) error
 	GetUserProfile(login string) (*models.UserItem, error)
 	EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error
 	GetNamesAndPaths(ids []int32) ([]string, []string, error)
-	CheckUserPassword(login string, password string) (bool, error)
+	GetUsersByIds(ids []uint64) ([]models.UserItem, error)
+	UpdatePassword(login string, password string) error
 	GetUserRole(login string) (string, error)
 	IsSubscribed(login string) (bool, error)
 	ChangeSubsribe(login string, isSubscribed bool) error
-	FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error)
+	FindUsers(login string, role string, page pagination.Page) ([]models.UserItem, string, error)
+	CountUsers(login string, role string) (uint64, error)
 	ChangeUsersRole(login string, role string) error
+	GetLoginByEmail(email string) (string, bool, error)
+	SetEmailVerified(login string, email string) (bool, error)
+	IsEmailVerified(login string) (bool, error)
+	GetTotp(login string) (string, bool, error)
+	SetTotpSecret(login string, secret string) error
+	EnableTotp(login string, recoveryCodes []string) error
+	DisableTotp(login string) error
+	UseRecoveryCode(login string, code string) (bool, error)
+	IsMfaRequired(role string) (bool, error)
+	SetMfaRequired(role string, required bool) error
+	GetUserLogin(id int64) (string, bool, error)
+	HasPermission(login string, permission string) (bool, error)
+	GetExternalIdentity(provider string, subject string) (string, bool, error)
+	LinkExternalIdentity(login string, provider string, subject string, email string) error
+	CreateExternalUser(login string, password string, name string, email string, emailVerified bool, provider string, subject string) error
+	GetLinkedProviders(login string) ([]string, error)
+	DeleteUser(login string) error
 }
 
+var ErrUserNotFound = errors.New("user not found")
+
 type RepoPostgre struct {
 	db *sql.DB
 }
@@ -67,36 +89,29 @@ func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
 	}
 }
 
-func (repo *RepoPostgre) CheckUserPassword(login string, password string) (bool, error) {
+func (repo *RepoPostgre) GetUser(login string) (*models.UserItem, bool, error) {
 	post := &models.UserItem{}
 
 	err := repo.db.QueryRow(
-		"SELECT login FROM profile "+
-			"WHERE login = $1 AND password = $2", login, password).Scan(&post.Login)
+		"SELECT login, photo, password FROM profile "+
+			"WHERE login = $1", login).Scan(&post.Login, &post.Photo, &post.Password)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, nil
+			return nil, false, nil
 		}
-		return false, fmt.Errorf("CheckUserPassword err: %w", err)
+		return nil, false, fmt.Errorf("GetUser err: %w", err)
 	}
 
-	return true, nil
+	return post, true, nil
 }
 
-func (repo *RepoPostgre) GetUser(login string, password string) (*models.UserItem, bool, error) {
-	post := &models.UserItem{}
-
-	err := repo.db.QueryRow(
-		"SELECT login, photo FROM profile "+
-			"WHERE login = $1 AND password = $2", login, password).Scan(&post.Login, &post.Photo)
+func (repo *RepoPostgre) UpdatePassword(login string, password string) error {
+	_, err := repo.db.Exec("UPDATE profile SET password = $1 WHERE login = $2", password, login)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, false, nil
-		}
-		return nil, false, fmt.Errorf("GetUser err: %w", err)
+		return fmt.Errorf("update password err: %w", err)
 	}
 
-	return post, true, nil
+	return nil
 }
 
 func (repo *RepoPostgre) FindUser(login string) (bool, error) {
@@ -122,7 +137,7 @@ func (repo *RepoPostgre) GetUserProfileId(login string) (int64, error) {
 		"SELECT id FROM profile WHERE login = $1", login).Scan(&userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, fmt.Errorf("User not found for login: %s", login)
+			return 0, fmt.Errorf("%w for login: %s", ErrUserNotFound, login)
 		}
 		return 0, fmt.Errorf("GetUserProfileID error: %w", err)
 	}
@@ -169,12 +184,35 @@ func (repo *RepoPostgre) GetNamesAndPaths(ids []int32) ([]string, []string, erro
 	return names, paths, nil
 }
 
+// GetUsersByIds returns the users with the given ids. Ids that do not
+// exist are skipped.
+func (repo *RepoPostgre) GetUsersByIds(ids []uint64) ([]models.UserItem, error) {
+	users := []models.UserItem{}
+
+	rows, err := repo.db.Query(
+		"SELECT id, login, name, photo, role FROM profile WHERE id = ANY ($1::BIGINT[])", pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("get users by ids err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user := models.UserItem{}
+		if err := rows.Scan(&user.Id, &user.Login, &user.Name, &user.Photo, &user.Role); err != nil {
+			return nil, fmt.Errorf("get users by ids scan err: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
 func (repo *RepoPostgre) GetUserProfile(login string) (*models.UserItem, error) {
 	post := &models.UserItem{}
 
 	err := repo.db.QueryRow(
-		"SELECT name, birth_date, login, email, photo FROM profile "+
-			"WHERE login = $1", login).Scan(&post.Name, &post.Birthdate, &post.Login, &post.Email, &post.Photo)
+		"SELECT name, birth_date, login, email, photo, email_verified FROM profile "+
+			"WHERE login = $1", login).Scan(&post.Name, &post.Birthdate, &post.Login, &post.Email, &post.Photo, &post.EmailVerified)
 	if err != nil {
 		return nil, fmt.Errorf("GetUserProfile err: %w", err)
 	}
@@ -206,7 +244,10 @@ func (repo *RepoPostgre) EditProfile(prevLogin string, login string, password st
 		if paramNum != 1 {
 			s.WriteString(", ")
 		}
+		// The right-hand side sees the old email, so the verification
+		// survives only when the email stays the same.
 		s.WriteString("email = $" + strconv.Itoa(paramNum))
+		s.WriteString(", email_verified = email_verified AND email = $" + strconv.Itoa(paramNum))
 		paramNum++
 		params = append(params, email)
 	}
@@ -267,14 +308,19 @@ func (repo *RepoPostgre) ChangeSubsribe(login string, isSubscribed bool) error {
 	return nil
 }
 
-func (repo *RepoPostgre) FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error) {
-	users := []models.UserItem{}
+// usersOrder is the order of the found users, by their ids.
+var usersOrder = pagination.Order{
+	Columns: []pagination.Column{{Expr: "id", Type: "BIGINT"}},
+}
 
+// writeUserFilter writes the FROM and the WHERE clauses of the users with
+// the login and the role, the empty ones match all, and returns the params
+// of them. It tells if there is a WHERE clause.
+func writeUserFilter(s *strings.Builder, login string, role string) ([]interface{}, bool) {
 	var hasWhere bool
 	paramNum := 1
 	var params []interface{}
-	var s strings.Builder
-	s.WriteString("SELECT id, login, photo, role FROM profile ")
+	s.WriteString("FROM profile ")
 	if login != "" {
 		s.WriteString("WHERE login = $1 ")
 		hasWhere = true
@@ -288,28 +334,63 @@ func (repo *RepoPostgre) FindUsers(login string, role string, first, limit uint6
 			s.WriteString("AND ")
 		}
 		s.WriteString("role = $" + strconv.Itoa(paramNum) + " ")
-		paramNum++
+		hasWhere = true
 		params = append(params, role)
 	}
-	s.WriteString("LIMIT $" + strconv.Itoa(paramNum) + " OFFSET $" + strconv.Itoa(paramNum+1))
-	params = append(params, limit, first)
+
+	return params, hasWhere
+}
+
+func (repo *RepoPostgre) FindUsers(login string, role string, page pagination.Page) ([]models.UserItem, string, error) {
+	users := []models.UserItem{}
+
+	var s strings.Builder
+	s.WriteString("SELECT id, login, photo, role, " + usersOrder.Key() + " ")
+	params, hasWhere := writeUserFilter(&s, login, role)
+	pageParams, err := page.Write(&s, usersOrder, hasWhere, len(params)+1)
+	if err != nil {
+		return nil, "", fmt.Errorf("find users err: %w", err)
+	}
+	params = append(params, pageParams...)
 
 	rows, err := repo.db.Query(s.String(), params...)
 	if err != nil {
-		return nil, fmt.Errorf("find users err: %w", err)
+		return nil, "", fmt.Errorf("find users err: %w", err)
 	}
 	defer rows.Close()
 
+	var next string
+	var lastKey []string
 	for rows.Next() {
 		post := models.UserItem{}
-		err := rows.Scan(&post.Id, &post.Login, &post.Photo, &post.Role)
+		key, keyDest := usersOrder.KeyDest()
+		err := rows.Scan(append([]interface{}{&post.Id, &post.Login, &post.Photo, &post.Role}, keyDest...)...)
 		if err != nil {
-			return nil, fmt.Errorf("find users scan err: %w", err)
+			return nil, "", fmt.Errorf("find users scan err: %w", err)
+		}
+		if page.Full(len(users)) {
+			next = pagination.Encode(lastKey)
+			break
 		}
 		users = append(users, post)
+		lastKey = key
 	}
 
-	return users, nil
+	return users, next, nil
+}
+
+func (repo *RepoPostgre) CountUsers(login string, role string) (uint64, error) {
+	var s strings.Builder
+	s.WriteString("SELECT COUNT(*) ")
+	params, _ := writeUserFilter(&s, login, role)
+
+	var count uint64
+	err := repo.db.QueryRow(s.String(), params...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count users err: %w", err)
+	}
+
+	return count, nil
 }
 
 func (repo *RepoPostgre) ChangeUsersRole(login string, role string) error {
@@ -320,3 +401,268 @@ func (repo *RepoPostgre) ChangeUsersRole(login string, role string) error {
 
 	return nil
 }
+
+func (repo *RepoPostgre) GetLoginByEmail(email string) (string, bool, error) {
+	var login string
+
+	err := repo.db.QueryRow("SELECT login FROM profile WHERE email = $1", email).Scan(&login)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("get login by email err: %w", err)
+	}
+
+	return login, true, nil
+}
+
+// SetEmailVerified marks the email of the login as verified. It reports
+// false if the login no longer has this email.
+func (repo *RepoPostgre) SetEmailVerified(login string, email string) (bool, error) {
+	result, err := repo.db.Exec("UPDATE profile SET email_verified = TRUE WHERE login = $1 AND email = $2", login, email)
+	if err != nil {
+		return false, fmt.Errorf("set email verified err: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("set email verified err: %w", err)
+	}
+
+	return updated > 0, nil
+}
+
+func (repo *RepoPostgre) IsEmailVerified(login string) (bool, error) {
+	var verified bool
+
+	err := repo.db.QueryRow("SELECT email_verified FROM profile WHERE login = $1", login).Scan(&verified)
+	if err != nil {
+		return false, fmt.Errorf("is email verified err: %w", err)
+	}
+
+	return verified, nil
+}
+
+// GetTotp returns the TOTP secret of the login and whether 2FA is enabled.
+// The secret of an unfinished enrollment is returned with enabled false.
+func (repo *RepoPostgre) GetTotp(login string) (string, bool, error) {
+	var secret string
+	var enabled bool
+
+	err := repo.db.QueryRow(
+		"SELECT COALESCE(totp_secret, ''), totp_enabled FROM profile WHERE login = $1", login).Scan(&secret, &enabled)
+	if err != nil {
+		return "", false, fmt.Errorf("get totp err: %w", err)
+	}
+
+	return secret, enabled, nil
+}
+
+func (repo *RepoPostgre) SetTotpSecret(login string, secret string) error {
+	_, err := repo.db.Exec(
+		"UPDATE profile SET totp_secret = $1, totp_enabled = FALSE, totp_recovery_codes = '{}' WHERE login = $2",
+		secret, login)
+	if err != nil {
+		return fmt.Errorf("set totp secret err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) EnableTotp(login string, recoveryCodes []string) error {
+	_, err := repo.db.Exec(
+		"UPDATE profile SET totp_enabled = TRUE, totp_recovery_codes = $1 WHERE login = $2",
+		pq.Array(recoveryCodes), login)
+	if err != nil {
+		return fmt.Errorf("enable totp err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) DisableTotp(login string) error {
+	_, err := repo.db.Exec(
+		"UPDATE profile SET totp_secret = NULL, totp_enabled = FALSE, totp_recovery_codes = '{}' WHERE login = $1",
+		login)
+	if err != nil {
+		return fmt.Errorf("disable totp err: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode removes the recovery code digest from the profile and
+// reports whether it was there.
+func (repo *RepoPostgre) UseRecoveryCode(login string, code string) (bool, error) {
+	result, err := repo.db.Exec(
+		"UPDATE profile SET totp_recovery_codes = array_remove(totp_recovery_codes, $1) "+
+			"WHERE login = $2 AND $1 = ANY(totp_recovery_codes)", code, login)
+	if err != nil {
+		return false, fmt.Errorf("use recovery code err: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("use recovery code err: %w", err)
+	}
+
+	return updated > 0, nil
+}
+
+func (repo *RepoPostgre) IsMfaRequired(role string) (bool, error) {
+	var required bool
+
+	err := repo.db.QueryRow(
+		"SELECT EXISTS(SELECT 1 FROM mfa_required_role WHERE role = $1)", role).Scan(&required)
+	if err != nil {
+		return false, fmt.Errorf("is mfa required err: %w", err)
+	}
+
+	return required, nil
+}
+
+func (repo *RepoPostgre) SetMfaRequired(role string, required bool) error {
+	var err error
+	if required {
+		_, err = repo.db.Exec("INSERT INTO mfa_required_role(role) VALUES($1) ON CONFLICT DO NOTHING", role)
+	} else {
+		_, err = repo.db.Exec("DELETE FROM mfa_required_role WHERE role = $1", role)
+	}
+	if err != nil {
+		return fmt.Errorf("set mfa required err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) GetUserLogin(id int64) (string, bool, error) {
+	var login string
+
+	err := repo.db.QueryRow("SELECT login FROM profile WHERE id = $1", id).Scan(&login)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("get user login err: %w", err)
+	}
+
+	return login, true, nil
+}
+
+// HasPermission reports whether the role of the user grants the permission.
+// A role that requires 2FA grants nothing until the user has enabled it.
+func (repo *RepoPostgre) HasPermission(login string, permission string) (bool, error) {
+	var allowed bool
+
+	err := repo.db.QueryRow(
+		"SELECT EXISTS(SELECT 1 FROM profile "+
+			"JOIN role_permission ON role_permission.role = profile.role "+
+			"WHERE profile.login = $1 AND role_permission.permission = $2 "+
+			"AND (profile.totp_enabled OR NOT EXISTS(SELECT 1 FROM mfa_required_role WHERE mfa_required_role.role = profile.role)))",
+		login, permission).Scan(&allowed)
+	if err != nil {
+		return false, fmt.Errorf("has permission err: %w", err)
+	}
+
+	return allowed, nil
+}
+
+// GetExternalIdentity returns the login of the profile the identity of the
+// OAuth provider is linked to.
+func (repo *RepoPostgre) GetExternalIdentity(provider string, subject string) (string, bool, error) {
+	var login string
+
+	err := repo.db.QueryRow(
+		"SELECT profile.login FROM external_identity "+
+			"JOIN profile ON profile.id = external_identity.profile_id "+
+			"WHERE external_identity.provider = $1 AND external_identity.subject = $2", provider, subject).Scan(&login)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("get external identity err: %w", err)
+	}
+
+	return login, true, nil
+}
+
+func (repo *RepoPostgre) LinkExternalIdentity(login string, provider string, subject string, email string) error {
+	_, err := repo.db.Exec(
+		"INSERT INTO external_identity(provider, subject, profile_id, email) "+
+			"SELECT $1, $2, id, NULLIF($3, '') FROM profile WHERE login = $4", provider, subject, email, login)
+	if err != nil {
+		return fmt.Errorf("link external identity err: %w", err)
+	}
+
+	return nil
+}
+
+// CreateExternalUser creates a profile for a user signing up through an
+// OAuth provider together with the link to the identity.
+func (repo *RepoPostgre) CreateExternalUser(login string, password string, name string, email string, emailVerified bool,
+	provider string, subject string) error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return fmt.Errorf("create external user err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var id int64
+	err = tx.QueryRow(
+		"INSERT INTO profile(name, photo, login, password, email, email_verified, registration_date) "+
+			"VALUES($1, '/avatars/default.jpg', $2, $3, $4, $5, CURRENT_TIMESTAMP) RETURNING id",
+		name, login, password, email, emailVerified).Scan(&id)
+	if err != nil {
+		return fmt.Errorf("create external user err: %w", err)
+	}
+
+	_, err = tx.Exec(
+		"INSERT INTO external_identity(provider, subject, profile_id, email) VALUES($1, $2, $3, NULLIF($4, ''))",
+		provider, subject, id, email)
+	if err != nil {
+		return fmt.Errorf("create external user err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("create external user err: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) GetLinkedProviders(login string) ([]string, error) {
+	providers := []string{}
+
+	rows, err := repo.db.Query(
+		"SELECT external_identity.provider FROM external_identity "+
+			"JOIN profile ON profile.id = external_identity.profile_id "+
+			"WHERE profile.login = $1", login)
+	if err != nil {
+		return nil, fmt.Errorf("get linked providers err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var provider string
+		err := rows.Scan(&provider)
+		if err != nil {
+			return nil, fmt.Errorf("get linked providers scan err: %w", err)
+		}
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+// DeleteUser deletes the profile. Linked identities go with it.
+func (repo *RepoPostgre) DeleteUser(login string) error {
+	_, err := repo.db.Exec("DELETE FROM profile WHERE login = $1", login)
+	if err != nil {
+		return fmt.Errorf("delete user err: %w", err)
+	}
+
+	return nil
+}
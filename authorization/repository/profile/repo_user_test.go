@@ -1,6 +1,7 @@
 package profile
 
 import (
+	"database/sql"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -8,6 +9,8 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/lib/pq"
 )
 
 func TestGetUser(t *testing.T) {
@@ -17,25 +20,26 @@ func TestGetUser(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"photo", "login"})
+	rows := sqlmock.NewRows([]string{"login", "photo", "password"})
 
 	testUser := models.UserItem{
-		Photo: "url1",
-		Login: "l1",
+		Photo:    "url1",
+		Login:    "l1",
+		Password: "$argon2id$v=19$m=65536,t=1,p=4$c2FsdA$a2V5",
 	}
 	expect := []*models.UserItem{&testUser}
 
 	for _, item := range expect {
-		rows = rows.AddRow(item.Login, item.Photo)
+		rows = rows.AddRow(item.Login, item.Photo, item.Password)
 	}
 
-	mock.ExpectQuery("SELECT login, photo FROM profile WHERE").WithArgs(expect[0].Login, expect[0].Password).WillReturnRows(rows)
+	mock.ExpectQuery("SELECT login, photo, password FROM profile WHERE").WithArgs(expect[0].Login).WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	user, foundAccount, err := repo.GetUser(expect[0].Login, expect[0].Password)
+	user, foundAccount, err := repo.GetUser(expect[0].Login)
 	if err != nil {
 		t.Errorf("GetUser error: %s", err)
 	}
@@ -54,11 +58,11 @@ func TestGetUser(t *testing.T) {
 	}
 
 	mock.
-		ExpectQuery("SELECT login, photo FROM profile WHERE").
-		WithArgs(expect[0].Login, expect[0].Password).
+		ExpectQuery("SELECT login, photo, password FROM profile WHERE").
+		WithArgs(expect[0].Login).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, found, err := repo.GetUser(expect[0].Login, expect[0].Password)
+	_, found, err := repo.GetUser(expect[0].Login)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -128,6 +132,47 @@ func TestFindUser(t *testing.T) {
 	}
 }
 
+func TestUpdatePassword(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	login := "l1"
+	hash := "$argon2id$v=19$m=65536,t=1,p=4$c2FsdA$a2V5"
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET password = $1 WHERE login = $2")).
+		WithArgs(hash, login).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.UpdatePassword(login, hash)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET password = $1 WHERE login = $2")).
+		WithArgs(hash, login).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	err = repo.UpdatePassword(login, hash)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
 func TestCreateUser(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -209,7 +254,7 @@ func TestEditProfile(t *testing.T) {
 	}
 
 	mock.ExpectExec(
-		regexp.QuoteMeta("UPDATE profile SET login = $1, photo = $2, email = $3, password = $4, birth_date = $5 WHERE login = $6")).
+		regexp.QuoteMeta("UPDATE profile SET login = $1, photo = $2, email = $3, email_verified = email_verified AND email = $3, password = $4, birth_date = $5 WHERE login = $6")).
 		WithArgs(testUser.Login, testUser.Photo, testUser.Email, testUser.Password, testUser.Birthdate, prev).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -228,7 +273,7 @@ func TestEditProfile(t *testing.T) {
 	}
 
 	mock.ExpectExec(
-		regexp.QuoteMeta("UPDATE profile SET login = $1, photo = $2, email = $3, password = $4, birth_date = $5 WHERE login = $6")).
+		regexp.QuoteMeta("UPDATE profile SET login = $1, photo = $2, email = $3, email_verified = email_verified AND email = $3, password = $4, birth_date = $5 WHERE login = $6")).
 		WithArgs(testUser.Login, testUser.Password, testUser.Photo, testUser.Email, testUser.Birthdate, prev).
 		WillReturnError(fmt.Errorf("db_error"))
 
@@ -238,3 +283,374 @@ func TestEditProfile(t *testing.T) {
 		return
 	}
 }
+
+func TestGetLoginByEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"login"}).AddRow("l1")
+
+	mock.ExpectQuery("SELECT login FROM profile WHERE email").WithArgs("e1@mail.ru").WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	login, found, err := repo.GetLoginByEmail("e1@mail.ru")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found || login != "l1" {
+		t.Errorf("expected login l1, got %q", login)
+		return
+	}
+
+	mock.ExpectQuery("SELECT login FROM profile WHERE email").WithArgs("e2@mail.ru").WillReturnError(sql.ErrNoRows)
+
+	_, found, err = repo.GetLoginByEmail("e2@mail.ru")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("expected not found")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestSetEmailVerified(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET email_verified = TRUE WHERE login = $1 AND email = $2")).
+		WithArgs("l1", "e1@mail.ru").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	updated, err := repo.SetEmailVerified("l1", "e1@mail.ru")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !updated {
+		t.Errorf("expected email to be verified")
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET email_verified = TRUE WHERE login = $1 AND email = $2")).
+		WithArgs("l1", "old@mail.ru").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	updated, err = repo.SetEmailVerified("l1", "old@mail.ru")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if updated {
+		t.Errorf("expected stale email not to be verified")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET totp_recovery_codes = array_remove(totp_recovery_codes, $1) WHERE login = $2 AND $1 = ANY(totp_recovery_codes)")).
+		WithArgs("h1", "l1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	used, err := repo.UseRecoveryCode("l1", "h1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !used {
+		t.Errorf("expected recovery code to be used")
+		return
+	}
+
+	mock.ExpectExec(
+		regexp.QuoteMeta("UPDATE profile SET totp_recovery_codes = array_remove(totp_recovery_codes, $1) WHERE login = $2 AND $1 = ANY(totp_recovery_codes)")).
+		WithArgs("h1", "l1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	used, err = repo.UseRecoveryCode("l1", "h1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if used {
+		t.Errorf("expected used recovery code to be rejected")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestGetTotp(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"totp_secret", "totp_enabled"}).AddRow("SECRET", true)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(totp_secret, ''), totp_enabled FROM profile WHERE login = $1")).
+		WithArgs("l1").
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	secret, enabled, err := repo.GetTotp("l1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if secret != "SECRET" || !enabled {
+		t.Errorf("wrong totp: %s %t", secret, enabled)
+		return
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(totp_secret, ''), totp_enabled FROM profile WHERE login = $1")).
+		WithArgs("l1").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, _, err = repo.GetTotp("l1")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+func TestHasPermission(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	query := regexp.QuoteMeta("SELECT EXISTS(SELECT 1 FROM profile JOIN role_permission ON role_permission.role = profile.role WHERE profile.login = $1 AND role_permission.permission = $2 " +
+		"AND (profile.totp_enabled OR NOT EXISTS(SELECT 1 FROM mfa_required_role WHERE mfa_required_role.role = profile.role)))")
+
+	mock.ExpectQuery(query).
+		WithArgs("l1", "films.add").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	allowed, err := repo.HasPermission("l1", "films.add")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !allowed {
+		t.Errorf("expected permission to be granted")
+		return
+	}
+
+	mock.ExpectQuery(query).
+		WithArgs("l1", "films.add").
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.HasPermission("l1", "films.add")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestCreateExternalUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO profile(name, photo, login, password, email, email_verified, registration_date)")).
+		WithArgs("n1", "yandex_l1", "p1", "e1@mail.ru", true).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO external_identity(provider, subject, profile_id, email)")).
+		WithArgs("yandex", "s1", int64(7), "e1@mail.ru").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.CreateExternalUser("yandex_l1", "p1", "n1", "e1@mail.ru", true, "yandex", "s1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO profile(name, photo, login, password, email, email_verified, registration_date)")).
+		WithArgs("n1", "yandex_l1", "p1", "e1@mail.ru", true).
+		WillReturnError(fmt.Errorf("db_error"))
+	mock.ExpectRollback()
+
+	err = repo.CreateExternalUser("yandex_l1", "p1", "n1", "e1@mail.ru", true, "yandex", "s1")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestGetUsersByIds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	query := regexp.QuoteMeta("SELECT id, login, name, photo, role FROM profile WHERE id = ANY ($1::BIGINT[])")
+
+	expect := []models.UserItem{
+		{Id: 1, Login: "l1", Name: "n1", Photo: "p1", Role: "user"},
+		{Id: 3, Login: "l3", Name: "n3", Photo: "p3", Role: "admin"},
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "login", "name", "photo", "role"})
+	for _, item := range expect {
+		rows = rows.AddRow(item.Id, item.Login, item.Name, item.Photo, item.Role)
+	}
+
+	mock.ExpectQuery(query).
+		WithArgs(pq.Array([]uint64{1, 2, 3})).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	users, err := repo.GetUsersByIds([]uint64{1, 2, 3})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(users, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, users)
+		return
+	}
+
+	mock.ExpectQuery(query).
+		WithArgs(pq.Array([]uint64{1})).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetUsersByIds([]uint64{1})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestFindUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Login", "Photo", "Role", "Id"}).
+		AddRow(4, "l4", "p4", "admin", "4").
+		AddRow(7, "l7", "p7", "admin", "7")
+
+	expect := []models.UserItem{
+		{Id: 4, Login: "l4", Photo: "p4", Role: "admin"},
+	}
+
+	query := "SELECT id, login, photo, role, id::TEXT FROM profile WHERE role = $1 " +
+		"AND (id) > ($2::BIGINT) ORDER BY id ASC LIMIT $3 OFFSET $4"
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("admin", "2", 2, 0).
+		WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM profile WHERE login = $1 AND role = $2 ")).
+		WithArgs("l4", "admin").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	users, next, err := repo.FindUsers("", "admin", pagination.Page{After: []string{"2"}, Limit: 1})
+	if err != nil {
+		t.Errorf("FindUsers error: %s", err)
+	}
+	if !reflect.DeepEqual(users, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, users)
+		return
+	}
+	if want := pagination.Encode([]string{"4"}); next != want {
+		t.Errorf("results not match, want %v, have %v", want, next)
+		return
+	}
+
+	count, err := repo.CountUsers("l4", "admin")
+	if err != nil {
+		t.Errorf("CountUsers error: %s", err)
+	}
+	if count != 1 {
+		t.Errorf("results not match, want %v, have %v", 1, count)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -1,9 +1,25 @@
 package session
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
 
 type Session struct {
 	Login     string
 	SID       string
 	ExpiresAt time.Time
+	CreatedAt time.Time
+	LastSeen  time.Time
+	UserAgent string
+	IP        string
+	Remember  bool
+}
+
+// PublicID identifies the session in listings and revoke requests, so the
+// SID itself never has to leave the cookie.
+func (s Session) PublicID() string {
+	sum := sha256.Sum256([]byte("session:" + s.SID))
+	return hex.EncodeToString(sum[:8])
 }
@@ -3,6 +3,7 @@ package session
 import (
 	"context"
 	"log/slog"
+	"strconv"
 	"sync"
 	"time"
 
@@ -12,9 +13,18 @@ import (
 
 var mutex sync.RWMutex
 
+const (
+	defaultAbsoluteTimeout = 24 * time.Hour
+	defaultRememberTimeout = 30 * 24 * time.Hour
+)
+
 type SessionRepo struct {
 	sessionRedisClient *redis.Client
 	Connection         bool
+
+	absoluteTimeout time.Duration
+	idleTimeout     time.Duration
+	rememberTimeout time.Duration
 }
 
 func (redisRepo *SessionRepo) CheckRedisSessionConnection(sessionCfg configs.DbRedisCfg) {
@@ -46,6 +56,15 @@ func GetSessionRepo(sessionCfg configs.DbRedisCfg, lg *slog.Logger) (*SessionRep
 	sessionRepo := SessionRepo{
 		sessionRedisClient: redisClient,
 		Connection:         true,
+		absoluteTimeout:    sessionCfg.AbsoluteTimeout,
+		idleTimeout:        sessionCfg.IdleTimeout,
+		rememberTimeout:    sessionCfg.RememberTimeout,
+	}
+	if sessionRepo.absoluteTimeout <= 0 {
+		sessionRepo.absoluteTimeout = defaultAbsoluteTimeout
+	}
+	if sessionRepo.rememberTimeout <= 0 {
+		sessionRepo.rememberTimeout = defaultRememberTimeout
 	}
 
 	go sessionRepo.CheckRedisSessionConnection(sessionCfg)
@@ -53,13 +72,78 @@ func GetSessionRepo(sessionCfg configs.DbRedisCfg, lg *slog.Logger) (*SessionRep
 	return &sessionRepo, nil
 }
 
+// Besides the SID -> login key every session keeps a metadata hash under
+// metaKey(sid), and every login keeps the set of its SIDs under
+// userSessionsKey(login). SIDs whose keys have expired are dropped from the
+// set whenever a session is added or the set is read, and the set itself
+// expires together with the longest living session of the login.
+func metaKey(sid string) string {
+	return "session:" + sid
+}
+
+func userSessionsKey(login string) string {
+	return "sessions:" + login
+}
+
+// Lifetime returns the absolute lifetime of a new session.
+func (redisRepo *SessionRepo) Lifetime(remember bool) time.Duration {
+	if remember {
+		return redisRepo.rememberTimeout
+	}
+
+	return redisRepo.absoluteTimeout
+}
+
+// idleTTL returns the TTL the session keys get on creation and on every
+// access: the idle timeout, but never past the absolute expiry. "Remember
+// me" sessions are not limited by the idle timeout.
+func (redisRepo *SessionRepo) idleTTL(expiresAt time.Time, remember bool) time.Duration {
+	ttl := time.Until(expiresAt)
+	if !remember && redisRepo.idleTimeout > 0 && redisRepo.idleTimeout < ttl {
+		ttl = redisRepo.idleTimeout
+	}
+
+	return ttl
+}
+
 func (redisRepo *SessionRepo) AddSession(ctx context.Context, active Session, lg *slog.Logger) (bool, error) {
 	if !redisRepo.Connection {
 		lg.Error("Redis session connection lost")
 		return false, nil
 	}
 
-	redisRepo.sessionRedisClient.Set(ctx, active.SID, active.Login, 24*time.Hour)
+	ttl := redisRepo.idleTTL(active.ExpiresAt, active.Remember)
+
+	redisRepo.pruneUserSessions(ctx, active.Login, lg)
+
+	setTTL, err := redisRepo.sessionRedisClient.TTL(ctx, userSessionsKey(active.Login)).Result()
+	if err != nil {
+		lg.Error("TTL request could not be completed", "err", err.Error())
+		return false, err
+	}
+
+	_, err = redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, active.SID, active.Login, ttl)
+		pipe.HSet(ctx, metaKey(active.SID),
+			"login", active.Login,
+			"created_at", active.CreatedAt.Unix(),
+			"last_seen", active.LastSeen.Unix(),
+			"user_agent", active.UserAgent,
+			"ip", active.IP,
+			"expires_at", active.ExpiresAt.Unix(),
+			"remember", active.Remember,
+		)
+		pipe.Expire(ctx, metaKey(active.SID), ttl)
+		pipe.SAdd(ctx, userSessionsKey(active.Login), active.SID)
+		if lifetime := time.Until(active.ExpiresAt); lifetime > setTTL {
+			pipe.Expire(ctx, userSessionsKey(active.Login), lifetime)
+		}
+		return nil
+	})
+	if err != nil {
+		lg.Error("Add session request could not be completed", "err", err.Error())
+		return false, err
+	}
 
 	sessionAdded, err_check := redisRepo.CheckActiveSession(ctx, active.SID, lg)
 
@@ -78,10 +162,12 @@ func (redisRepo *SessionRepo) GetUserLogin(ctx context.Context, sid string, lg *
 
 	value, err := redisRepo.sessionRedisClient.Get(ctx, sid).Result()
 	if err != nil {
-		lg.Error("Error, cannot find session " + sid)
+		lg.Error("Error, cannot find session")
 		return "", err
 	}
 
+	redisRepo.touch(ctx, sid, lg)
+
 	return value, nil
 }
 
@@ -93,24 +179,186 @@ func (redisRepo *SessionRepo) CheckActiveSession(ctx context.Context, sid string
 
 	_, err := redisRepo.sessionRedisClient.Get(ctx, sid).Result()
 	if err == redis.Nil {
-		lg.Error("Key " + sid + " not found")
+		lg.Error("session not found")
 		return false, nil
 	}
 
 	if err != nil {
-		lg.Error("Get request could not be completed ", err)
+		lg.Error("Get request could not be completed", "err", err.Error())
 		return false, err
 	}
 
+	redisRepo.touch(ctx, sid, lg)
+
 	return true, nil
 }
 
+// touch records the last time the session was used and slides its idle
+// expiry forward.
+func (redisRepo *SessionRepo) touch(ctx context.Context, sid string, lg *slog.Logger) {
+	meta, err := redisRepo.sessionRedisClient.HMGet(ctx, metaKey(sid), "expires_at", "remember").Result()
+	if err != nil {
+		lg.Error("HMGet request could not be completed", "err", err.Error())
+		return
+	}
+
+	expiresAt, remember := parseExpiry(meta[0], meta[1])
+	if expiresAt.IsZero() {
+		return
+	}
+
+	ttl := redisRepo.idleTTL(expiresAt, remember)
+	if ttl <= 0 {
+		return
+	}
+
+	_, err = redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, metaKey(sid), "last_seen", time.Now().Unix())
+		pipe.Expire(ctx, sid, ttl)
+		pipe.Expire(ctx, metaKey(sid), ttl)
+		return nil
+	})
+	if err != nil {
+		lg.Error("Touch session request could not be completed", "err", err.Error())
+	}
+}
+
+// parseExpiry decodes the expires_at and remember fields of the session
+// metadata. Sessions created before they were stored get a zero time.
+func parseExpiry(expiresAt interface{}, remember interface{}) (time.Time, bool) {
+	value, ok := expiresAt.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	flag, _ := remember.(string)
+	return time.Unix(unix, 0), flag == "1"
+}
+
+// pruneUserSessions drops the SIDs of expired sessions from the set of the
+// login.
+func (redisRepo *SessionRepo) pruneUserSessions(ctx context.Context, login string, lg *slog.Logger) {
+	sids, err := redisRepo.sessionRedisClient.SMembers(ctx, userSessionsKey(login)).Result()
+	if err != nil {
+		lg.Error("SMembers request could not be completed", "err", err.Error())
+		return
+	}
+
+	for _, sid := range sids {
+		exists, err := redisRepo.sessionRedisClient.Exists(ctx, sid).Result()
+		if err != nil {
+			lg.Error("Exists request could not be completed", "err", err.Error())
+			return
+		}
+
+		if exists == 0 {
+			redisRepo.sessionRedisClient.SRem(ctx, userSessionsKey(login), sid)
+		}
+	}
+}
+
 func (redisRepo *SessionRepo) DeleteSession(ctx context.Context, sid string, lg *slog.Logger) (bool, error) {
-	_, err := redisRepo.sessionRedisClient.Del(ctx, sid).Result()
+	login, err := redisRepo.sessionRedisClient.Get(ctx, sid).Result()
+	if err != nil && err != redis.Nil {
+		lg.Error("Get request could not be completed", "err", err.Error())
+		return false, err
+	}
+
+	_, err = redisRepo.sessionRedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sid, metaKey(sid))
+		if login != "" {
+			pipe.SRem(ctx, userSessionsKey(login), sid)
+		}
+		return nil
+	})
 	if err != nil {
-		lg.Error("Delete request could not be completed:", err)
+		lg.Error("Delete request could not be completed", "err", err.Error())
 		return false, err
 	}
 
 	return true, nil
 }
+
+// GetUserSessions returns the active sessions of the login.
+func (redisRepo *SessionRepo) GetUserSessions(ctx context.Context, login string, lg *slog.Logger) ([]Session, error) {
+	if !redisRepo.Connection {
+		lg.Error("Redis session connection lost")
+		return nil, nil
+	}
+
+	sids, err := redisRepo.sessionRedisClient.SMembers(ctx, userSessionsKey(login)).Result()
+	if err != nil {
+		lg.Error("SMembers request could not be completed", "err", err.Error())
+		return nil, err
+	}
+
+	sessions := []Session{}
+	for _, sid := range sids {
+		meta, err := redisRepo.sessionRedisClient.HGetAll(ctx, metaKey(sid)).Result()
+		if err != nil {
+			lg.Error("HGetAll request could not be completed", "err", err.Error())
+			return nil, err
+		}
+
+		ttl, err := redisRepo.sessionRedisClient.TTL(ctx, sid).Result()
+		if err != nil {
+			lg.Error("TTL request could not be completed", "err", err.Error())
+			return nil, err
+		}
+
+		if len(meta) == 0 || ttl < 0 {
+			redisRepo.sessionRedisClient.SRem(ctx, userSessionsKey(login), sid)
+			continue
+		}
+
+		createdAt, _ := strconv.ParseInt(meta["created_at"], 10, 64)
+		lastSeen, _ := strconv.ParseInt(meta["last_seen"], 10, 64)
+		expiresAt, remember := parseExpiry(meta["expires_at"], meta["remember"])
+		if expiresAt.IsZero() {
+			expiresAt = time.Now().Add(ttl)
+		}
+
+		sessions = append(sessions, Session{
+			Login:     login,
+			SID:       sid,
+			ExpiresAt: expiresAt,
+			CreatedAt: time.Unix(createdAt, 0),
+			LastSeen:  time.Unix(lastSeen, 0),
+			UserAgent: meta["user_agent"],
+			IP:        meta["ip"],
+			Remember:  remember,
+		})
+	}
+
+	return sessions, nil
+}
+
+// DeleteUserSessions kills every session of the login except the one with
+// SID except, which may be empty. It returns the number of killed sessions.
+func (redisRepo *SessionRepo) DeleteUserSessions(ctx context.Context, login string, except string, lg *slog.Logger) (int, error) {
+	sids, err := redisRepo.sessionRedisClient.SMembers(ctx, userSessionsKey(login)).Result()
+	if err != nil {
+		lg.Error("SMembers request could not be completed", "err", err.Error())
+		return 0, err
+	}
+
+	var killed int
+	for _, sid := range sids {
+		if sid == except {
+			continue
+		}
+
+		_, err := redisRepo.DeleteSession(ctx, sid, lg)
+		if err != nil {
+			return killed, err
+		}
+		killed++
+	}
+
+	return killed, nil
+}
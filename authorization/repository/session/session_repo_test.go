@@ -0,0 +1,503 @@
+package session
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func TestIdleTTL(t *testing.T) {
+	repo := SessionRepo{
+		absoluteTimeout: 24 * time.Hour,
+		idleTimeout:     2 * time.Hour,
+		rememberTimeout: 30 * 24 * time.Hour,
+	}
+
+	ttl := repo.idleTTL(time.Now().Add(24*time.Hour), false)
+	if ttl != 2*time.Hour {
+		t.Errorf("expected idle timeout, got %s", ttl)
+		return
+	}
+
+	ttl = repo.idleTTL(time.Now().Add(time.Hour), false)
+	if ttl > time.Hour || ttl < 59*time.Minute {
+		t.Errorf("ttl must not pass the absolute expiry, got %s", ttl)
+		return
+	}
+
+	ttl = repo.idleTTL(time.Now().Add(30*24*time.Hour), true)
+	if ttl < 29*24*time.Hour {
+		t.Errorf("remember me session must not be limited by idle timeout, got %s", ttl)
+		return
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	expiresAt, remember := parseExpiry("1700000000", "1")
+	if expiresAt.Unix() != 1700000000 || !remember {
+		t.Errorf("wrong expiry parsed: %s %t", expiresAt, remember)
+		return
+	}
+
+	expiresAt, remember = parseExpiry(nil, nil)
+	if !expiresAt.IsZero() || remember {
+		t.Errorf("expected zero expiry for legacy session")
+		return
+	}
+}
+
+func getTestRepo(t *testing.T) (*SessionRepo, *fakeRedis) {
+	srv := newFakeRedis(t)
+	client := redis.NewClient(&redis.Options{Addr: srv.ln.Addr().String()})
+	t.Cleanup(func() { client.Close() })
+
+	repo := &SessionRepo{
+		sessionRedisClient: client,
+		Connection:         true,
+		absoluteTimeout:    24 * time.Hour,
+		idleTimeout:        2 * time.Hour,
+		rememberTimeout:    30 * 24 * time.Hour,
+	}
+
+	return repo, srv
+}
+
+func newSession(sid string, remember bool) Session {
+	lifetime := 24 * time.Hour
+	if remember {
+		lifetime = 30 * 24 * time.Hour
+	}
+
+	return Session{
+		Login:     "l1",
+		SID:       sid,
+		CreatedAt: time.Now(),
+		LastSeen:  time.Now(),
+		ExpiresAt: time.Now().Add(lifetime),
+		UserAgent: "ua-" + sid,
+		IP:        "127.0.0.1",
+		Remember:  remember,
+	}
+}
+
+func TestAddSession(t *testing.T) {
+	repo, _ := getTestRepo(t)
+	lg := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	for _, active := range []Session{newSession("s1", false), newSession("s2", true)} {
+		added, err := repo.AddSession(ctx, active, lg)
+		if err != nil || !added {
+			t.Errorf("session %s not added: %v", active.SID, err)
+			return
+		}
+	}
+
+	sessions, err := repo.GetUserSessions(ctx, "l1", lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(sessions) != 2 {
+		t.Errorf("expected 2 sessions, got %d", len(sessions))
+		return
+	}
+	for _, active := range sessions {
+		if active.UserAgent != "ua-"+active.SID || active.IP != "127.0.0.1" || active.Remember != (active.SID == "s2") {
+			t.Errorf("wrong session metadata: %+v", active)
+			return
+		}
+	}
+
+	ttl, err := repo.sessionRedisClient.TTL(ctx, userSessionsKey("l1")).Result()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if ttl < 29*24*time.Hour || ttl > 30*24*time.Hour {
+		t.Errorf("sessions set must live as long as the longest session, got %s", ttl)
+		return
+	}
+}
+
+func TestExpiredSessions(t *testing.T) {
+	repo, srv := getTestRepo(t)
+	lg := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	_, err := repo.AddSession(ctx, newSession("s1", false), lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	srv.fastForward(3 * time.Hour)
+
+	_, err = repo.AddSession(ctx, newSession("s2", false), lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+
+	sids, err := repo.sessionRedisClient.SMembers(ctx, userSessionsKey("l1")).Result()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(sids) != 1 || sids[0] != "s2" {
+		t.Errorf("expired session must be pruned on add, got %v", sids)
+		return
+	}
+
+	srv.fastForward(3 * time.Hour)
+
+	sessions, err := repo.GetUserSessions(ctx, "l1", lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(sessions) != 0 {
+		t.Errorf("expected no sessions, got %d", len(sessions))
+		return
+	}
+
+	srv.fastForward(24 * time.Hour)
+
+	exists, err := repo.sessionRedisClient.Exists(ctx, userSessionsKey("l1")).Result()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if exists != 0 {
+		t.Errorf("sessions set must expire with the sessions")
+		return
+	}
+}
+
+func TestDeleteUserSessions(t *testing.T) {
+	repo, _ := getTestRepo(t)
+	lg := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	ctx := context.Background()
+
+	for _, sid := range []string{"s1", "s2", "s3"} {
+		_, err := repo.AddSession(ctx, newSession(sid, false), lg)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err.Error())
+			return
+		}
+	}
+
+	killed, err := repo.DeleteUserSessions(ctx, "l1", "s2", lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if killed != 2 {
+		t.Errorf("expected 2 killed sessions, got %d", killed)
+		return
+	}
+
+	found, err := repo.CheckActiveSession(ctx, "s1", lg)
+	if err != nil || found {
+		t.Errorf("session s1 must be deleted")
+		return
+	}
+
+	sessions, err := repo.GetUserSessions(ctx, "l1", lg)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+		return
+	}
+	if len(sessions) != 1 || sessions[0].SID != "s2" {
+		t.Errorf("only the kept session must be left, got %v", sessions)
+		return
+	}
+
+	deleted, err := repo.DeleteSession(ctx, "s2", lg)
+	if err != nil || !deleted {
+		t.Errorf("session s2 not deleted: %v", err)
+		return
+	}
+
+	sids, err := repo.sessionRedisClient.SMembers(ctx, userSessionsKey("l1")).Result()
+	if err != nil || len(sids) != 0 {
+		t.Errorf("deleted session must leave the set, got %v", sids)
+		return
+	}
+}
+
+// fakeRedis is a minimal in-memory Redis server that speaks just enough of
+// RESP for the session repository.
+type fakeRedis struct {
+	mu      sync.Mutex
+	ln      net.Listener
+	now     time.Time
+	strings map[string]string
+	hashes  map[string]map[string]string
+	sets    map[string]map[string]bool
+	expires map[string]time.Time
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen error: %s", err.Error())
+	}
+
+	srv := &fakeRedis{
+		ln:      ln,
+		now:     time.Now(),
+		strings: map[string]string{},
+		hashes:  map[string]map[string]string{},
+		sets:    map[string]map[string]bool{},
+		expires: map[string]time.Time{},
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+
+	return srv
+}
+
+// fastForward moves the clock of the server, expiring keys on the way.
+func (srv *fakeRedis) fastForward(d time.Duration) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	srv.now = srv.now.Add(d)
+}
+
+func (srv *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	var queue [][]string
+	var multi bool
+	for {
+		args, err := readCommand(reader)
+		if err != nil {
+			return
+		}
+
+		var out bytes.Buffer
+		switch name := strings.ToLower(args[0]); {
+		case name == "multi":
+			multi = true
+			out.WriteString("+OK\r\n")
+		case name == "exec":
+			fmt.Fprintf(&out, "*%d\r\n", len(queue))
+			for _, cmd := range queue {
+				out.WriteString(srv.exec(cmd))
+			}
+			queue, multi = nil, false
+		case multi:
+			queue = append(queue, args)
+			out.WriteString("+QUEUED\r\n")
+		default:
+			out.WriteString(srv.exec(args))
+		}
+
+		if _, err = conn.Write(out.Bytes()); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		line, err = reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+
+		value := make([]byte, size+2)
+		if _, err = io.ReadFull(reader, value); err != nil {
+			return nil, err
+		}
+		args = append(args, string(value[:size]))
+	}
+
+	return args, nil
+}
+
+func bulk(value string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+}
+
+func integer(value int) string {
+	return fmt.Sprintf(":%d\r\n", value)
+}
+
+func array(values []string) string {
+	reply := fmt.Sprintf("*%d\r\n", len(values))
+	for _, value := range values {
+		reply += bulk(value)
+	}
+	return reply
+}
+
+func (srv *fakeRedis) exists(key string) bool {
+	if deadline, ok := srv.expires[key]; ok && !srv.now.Before(deadline) {
+		delete(srv.strings, key)
+		delete(srv.hashes, key)
+		delete(srv.sets, key)
+		delete(srv.expires, key)
+	}
+
+	_, isString := srv.strings[key]
+	_, isHash := srv.hashes[key]
+	_, isSet := srv.sets[key]
+	return isString || isHash || isSet
+}
+
+func (srv *fakeRedis) exec(args []string) string {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	key := ""
+	if len(args) > 1 {
+		key = args[1]
+		srv.exists(key)
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "ping":
+		return "+PONG\r\n"
+	case "set":
+		srv.strings[key] = args[2]
+		delete(srv.expires, key)
+		if len(args) == 5 {
+			amount, _ := strconv.Atoi(args[4])
+			unit := time.Second
+			if strings.ToLower(args[3]) == "px" {
+				unit = time.Millisecond
+			}
+			srv.expires[key] = srv.now.Add(time.Duration(amount) * unit)
+		}
+		return "+OK\r\n"
+	case "get":
+		value, ok := srv.strings[key]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(value)
+	case "exists":
+		if srv.exists(key) {
+			return integer(1)
+		}
+		return integer(0)
+	case "del":
+		var deleted int
+		for _, name := range args[1:] {
+			if srv.exists(name) {
+				deleted++
+			}
+			delete(srv.strings, name)
+			delete(srv.hashes, name)
+			delete(srv.sets, name)
+			delete(srv.expires, name)
+		}
+		return integer(deleted)
+	case "expire":
+		if !srv.exists(key) {
+			return integer(0)
+		}
+		seconds, _ := strconv.Atoi(args[2])
+		srv.expires[key] = srv.now.Add(time.Duration(seconds) * time.Second)
+		return integer(1)
+	case "ttl":
+		if !srv.exists(key) {
+			return integer(-2)
+		}
+		deadline, ok := srv.expires[key]
+		if !ok {
+			return integer(-1)
+		}
+		return integer(int(deadline.Sub(srv.now) / time.Second))
+	case "hset":
+		if srv.hashes[key] == nil {
+			srv.hashes[key] = map[string]string{}
+		}
+		for i := 2; i+1 < len(args); i += 2 {
+			srv.hashes[key][args[i]] = args[i+1]
+		}
+		return integer((len(args) - 2) / 2)
+	case "hmget":
+		reply := fmt.Sprintf("*%d\r\n", len(args)-2)
+		for _, field := range args[2:] {
+			value, ok := srv.hashes[key][field]
+			if !ok {
+				reply += "$-1\r\n"
+				continue
+			}
+			reply += bulk(value)
+		}
+		return reply
+	case "hgetall":
+		values := []string{}
+		for field, value := range srv.hashes[key] {
+			values = append(values, field, value)
+		}
+		return array(values)
+	case "sadd":
+		if srv.sets[key] == nil {
+			srv.sets[key] = map[string]bool{}
+		}
+		for _, member := range args[2:] {
+			srv.sets[key][member] = true
+		}
+		return integer(len(args) - 2)
+	case "srem":
+		for _, member := range args[2:] {
+			delete(srv.sets[key], member)
+		}
+		if len(srv.sets[key]) == 0 {
+			delete(srv.sets, key)
+		}
+		return integer(len(args) - 2)
+	case "smembers":
+		members := []string{}
+		for member := range srv.sets[key] {
+			members = append(members, member)
+		}
+		sort.Strings(members)
+		return array(members)
+	}
+
+	return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+}
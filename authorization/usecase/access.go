@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
+)
+
+const (
+	refreshPurpose = "refresh"
+
+	defaultAccessTTL  = 5 * time.Minute
+	maxAccessTTL      = 15 * time.Minute
+	defaultRefreshTTL = 30 * 24 * time.Hour
+)
+
+// TokenPair is issued next to the session cookie when access tokens are
+// enabled. The access token lets other services authenticate the user
+// without asking this service; the refresh token gets a new pair while
+// the session is alive.
+//
+// Access tokens are verified locally and cannot be revoked: after logout,
+// a session revoke, a password reset or an account deletion the refresh
+// token dies with the session, but an access token already issued stays
+// valid until it expires. That is why its lifetime is capped at
+// maxAccessTTL.
+type TokenPair struct {
+	Access    string
+	Refresh   string
+	ExpiresAt time.Time
+}
+
+func (core *Core) TokensEnabled() bool {
+	return core.tokens.Enabled
+}
+
+// IssueTokens returns a new token pair for the session sid of login.
+func (core *Core) IssueTokens(ctx context.Context, login string, sid string) (*TokenPair, error) {
+	userId, err := core.users.GetUserProfileId(login)
+	if err != nil {
+		core.lg.Error("issue tokens error", "err", err.Error())
+		return nil, fmt.Errorf("issue tokens err: %w", err)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(core.tokens.AccessTTL)
+
+	access, err := token.Sign(core.keys.Active, token.Claims{
+		Subject:   login,
+		UserId:    uint64(userId),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: expiresAt.Unix(),
+	})
+	if err != nil {
+		core.lg.Error("issue tokens error", "err", err.Error())
+		return nil, fmt.Errorf("issue tokens err: %w", err)
+	}
+
+	refresh, err := core.issueToken(ctx, refreshPurpose, url.Values{
+		"login": {login},
+		"sid":   {sid},
+	}, core.tokens.RefreshTTL)
+	if err != nil {
+		core.lg.Error("issue tokens error", "err", err.Error())
+		return nil, fmt.Errorf("issue tokens err: %w", err)
+	}
+
+	return &TokenPair{Access: access, Refresh: refresh, ExpiresAt: expiresAt}, nil
+}
+
+// RefreshTokens exchanges a refresh token for a new pair. Refresh tokens
+// are single-use and die together with the session they were issued for.
+func (core *Core) RefreshTokens(ctx context.Context, refresh string) (*TokenPair, error) {
+	values, err := core.consumeToken(ctx, refreshPurpose, refresh)
+	if err != nil {
+		return nil, err
+	}
+	login, sid := values.Get("login"), values.Get("sid")
+
+	core.mutex.RLock()
+	sessionLogin, err := core.sessions.GetUserLogin(ctx, sid, core.lg)
+	core.mutex.RUnlock()
+
+	if err != nil || sessionLogin != login {
+		return nil, ErrInvalidToken
+	}
+
+	return core.IssueTokens(ctx, login, sid)
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+)
+
+const (
+	verifyEmailPurpose   = "verify_email"
+	resetPasswordPurpose = "reset_password"
+
+	defaultVerifyTTL = 48 * time.Hour
+	defaultResetTTL  = time.Hour
+)
+
+var (
+	ErrInvalidToken    = errors.New("invalid token")
+	ErrNotVerified     = errors.New("email not verified")
+	ErrAlreadyVerified = errors.New("email already verified")
+)
+
+func validEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email
+}
+
+// issueToken stores a single-use token for the purpose and returns it
+// signed. The values are kept in the store, the token is only a reference.
+func (core *Core) issueToken(ctx context.Context, purpose string, values url.Values, ttl time.Duration) (string, error) {
+	id, err := GenerateToken()
+	if err != nil {
+		return "", err
+	}
+
+	err = core.csrfTokens.AddToken(ctx, purpose+":"+id, values.Encode(), ttl, core.lg)
+	if err != nil {
+		return "", err
+	}
+
+	return signToken(core.tokenSecret, purpose, id), nil
+}
+
+// consumeToken checks the token and returns its values. A token can be
+// consumed only once.
+func (core *Core) consumeToken(ctx context.Context, purpose string, token string) (url.Values, error) {
+	id, ok := verifyToken(core.tokenSecret, purpose, token)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	value, found, err := core.csrfTokens.ConsumeToken(ctx, purpose+":"+id, core.lg)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrInvalidToken
+	}
+
+	values, err := url.ParseQuery(value)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return values, nil
+}
+
+func (core *Core) link(path string, token string) string {
+	return core.account.LinkBase + path + "?token=" + url.QueryEscape(token)
+}
+
+// SendVerificationEmail mails the user a link that confirms the current
+// email of the profile. The letters are limited per address and per client
+// IP; when over the limit ErrTooManyAttempts is returned with the time to
+// wait.
+func (core *Core) SendVerificationEmail(ctx context.Context, login string, ip string) (time.Duration, error) {
+	profile, err := core.users.GetUserProfile(login)
+	if err != nil {
+		core.lg.Error("send verification error", "err", err.Error())
+		return 0, fmt.Errorf("send verification err: %w", err)
+	}
+	if profile.EmailVerified {
+		return 0, ErrAlreadyVerified
+	}
+
+	retryAfter, err := core.mailRetryAfter(ctx, profile.Email, ip)
+	if err != nil {
+		return 0, fmt.Errorf("send verification err: %w", err)
+	}
+	if retryAfter > 0 {
+		return retryAfter, ErrTooManyAttempts
+	}
+
+	return 0, core.sendVerification(ctx, login, profile.Email)
+}
+
+func (core *Core) sendVerification(ctx context.Context, login string, email string) error {
+	token, err := core.issueToken(ctx, verifyEmailPurpose, url.Values{
+		"login": {login},
+		"email": {email},
+	}, core.account.VerifyTTL)
+	if err != nil {
+		core.lg.Error("send verification error", "err", err.Error())
+		return fmt.Errorf("send verification err: %w", err)
+	}
+
+	body := "Здравствуйте, " + login + "!\n\n" +
+		"Чтобы подтвердить почту, перейдите по ссылке:\n" + core.link("/verify_email", token) + "\n\n" +
+		"Если вы не регистрировались на movie-hub, просто проигнорируйте это письмо.\n"
+
+	err = core.mailer.Send(email, "Подтверждение почты", body)
+	if err != nil {
+		core.lg.Error("send verification error", "err", err.Error())
+		return fmt.Errorf("send verification err: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmail confirms the email the token was issued for. The token is
+// rejected if the profile has changed its email since.
+func (core *Core) VerifyEmail(ctx context.Context, token string) error {
+	values, err := core.consumeToken(ctx, verifyEmailPurpose, token)
+	if err != nil {
+		return err
+	}
+
+	updated, err := core.users.SetEmailVerified(values.Get("login"), values.Get("email"))
+	if err != nil {
+		core.lg.Error("verify email error", "err", err.Error())
+		return fmt.Errorf("verify email err: %w", err)
+	}
+	if !updated {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+// ForgotPassword mails a password reset link to the owner of the email.
+// An unknown email is not reported, so the endpoint cannot be used to
+// find out who is registered; it counts against the same per address and
+// per client IP limits as a known one.
+func (core *Core) ForgotPassword(ctx context.Context, email string, ip string) (time.Duration, error) {
+	retryAfter, err := core.mailRetryAfter(ctx, email, ip)
+	if err != nil {
+		return 0, fmt.Errorf("forgot password err: %w", err)
+	}
+	if retryAfter > 0 {
+		return retryAfter, ErrTooManyAttempts
+	}
+
+	login, found, err := core.users.GetLoginByEmail(email)
+	if err != nil {
+		core.lg.Error("forgot password error", "err", err.Error())
+		return 0, fmt.Errorf("forgot password err: %w", err)
+	}
+	if !found {
+		return 0, nil
+	}
+
+	token, err := core.issueToken(ctx, resetPasswordPurpose, url.Values{"login": {login}}, core.account.ResetTTL)
+	if err != nil {
+		core.lg.Error("forgot password error", "err", err.Error())
+		return 0, fmt.Errorf("forgot password err: %w", err)
+	}
+
+	body := "Здравствуйте, " + login + "!\n\n" +
+		"Чтобы задать новый пароль, перейдите по ссылке:\n" + core.link("/password/reset", token) + "\n\n" +
+		"Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо.\n"
+
+	err = core.mailer.Send(email, "Сброс пароля", body)
+	if err != nil {
+		core.lg.Error("forgot password error", "err", err.Error())
+		return 0, fmt.Errorf("forgot password err: %w", err)
+	}
+
+	return 0, nil
+}
+
+// ResetPassword sets a new password for the user the token was issued to
+// and logs the user out everywhere.
+func (core *Core) ResetPassword(ctx context.Context, token string, password string) error {
+	values, err := core.consumeToken(ctx, resetPasswordPurpose, token)
+	if err != nil {
+		return err
+	}
+	login := values.Get("login")
+
+	hash, err := HashPassword(password)
+	if err != nil {
+		core.lg.Error("reset password error", "err", err.Error())
+		return fmt.Errorf("reset password err: %w", err)
+	}
+
+	err = core.users.UpdatePassword(login, hash)
+	if err != nil {
+		core.lg.Error("reset password error", "err", err.Error())
+		return fmt.Errorf("reset password err: %w", err)
+	}
+
+	_, err = core.RevokeOtherSessions(ctx, login, "")
+	if err != nil {
+		return fmt.Errorf("reset password err: %w", err)
+	}
+
+	err = core.SigninSucceeded(ctx, login)
+	if err != nil {
+		core.lg.Error("reset password error", "err", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+)
+
+var (
+	ErrWrongPassword = errors.New("wrong password")
+	// ErrReauthRequired means the session is too old to delete the account
+	// without a password or a code.
+	ErrReauthRequired = errors.New("reauthentication required")
+)
+
+// ExportAccount collects everything the services keep about the user.
+func (core *Core) ExportAccount(ctx context.Context, login string) (*requests.AccountExportResponse, error) {
+	profile, err := core.users.GetUserProfile(login)
+	if err != nil {
+		core.lg.Error("export account error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	role, err := core.users.GetUserRole(login)
+	if err != nil {
+		core.lg.Error("export account error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	subscribed, err := core.users.IsSubscribed(login)
+	if err != nil {
+		core.lg.Error("export account error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	providers, err := core.users.GetLinkedProviders(login)
+	if err != nil {
+		core.lg.Error("export account error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	sessions, err := core.GetSessions(ctx, login)
+	if err != nil {
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	userId, err := core.users.GetUserProfileId(login)
+	if err != nil {
+		core.lg.Error("export account error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	filmsData, err := core.films.ExportUserData(ctx, &films.UserDataRequest{UserId: uint64(userId)})
+	if err != nil {
+		core.lg.Error("export account films error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	commentsData, err := core.comments.ExportUserData(ctx, &comments.UserDataRequest{UserId: uint64(userId)})
+	if err != nil {
+		core.lg.Error("export account comments error", "err", err.Error())
+		return nil, fmt.Errorf("export account err: %w", err)
+	}
+
+	export := &requests.AccountExportResponse{
+		ExportedAt: time.Now().Format(time.RFC3339),
+		Profile: requests.ProfileResponse{
+			Email:         profile.Email,
+			Name:          profile.Name,
+			Login:         profile.Login,
+			Photo:         profile.Photo,
+			BirthDate:     profile.Birthdate,
+			EmailVerified: profile.EmailVerified,
+		},
+		Role:           role,
+		Subscribed:     subscribed,
+		LinkedAccounts: providers,
+		Sessions:       make([]requests.SessionItem, 0, len(sessions)),
+		FavoriteFilms:  filmsData.FavoriteFilms,
+		FavoriteActors: filmsData.FavoriteActors,
+		Ratings:        make([]requests.ExportRating, 0, len(filmsData.Ratings)),
+		SeenFilms:      filmsData.NearFilms,
+		Comments:       make([]requests.ExportComment, 0, len(commentsData.Comments)),
+	}
+	for _, active := range sessions {
+		export.Sessions = append(export.Sessions, requests.SessionItem{
+			Id:        active.PublicID(),
+			CreatedAt: active.CreatedAt.Format(time.RFC3339),
+			LastSeen:  active.LastSeen.Format(time.RFC3339),
+			UserAgent: active.UserAgent,
+			Ip:        active.IP,
+		})
+	}
+	for _, rating := range filmsData.Ratings {
+		export.Ratings = append(export.Ratings, requests.ExportRating{FilmId: rating.FilmId, Rating: uint16(rating.Rating)})
+	}
+	for _, comment := range commentsData.Comments {
+		export.Comments = append(export.Comments, requests.ExportComment{
+			FilmId:  comment.FilmId,
+			Title:   comment.Title,
+			Text:    comment.Text,
+			Spoiler: comment.Spoiler,
+		})
+	}
+
+	return export, nil
+}
+
+// reauthWindow is how old a session may be to delete the account without
+// a password or a code. Users without a password, such as those who signed
+// up through OAuth, sign in with their provider again to get such a session.
+const reauthWindow = 5 * time.Minute
+
+// DeleteAccount deletes the user in every service and kills all of their
+// sessions. The user proves it is them with the password, a 2FA code or a
+// session sid created within reauthWindow, so that a stolen session is not
+// enough. Wrong passwords and codes count as sign in failures of the login
+// and the client IP. The data of the other services goes first, so that a
+// failed deletion can be retried while the profile still exists.
+func (core *Core) DeleteAccount(ctx context.Context, login string, sid string, password string, code string, ip string) (time.Duration, error) {
+	retryAfter, err := core.reauthenticate(ctx, login, sid, password, code, ip)
+	if err != nil {
+		return retryAfter, err
+	}
+
+	return 0, core.deleteAccount(ctx, login)
+}
+
+// reauthenticate checks the password or the code if one is given, and the
+// age of the session otherwise.
+func (core *Core) reauthenticate(ctx context.Context, login string, sid string, password string, code string, ip string) (time.Duration, error) {
+	if password == "" && code == "" {
+		sessions, err := core.GetSessions(ctx, login)
+		if err != nil {
+			return 0, err
+		}
+		for _, active := range sessions {
+			if active.SID == sid && time.Since(active.CreatedAt) < reauthWindow {
+				return 0, nil
+			}
+		}
+		return 0, ErrReauthRequired
+	}
+
+	retryAfter, err := core.codeRetryAfter(ctx, login, ip)
+	if err != nil {
+		return retryAfter, err
+	}
+
+	var ok bool
+	if password != "" {
+		ok, err = core.CheckPassword(login, password)
+	} else {
+		ok, err = core.checkSecondFactor(ctx, login, code)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reauthenticate err: %w", err)
+	}
+	if ok {
+		return 0, nil
+	}
+
+	retryAfter, err = core.codeFailed(ctx, login, ip)
+	if errors.Is(err, ErrInvalidCode) && password != "" {
+		err = ErrWrongPassword
+	}
+
+	return retryAfter, err
+}
+
+func (core *Core) deleteAccount(ctx context.Context, login string) error {
+	userId, err := core.users.GetUserProfileId(login)
+	if err != nil {
+		core.lg.Error("delete account error", "err", err.Error())
+		return fmt.Errorf("delete account err: %w", err)
+	}
+
+	_, err = core.films.DeleteUserData(ctx, &films.UserDataRequest{UserId: uint64(userId)})
+	if err != nil {
+		core.lg.Error("delete account films error", "err", err.Error())
+		return fmt.Errorf("delete account err: %w", err)
+	}
+
+	_, err = core.comments.DeleteUserData(ctx, &comments.UserDataRequest{UserId: uint64(userId)})
+	if err != nil {
+		core.lg.Error("delete account comments error", "err", err.Error())
+		return fmt.Errorf("delete account err: %w", err)
+	}
+
+	core.mutex.Lock()
+	_, err = core.sessions.DeleteUserSessions(ctx, login, "", core.lg)
+	core.mutex.Unlock()
+	if err != nil {
+		return fmt.Errorf("delete account err: %w", err)
+	}
+
+	err = core.users.DeleteUser(login)
+	if err != nil {
+		core.lg.Error("delete account error", "err", err.Error())
+		return fmt.Errorf("delete account err: %w", err)
+	}
+
+	return nil
+}
@@ -5,36 +5,74 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"math/rand"
-	"regexp"
+	"net/url"
 	"sync"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/csrf"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/limiter"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/profile"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/repository/session"
+	comments "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	films "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/mailer"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/metrics"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/oauth"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/rbac"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
 )
 
+//go:generate mockgen -source=core.go -destination=../mocks/core_mock.go -package=mocks
+
 type ICore interface {
-	CreateSession(ctx context.Context, login string) (string, session.Session, error)
+	CreateSession(ctx context.Context, login string, userAgent string, ip string, remember bool) (string, session.Session, error)
 	KillSession(ctx context.Context, sid string) error
+	GetSessions(ctx context.Context, login string) ([]session.Session, error)
+	RevokeSession(ctx context.Context, login string, publicId string) error
+	RevokeOtherSessions(ctx context.Context, login string, sid string) (int, error)
 	FindActiveSession(ctx context.Context, sid string) (bool, error)
-	CreateUserAccount(login string, password string, name string, birthDate string, email string) error
+	CreateUserAccount(ctx context.Context, login string, password string, name string, birthDate string, email string) error
 	FindUserAccount(login string, password string) (*models.UserItem, bool, error)
 	FindUserByLogin(login string) (bool, error)
 	GetUserName(ctx context.Context, sid string) (string, error)
 	GetUserProfile(login string) (*models.UserItem, error)
-	EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error
-	CheckCsrfToken(ctx context.Context, token string) (bool, error)
-	CreateCsrfToken(ctx context.Context) (string, error)
+	EditProfile(ctx context.Context, sid string, prevLogin string, login string, password string, email string, birthDate string, photo string) error
+	CheckCsrfToken(ctx context.Context, token string, sid string) (bool, error)
+	CreateCsrfToken(ctx context.Context, sid string) (string, error)
 	CheckPassword(login string, password string) (bool, error)
 	GetUserRole(login string) (string, error)
 	Subscribe(userName string) (bool, error)
 	IsSubscribed(userName string) (bool, error)
-	FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error)
-	ChangeUsersRole(login string, role string, currentUserRole string) error
+	FindUsers(login string, role string, page pagination.Page) (*requests.UsersResponse, error)
+	ChangeUsersRole(login string, role string, currentUserLogin string) error
+	SigninRetryAfter(ctx context.Context, login string, ip string) (time.Duration, error)
+	SigninFailed(ctx context.Context, login string, ip string) (time.Duration, error)
+	SigninSucceeded(ctx context.Context, login string) error
+	SendVerificationEmail(ctx context.Context, login string, ip string) (time.Duration, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ForgotPassword(ctx context.Context, email string, ip string) (time.Duration, error)
+	ResetPassword(ctx context.Context, token string, password string) error
+	MfaStatus(login string) (bool, bool, error)
+	StartMfa(ctx context.Context, login string, remember bool) (string, error)
+	FinishMfa(ctx context.Context, token string, code string, ip string) (string, bool, time.Duration, error)
+	EnrollTotp(login string) (string, string, error)
+	ConfirmTotp(ctx context.Context, login string, code string, ip string) ([]string, time.Duration, error)
+	DisableTotp(ctx context.Context, login string, code string, ip string) (time.Duration, error)
+	SetRoleMfaRequired(role string, required bool, currentUserLogin string) error
+	TokensEnabled() bool
+	IssueTokens(ctx context.Context, login string, sid string) (*TokenPair, error)
+	RefreshTokens(ctx context.Context, refresh string) (*TokenPair, error)
+	CheckPermission(login string, permission string) (bool, error)
+	OAuthStart(ctx context.Context, provider string, remember bool, linkLogin string) (string, error)
+	OAuthCallback(ctx context.Context, provider string, callback url.Values) (string, bool, error)
+	OAuthRedirect() string
+	ExportAccount(ctx context.Context, login string) (*requests.AccountExportResponse, error)
+	DeleteAccount(ctx context.Context, login string, sid string, password string, code string, ip string) (time.Duration, error)
 }
 
 type Core struct {
@@ -43,6 +81,20 @@ type Core struct {
 	lg         *slog.Logger
 	users      profile.IUserRepo
 	csrfTokens csrf.CsrfRepo
+	limiter    limiter.LimiterRepo
+	limits     configs.LimiterCfg
+	mt         *metrics.AuthMetrics
+	mailer     mailer.Mailer
+	account    configs.AccountCfg
+	// tokenSecret signs email verification and password reset tokens.
+	tokenSecret []byte
+	tokens      configs.TokenCfg
+	keys        *token.KeyRing
+	providers   map[string]oauth.Provider
+	// oauthRedirect is where users are sent back to after social login.
+	oauthRedirect string
+	films         films.FilmsClient
+	comments      comments.CommentsClient
 }
 
 var (
@@ -52,9 +104,8 @@ var (
 	InvalideEmail  = errors.New("invalide email")
 )
 
-var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
-
-func GetCore(cfg_sql *configs.DbDsnCfg, cfg_csrf configs.DbRedisCfg, cfg_sessions configs.DbRedisCfg, lg *slog.Logger) (*Core, error) {
+func GetCore(cfg_sql *configs.DbDsnCfg, cfg_csrf configs.DbRedisCfg, cfg_sessions configs.DbRedisCfg, cfg_limiter configs.LimiterCfg, cfg_account configs.AccountCfg,
+	cfg_token configs.TokenCfg, keys *token.KeyRing, cfg_oauth configs.OAuthCfg, lg *slog.Logger) (*Core, error) {
 	session, err := session.GetSessionRepo(cfg_sessions, lg)
 
 	if err != nil {
@@ -74,31 +125,126 @@ func GetCore(cfg_sql *configs.DbDsnCfg, cfg_csrf configs.DbRedisCfg, cfg_session
 		return nil, err
 	}
 
+	limiter, err := limiter.GetLimiterRepo(cfg_limiter.DbRedisCfg, lg)
+	if err != nil {
+		lg.Error("Limiter repository is not responding")
+		return nil, err
+	}
+
+	mailer, err := mailer.GetMailer(cfg_account.Mail, lg)
+	if err != nil {
+		lg.Error("cant create mailer")
+		return nil, err
+	}
+
+	tokenSecret := []byte(cfg_account.TokenSecret)
+	if len(tokenSecret) == 0 {
+		lg.Warn("token secret is not set, email tokens will not survive a restart")
+		secret, err := GenerateToken()
+		if err != nil {
+			return nil, err
+		}
+		tokenSecret = []byte(secret)
+	}
+	if cfg_account.VerifyTTL <= 0 {
+		cfg_account.VerifyTTL = defaultVerifyTTL
+	}
+	if cfg_account.ResetTTL <= 0 {
+		cfg_account.ResetTTL = defaultResetTTL
+	}
+
+	filmsConn, err := grpcclient.Dial(cfg_sql.FilmsGrpcAddr, cfg_sql.GrpcClient, lg)
+	if err != nil {
+		lg.Error("films grpc connect error", "err", err.Error())
+		return nil, err
+	}
+
+	commentsConn, err := grpcclient.Dial(cfg_sql.CommentsGrpcAddr, cfg_sql.GrpcClient, lg)
+	if err != nil {
+		lg.Error("comments grpc connect error", "err", err.Error())
+		return nil, err
+	}
+
+	providers, err := oauth.GetProviders(cfg_oauth)
+	if err != nil {
+		lg.Error("cant create oauth providers", "err", err.Error())
+		return nil, err
+	}
+
+	if cfg_token.AccessTTL <= 0 {
+		cfg_token.AccessTTL = defaultAccessTTL
+	}
+	if cfg_token.AccessTTL > maxAccessTTL {
+		lg.Warn("access token lifetime is capped, tokens cannot be revoked", "access_ttl", cfg_token.AccessTTL, "max", maxAccessTTL)
+		cfg_token.AccessTTL = maxAccessTTL
+	}
+	if cfg_token.RefreshTTL <= 0 {
+		cfg_token.RefreshTTL = defaultRefreshTTL
+	}
+
 	core := Core{
 		sessions:   *session,
 		lg:         lg.With("module", "core"),
 		users:      users,
 		csrfTokens: *csrf,
+		limiter:    *limiter,
+		limits:     withDefaultLimits(cfg_limiter),
+		mt:         metrics.GetAuthMetrics(),
+		mailer:     mailer,
+		account:    cfg_account,
+
+		tokenSecret: tokenSecret,
+		tokens:      cfg_token,
+		keys:        keys,
+
+		providers:     providers,
+		oauthRedirect: cfg_oauth.Redirect,
+		films:         films.NewFilmsClient(filmsConn),
+		comments:      comments.NewCommentsClient(commentsConn),
 	}
 	return &core, nil
 }
 
 func (core *Core) CheckPassword(login string, password string) (bool, error) {
-	found, err := core.users.CheckUserPassword(login, password)
+	user, found, err := core.users.GetUser(login)
 	if err != nil {
 		core.lg.Error("find user error", "err", err.Error())
-		return false, fmt.Errorf("FindUserAccount err: %w", err)
+		return false, fmt.Errorf("CheckPassword err: %w", err)
 	}
-	return found, nil
+	if !found {
+		VerifyPassword(password, dummyHash)
+		return false, nil
+	}
+
+	match, _ := VerifyPassword(password, user.Password)
+	return match, nil
 }
 
-func (core *Core) EditProfile(prevLogin string, login string, password string, email string, birthDate string, photo string) error {
+// EditProfile updates the profile of the user behind session sid. When the
+// password changes every other session of the user is revoked.
+func (core *Core) EditProfile(ctx context.Context, sid string, prevLogin string, login string, password string, email string, birthDate string, photo string) error {
+	if password != "" {
+		hash, err := HashPassword(password)
+		if err != nil {
+			core.lg.Error("Edit profile error", "err", err.Error())
+			return fmt.Errorf("Edit profile error: %w", err)
+		}
+		password = hash
+	}
+
 	err := core.users.EditProfile(prevLogin, login, password, email, birthDate, photo)
 	if err != nil {
 		core.lg.Error("Edit profile error", "err", err.Error())
 		return fmt.Errorf("Edit profile error: %w", err)
 	}
 
+	if password != "" {
+		_, err = core.RevokeOtherSessions(ctx, prevLogin, sid)
+		if err != nil {
+			return fmt.Errorf("Edit profile error: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -114,13 +260,23 @@ func (core *Core) GetUserName(ctx context.Context, sid string) (string, error) {
 	return login, nil
 }
 
-func (core *Core) CreateSession(ctx context.Context, login string) (string, session.Session, error) {
-	sid := RandStringRunes(32)
+func (core *Core) CreateSession(ctx context.Context, login string, userAgent string, ip string, remember bool) (string, session.Session, error) {
+	sid, err := GenerateToken()
+	if err != nil {
+		core.lg.Error("create session error", "err", err.Error())
+		return "", session.Session{}, err
+	}
 
+	now := time.Now()
 	newSession := session.Session{
 		Login:     login,
 		SID:       sid,
-		ExpiresAt: time.Now().Add(24 * time.Hour),
+		ExpiresAt: now.Add(core.sessions.Lifetime(remember)),
+		CreatedAt: now,
+		LastSeen:  now,
+		UserAgent: userAgent,
+		IP:        ip,
+		Remember:  remember,
 	}
 
 	core.mutex.Lock()
@@ -162,26 +318,126 @@ func (core *Core) KillSession(ctx context.Context, sid string) error {
 	return nil
 }
 
-func (core *Core) CreateUserAccount(login string, password string, name string, birthDate string, email string) error {
-	if matched, _ := regexp.MatchString(`@`, email); !matched {
+func (core *Core) GetSessions(ctx context.Context, login string) ([]session.Session, error) {
+	core.mutex.RLock()
+	sessions, err := core.sessions.GetUserSessions(ctx, login, core.lg)
+	core.mutex.RUnlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("get sessions err: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession kills the session of login identified by its public ID.
+func (core *Core) RevokeSession(ctx context.Context, login string, publicId string) error {
+	sessions, err := core.GetSessions(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	for _, active := range sessions {
+		if active.PublicID() != publicId {
+			continue
+		}
+
+		return core.KillSession(ctx, active.SID)
+	}
+
+	return ErrNotFound
+}
+
+// RevokeOtherSessions kills every session of login except sid and returns
+// how many were killed.
+func (core *Core) RevokeOtherSessions(ctx context.Context, login string, sid string) (int, error) {
+	core.mutex.Lock()
+	killed, err := core.sessions.DeleteUserSessions(ctx, login, sid, core.lg)
+	core.mutex.Unlock()
+
+	if err != nil {
+		core.lg.Error("revoke sessions error", "err", err.Error())
+		return killed, fmt.Errorf("revoke sessions err: %w", err)
+	}
+
+	return killed, nil
+}
+
+// CreateUserAccount registers the user and mails a link to verify the
+// email. A failed or throttled letter does not fail the sign up, it can be
+// resent.
+func (core *Core) CreateUserAccount(ctx context.Context, login string, password string, name string, birthDate string, email string) error {
+	if !validEmail(email) {
 		return InvalideEmail
 	}
-	err := core.users.CreateUser(login, password, name, birthDate, email)
+
+	hash, err := HashPassword(password)
 	if err != nil {
 		core.lg.Error("create user error", "err", err.Error())
 		return fmt.Errorf("CreateUserAccount err: %w", err)
 	}
 
+	err = core.users.CreateUser(login, hash, name, birthDate, email)
+	if err != nil {
+		core.lg.Error("create user error", "err", err.Error())
+		return fmt.Errorf("CreateUserAccount err: %w", err)
+	}
+
+	retryAfter, err := core.mailRetryAfter(ctx, email, "")
+	if err != nil {
+		core.lg.Error("create user error", "err", err.Error())
+		return nil
+	}
+	if retryAfter > 0 {
+		core.lg.Warn("verification letter throttled", "login", login)
+		return nil
+	}
+
+	err = core.sendVerification(ctx, login, email)
+	if err != nil {
+		core.lg.Error("create user error", "err", err.Error())
+	}
+
 	return nil
 }
 
 func (core *Core) FindUserAccount(login string, password string) (*models.UserItem, bool, error) {
-	user, found, err := core.users.GetUser(login, password)
+	user, found, err := core.users.GetUser(login)
 	if err != nil {
 		core.lg.Error("find user error", "err", err.Error())
 		return nil, false, fmt.Errorf("FindUserAccount err: %w", err)
 	}
-	return user, found, nil
+	if !found {
+		VerifyPassword(password, dummyHash)
+		return nil, false, nil
+	}
+
+	match, needsRehash := VerifyPassword(password, user.Password)
+	user.Password = ""
+	if !match {
+		return nil, false, nil
+	}
+
+	if needsRehash {
+		core.rehashPassword(login, password)
+	}
+
+	return user, true, nil
+}
+
+// rehashPassword replaces a plaintext or outdated hash after a successful
+// login. Failures are only logged: the user has already been authenticated.
+func (core *Core) rehashPassword(login string, password string) {
+	hash, err := HashPassword(password)
+	if err != nil {
+		core.lg.Error("rehash password error", "err", err.Error())
+		return
+	}
+
+	err = core.users.UpdatePassword(login, hash)
+	if err != nil {
+		core.lg.Error("rehash password error", "err", err.Error())
+	}
 }
 
 func (core *Core) FindUserByLogin(login string) (bool, error) {
@@ -194,14 +450,6 @@ func (core *Core) FindUserByLogin(login string) (bool, error) {
 	return found, nil
 }
 
-func RandStringRunes(seed int) string {
-	symbols := make([]rune, seed)
-	for i := range symbols {
-		symbols[i] = letterRunes[rand.Intn(len(letterRunes))]
-	}
-	return string(symbols)
-}
-
 func (core *Core) GetUserProfile(login string) (*models.UserItem, error) {
 	profile, err := core.users.GetUserProfile(login)
 	if err != nil {
@@ -212,26 +460,38 @@ func (core *Core) GetUserProfile(login string) (*models.UserItem, error) {
 	return profile, nil
 }
 
-func (core *Core) CheckCsrfToken(ctx context.Context, token string) (bool, error) {
+// CheckCsrfToken reports whether the token exists and was issued to the
+// session sid. A token minted for another session, or before login, is
+// rejected.
+func (core *Core) CheckCsrfToken(ctx context.Context, token string, sid string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
 	core.mutex.RLock()
-	found, err := core.csrfTokens.CheckActiveCsrf(ctx, token, core.lg)
+	binding, found, err := core.csrfTokens.GetCsrfSession(ctx, token, core.lg)
 	core.mutex.RUnlock()
 
 	if err != nil {
 		return false, err
 	}
 
-	return found, err
+	return found && sameBinding(binding, sid), nil
 }
 
-func (core *Core) CreateCsrfToken(ctx context.Context) (string, error) {
-	sid := RandStringRunes(32)
+func (core *Core) CreateCsrfToken(ctx context.Context, sid string) (string, error) {
+	token, err := GenerateToken()
+	if err != nil {
+		core.lg.Error("create csrf token error", "err", err.Error())
+		return "", err
+	}
 
 	core.mutex.Lock()
 	csrfAdded, err := core.csrfTokens.AddCsrf(
 		ctx,
 		models.Csrf{
-			SID:       sid,
+			SID:       token,
+			Session:   sessionBinding(sid),
 			ExpiresAt: time.Now().Add(3 * time.Hour),
 		},
 		core.lg,
@@ -246,7 +506,7 @@ func (core *Core) CreateCsrfToken(ctx context.Context) (string, error) {
 		return "", nil
 	}
 
-	return sid, nil
+	return token, nil
 }
 
 func (core *Core) GetUserRole(login string) (string, error) {
@@ -259,6 +519,8 @@ func (core *Core) GetUserRole(login string) (string, error) {
 	return role, nil
 }
 
+// Subscribe toggles push notifications. Only users with a verified email
+// can subscribe; unsubscribing is always allowed.
 func (core *Core) Subscribe(userName string) (bool, error) {
 	isSubcribed, err := core.users.IsSubscribed(userName)
 	if err != nil {
@@ -266,6 +528,17 @@ func (core *Core) Subscribe(userName string) (bool, error) {
 		return false, fmt.Errorf("")
 	}
 
+	if !isSubcribed {
+		verified, err := core.users.IsEmailVerified(userName)
+		if err != nil {
+			core.lg.Error("is email verified error", "err", err.Error())
+			return false, fmt.Errorf("subscribe err: %w", err)
+		}
+		if !verified {
+			return false, ErrNotVerified
+		}
+	}
+
 	err = core.users.ChangeSubsribe(userName, !isSubcribed)
 	if err != nil {
 		core.lg.Error("change subscribe error", "err", err.Error())
@@ -285,28 +558,55 @@ func (core *Core) IsSubscribed(userName string) (bool, error) {
 	return isSubcribed, nil
 }
 
-func (core *Core) FindUsers(login string, role string, first, limit uint64) ([]models.UserItem, error) {
-	users, err := core.users.FindUsers(login, role, first, limit)
+// FindUsers returns ErrNotFound if no user matches the filters, an empty
+// page after the last one is not an error.
+func (core *Core) FindUsers(login string, role string, page pagination.Page) (*requests.UsersResponse, error) {
+	users, next, err := core.users.FindUsers(login, role, page)
 	if err != nil {
 		core.lg.Error("find user error", "err:", err.Error())
 		return nil, fmt.Errorf("find user error: %w", err)
 	}
-	if len(users) == 0 {
+	total, err := core.users.CountUsers(login, role)
+	if err != nil {
+		core.lg.Error("count users error", "err:", err.Error())
+		return nil, fmt.Errorf("find user error: %w", err)
+	}
+	if total == 0 {
 		return nil, ErrNotFound
 	}
 
-	return users, nil
+	return &requests.UsersResponse{Users: users, Total: total, NextCursor: next}, nil
 }
 
-func (core *Core) ChangeUsersRole(login string, role string, currentUserRole string) error {
-	if currentUserRole != "super" {
+// ChangeUsersRole is allowed to users with the rbac.ChangeRole permission
+// only, and only once the user has 2FA enabled if the role requires it.
+func (core *Core) ChangeUsersRole(login string, role string, currentUserLogin string) error {
+	allowed, err := core.users.HasPermission(currentUserLogin, rbac.ChangeRole)
+	if err != nil {
+		core.lg.Error("change user role error", "err", err.Error())
+		return fmt.Errorf("change user role error: %w", err)
+	}
+	if !allowed {
 		return ErrNotAllowed
 	}
 
-	err := core.users.ChangeUsersRole(login, role)
+	err = core.users.ChangeUsersRole(login, role)
 	if err != nil {
 		core.lg.Error("change user role error", "err:", err.Error())
 		return fmt.Errorf("change user role error: %w", err)
 	}
 	return nil
 }
+
+// CheckPermission reports whether the role of the user grants the
+// permission. Permissions are listed in the rbac package. Users whose role
+// requires 2FA get none of them until they enable it.
+func (core *Core) CheckPermission(login string, permission string) (bool, error) {
+	allowed, err := core.users.HasPermission(login, permission)
+	if err != nil {
+		core.lg.Error("check permission error", "err", err.Error())
+		return false, fmt.Errorf("check permission err: %w", err)
+	}
+
+	return allowed, nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+const (
+	defaultLoginAttempts = 5
+	defaultIpAttempts    = 50
+	defaultWindow        = 15 * time.Minute
+	defaultBaseLockout   = 30 * time.Second
+	defaultMaxLockout    = time.Hour
+
+	defaultMailPerAddress = 3
+	defaultMailPerIp      = 20
+	defaultMailWindow     = time.Hour
+)
+
+func withDefaultLimits(cfg configs.LimiterCfg) configs.LimiterCfg {
+	if cfg.LoginAttempts <= 0 {
+		cfg.LoginAttempts = defaultLoginAttempts
+	}
+	if cfg.IpAttempts <= 0 {
+		cfg.IpAttempts = defaultIpAttempts
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = defaultWindow
+	}
+	if cfg.BaseLockout <= 0 {
+		cfg.BaseLockout = defaultBaseLockout
+	}
+	if cfg.MaxLockout < cfg.BaseLockout {
+		cfg.MaxLockout = defaultMaxLockout
+	}
+	if cfg.MailPerAddress <= 0 {
+		cfg.MailPerAddress = defaultMailPerAddress
+	}
+	if cfg.MailPerIp <= 0 {
+		cfg.MailPerIp = defaultMailPerIp
+	}
+	if cfg.MailWindow <= 0 {
+		cfg.MailWindow = defaultMailWindow
+	}
+
+	return cfg
+}
+
+type signinLimit struct {
+	scope    string
+	key      string
+	attempts int64
+}
+
+func (core *Core) signinLimits(login string, ip string) []signinLimit {
+	return []signinLimit{
+		{scope: "login", key: "login:" + login, attempts: core.limits.LoginAttempts},
+		{scope: "ip", key: "ip:" + ip, attempts: core.limits.IpAttempts},
+	}
+}
+
+// lockoutDuration returns the lockout caused by the given number of
+// failures: none below attempts, base at attempts, doubling with every
+// further failure and capped at max.
+func lockoutDuration(failures int64, attempts int64, base time.Duration, max time.Duration) time.Duration {
+	if failures < attempts {
+		return 0
+	}
+
+	lockout := base
+	for i := attempts; i < failures && lockout < max; i++ {
+		lockout *= 2
+	}
+
+	if lockout > max {
+		lockout = max
+	}
+
+	return lockout
+}
+
+// SigninRetryAfter returns how long sign in stays locked out for the login
+// or the client IP, or zero if it is allowed.
+func (core *Core) SigninRetryAfter(ctx context.Context, login string, ip string) (time.Duration, error) {
+	var retryAfter time.Duration
+	for _, limit := range core.signinLimits(login, ip) {
+		lockedFor, err := core.limiter.LockedFor(ctx, limit.key, core.lg)
+		if err != nil {
+			return 0, fmt.Errorf("signin retry after err: %w", err)
+		}
+
+		if lockedFor > retryAfter {
+			retryAfter = lockedFor
+		}
+	}
+
+	if retryAfter > 0 {
+		core.mt.Throttled.Inc()
+	}
+
+	return retryAfter, nil
+}
+
+// SigninFailed counts a failed sign in for the login and the client IP and
+// returns the lockout it caused, if any.
+func (core *Core) SigninFailed(ctx context.Context, login string, ip string) (time.Duration, error) {
+	core.mt.SigninFailures.Inc()
+
+	var retryAfter time.Duration
+	for _, limit := range core.signinLimits(login, ip) {
+		failures, err := core.limiter.AddFailure(ctx, limit.key, core.limits.Window, core.lg)
+		if err != nil {
+			return 0, fmt.Errorf("signin failed err: %w", err)
+		}
+
+		lockout := lockoutDuration(failures, limit.attempts, core.limits.BaseLockout, core.limits.MaxLockout)
+		if lockout == 0 {
+			continue
+		}
+
+		err = core.limiter.Lock(ctx, limit.key, lockout, core.lg)
+		if err != nil {
+			return 0, fmt.Errorf("signin failed err: %w", err)
+		}
+		core.mt.Lockouts.WithLabelValues(limit.scope).Inc()
+
+		if lockout > retryAfter {
+			retryAfter = lockout
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// SigninSucceeded forgets the failures of the login. Failures of the IP
+// are kept, so one valid account does not lift the limit for the others.
+func (core *Core) SigninSucceeded(ctx context.Context, login string) error {
+	err := core.limiter.Reset(ctx, "login:"+login, core.lg)
+	if err != nil {
+		return fmt.Errorf("signin succeeded err: %w", err)
+	}
+
+	return nil
+}
+
+// mailLimits returns the quotas a letter to the address counts against.
+// Letters not requested by a client, such as the one sent on sign up, are
+// limited per address only.
+func (core *Core) mailLimits(address string, ip string) []signinLimit {
+	limits := []signinLimit{
+		{scope: "mail_address", key: "mail_address:" + strings.ToLower(address), attempts: core.limits.MailPerAddress},
+	}
+	if ip != "" {
+		limits = append(limits, signinLimit{scope: "mail_ip", key: "mail_ip:" + ip, attempts: core.limits.MailPerIp})
+	}
+
+	return limits
+}
+
+// mailRetryAfter counts a letter to the address requested from the client
+// IP and returns how long to wait if the address or the IP is over its
+// quota, or zero if the letter may be sent.
+func (core *Core) mailRetryAfter(ctx context.Context, address string, ip string) (time.Duration, error) {
+	var retryAfter time.Duration
+	for _, limit := range core.mailLimits(address, ip) {
+		hits, left, err := core.limiter.Hit(ctx, limit.key, core.limits.MailWindow, core.lg)
+		if err != nil {
+			return 0, fmt.Errorf("mail retry after err: %w", err)
+		}
+
+		if hits > limit.attempts && left > retryAfter {
+			retryAfter = left
+		}
+	}
+
+	return retryAfter, nil
+}
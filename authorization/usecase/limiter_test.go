@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutDuration(t *testing.T) {
+	testCases := []struct {
+		failures int64
+		expected time.Duration
+	}{
+		{failures: 1, expected: 0},
+		{failures: 4, expected: 0},
+		{failures: 5, expected: 30 * time.Second},
+		{failures: 6, expected: time.Minute},
+		{failures: 8, expected: 4 * time.Minute},
+		{failures: 100, expected: time.Hour},
+	}
+
+	for _, testCase := range testCases {
+		lockout := lockoutDuration(testCase.failures, 5, 30*time.Second, time.Hour)
+		if lockout != testCase.expected {
+			t.Errorf("failures %d: expected %s, got %s", testCase.failures, testCase.expected, lockout)
+			return
+		}
+	}
+}
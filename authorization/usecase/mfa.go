@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/rbac"
+)
+
+const (
+	mfaPendingPurpose = "mfa_pending"
+	mfaPendingTTL     = 5 * time.Minute
+)
+
+var (
+	ErrInvalidCode       = errors.New("invalid code")
+	ErrMfaAlreadyEnabled = errors.New("2fa already enabled")
+	ErrMfaNotEnabled     = errors.New("2fa not enabled")
+	ErrMfaRequired       = errors.New("2fa required")
+	ErrTooManyAttempts   = errors.New("too many attempts")
+)
+
+// MfaStatus reports whether the user has 2FA enabled and, if not, whether
+// the role of the user requires it.
+func (core *Core) MfaStatus(login string) (bool, bool, error) {
+	_, enabled, err := core.users.GetTotp(login)
+	if err != nil {
+		core.lg.Error("mfa status error", "err", err.Error())
+		return false, false, fmt.Errorf("mfa status err: %w", err)
+	}
+	if enabled {
+		return true, false, nil
+	}
+
+	role, err := core.users.GetUserRole(login)
+	if err != nil {
+		core.lg.Error("mfa status error", "err", err.Error())
+		return false, false, fmt.Errorf("mfa status err: %w", err)
+	}
+
+	required, err := core.users.IsMfaRequired(role)
+	if err != nil {
+		core.lg.Error("mfa status error", "err", err.Error())
+		return false, false, fmt.Errorf("mfa status err: %w", err)
+	}
+
+	return false, required, nil
+}
+
+// StartMfa is called after the password of a user with 2FA was checked.
+// It returns a short-lived token to be exchanged for a session together
+// with a code.
+func (core *Core) StartMfa(ctx context.Context, login string, remember bool) (string, error) {
+	token, err := core.issueToken(ctx, mfaPendingPurpose, url.Values{
+		"login":    {login},
+		"remember": {strconv.FormatBool(remember)},
+	}, mfaPendingTTL)
+	if err != nil {
+		core.lg.Error("start mfa error", "err", err.Error())
+		return "", fmt.Errorf("start mfa err: %w", err)
+	}
+
+	return token, nil
+}
+
+// FinishMfa checks the code for the pending token and returns the login to
+// create a session for. The token survives wrong codes, which are counted
+// as sign in failures, and is consumed on success.
+func (core *Core) FinishMfa(ctx context.Context, token string, code string, ip string) (string, bool, time.Duration, error) {
+	id, ok := verifyToken(core.tokenSecret, mfaPendingPurpose, token)
+	if !ok {
+		return "", false, 0, ErrInvalidToken
+	}
+	key := mfaPendingPurpose + ":" + id
+
+	value, found, err := core.csrfTokens.GetToken(ctx, key, core.lg)
+	if err != nil {
+		return "", false, 0, fmt.Errorf("finish mfa err: %w", err)
+	}
+	if !found {
+		return "", false, 0, ErrInvalidToken
+	}
+
+	values, err := url.ParseQuery(value)
+	if err != nil {
+		return "", false, 0, ErrInvalidToken
+	}
+	login := values.Get("login")
+	remember, _ := strconv.ParseBool(values.Get("remember"))
+
+	retryAfter, err := core.codeRetryAfter(ctx, login, ip)
+	if err != nil {
+		return "", false, retryAfter, fmt.Errorf("finish mfa err: %w", err)
+	}
+
+	ok, err = core.checkSecondFactor(ctx, login, code)
+	if err != nil {
+		return "", false, 0, fmt.Errorf("finish mfa err: %w", err)
+	}
+	if !ok {
+		retryAfter, err := core.codeFailed(ctx, login, ip)
+		return "", false, retryAfter, err
+	}
+
+	_, found, err = core.csrfTokens.ConsumeToken(ctx, key, core.lg)
+	if err != nil {
+		return "", false, 0, fmt.Errorf("finish mfa err: %w", err)
+	}
+	if !found {
+		return "", false, 0, ErrInvalidToken
+	}
+
+	return login, remember, 0, nil
+}
+
+// codeRetryAfter returns ErrTooManyAttempts with the time to wait while the
+// login or the client IP is locked out. 2FA codes share the limits of sign
+// in, so a six digit code cannot be guessed through any endpoint.
+func (core *Core) codeRetryAfter(ctx context.Context, login string, ip string) (time.Duration, error) {
+	retryAfter, err := core.SigninRetryAfter(ctx, login, ip)
+	if err != nil {
+		return 0, err
+	}
+	if retryAfter > 0 {
+		return retryAfter, ErrTooManyAttempts
+	}
+
+	return 0, nil
+}
+
+// codeFailed counts a wrong code as a sign in failure. It returns
+// ErrTooManyAttempts with the time to wait once that locks the login or the
+// client IP out, and ErrInvalidCode otherwise.
+func (core *Core) codeFailed(ctx context.Context, login string, ip string) (time.Duration, error) {
+	retryAfter, err := core.SigninFailed(ctx, login, ip)
+	if err != nil {
+		core.lg.Error("code failed error", "err", err.Error())
+	}
+	if retryAfter > 0 {
+		return retryAfter, ErrTooManyAttempts
+	}
+
+	return 0, ErrInvalidCode
+}
+
+// checkSecondFactor accepts a TOTP code, each at most once, or one of the
+// recovery codes, which is used up.
+func (core *Core) checkSecondFactor(ctx context.Context, login string, code string) (bool, error) {
+	secret, enabled, err := core.users.GetTotp(login)
+	if err != nil {
+		return false, err
+	}
+	if !enabled {
+		return false, nil
+	}
+
+	if step, ok := ValidateTotp(secret, code, time.Now()); ok {
+		return core.markTotpUsed(ctx, login, step)
+	}
+
+	return core.users.UseRecoveryCode(login, hashRecoveryCode(code))
+}
+
+func (core *Core) markTotpUsed(ctx context.Context, login string, step int64) (bool, error) {
+	key := "totp_used:" + login + ":" + strconv.FormatInt(step, 10)
+	return core.csrfTokens.MarkUsed(ctx, key, (2*totpSkew+1)*totpPeriod, core.lg)
+}
+
+// EnrollTotp starts 2FA enrollment: it stores a new secret and returns it
+// with the provisioning URI. 2FA is enabled only after ConfirmTotp.
+func (core *Core) EnrollTotp(login string) (string, string, error) {
+	_, enabled, err := core.users.GetTotp(login)
+	if err != nil {
+		core.lg.Error("enroll totp error", "err", err.Error())
+		return "", "", fmt.Errorf("enroll totp err: %w", err)
+	}
+	if enabled {
+		return "", "", ErrMfaAlreadyEnabled
+	}
+
+	secret, err := GenerateTotpSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	err = core.users.SetTotpSecret(login, secret)
+	if err != nil {
+		core.lg.Error("enroll totp error", "err", err.Error())
+		return "", "", fmt.Errorf("enroll totp err: %w", err)
+	}
+
+	return secret, TotpURI(login, secret), nil
+}
+
+// ConfirmTotp enables 2FA once the user proves the authenticator works,
+// and returns the recovery codes. They are shown to the user only once.
+// Wrong codes count as sign in failures of the login and the client IP.
+func (core *Core) ConfirmTotp(ctx context.Context, login string, code string, ip string) ([]string, time.Duration, error) {
+	secret, enabled, err := core.users.GetTotp(login)
+	if err != nil {
+		core.lg.Error("confirm totp error", "err", err.Error())
+		return nil, 0, fmt.Errorf("confirm totp err: %w", err)
+	}
+	if enabled {
+		return nil, 0, ErrMfaAlreadyEnabled
+	}
+	if secret == "" {
+		return nil, 0, ErrMfaNotEnabled
+	}
+
+	retryAfter, err := core.codeRetryAfter(ctx, login, ip)
+	if err != nil {
+		return nil, retryAfter, fmt.Errorf("confirm totp err: %w", err)
+	}
+
+	step, ok := ValidateTotp(secret, code, time.Now())
+	if ok {
+		ok, err = core.markTotpUsed(ctx, login, step)
+		if err != nil {
+			return nil, 0, fmt.Errorf("confirm totp err: %w", err)
+		}
+	}
+	if !ok {
+		retryAfter, err := core.codeFailed(ctx, login, ip)
+		return nil, retryAfter, err
+	}
+
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = core.users.EnableTotp(login, hashes)
+	if err != nil {
+		core.lg.Error("confirm totp error", "err", err.Error())
+		return nil, 0, fmt.Errorf("confirm totp err: %w", err)
+	}
+
+	return codes, 0, nil
+}
+
+// DisableTotp turns 2FA off. It takes a current code and is refused when
+// the role of the user requires 2FA. Wrong codes count as sign in failures
+// of the login and the client IP.
+func (core *Core) DisableTotp(ctx context.Context, login string, code string, ip string) (time.Duration, error) {
+	enabled, _, err := core.MfaStatus(login)
+	if err != nil {
+		return 0, err
+	}
+	if !enabled {
+		return 0, ErrMfaNotEnabled
+	}
+
+	role, err := core.users.GetUserRole(login)
+	if err != nil {
+		core.lg.Error("disable totp error", "err", err.Error())
+		return 0, fmt.Errorf("disable totp err: %w", err)
+	}
+
+	required, err := core.users.IsMfaRequired(role)
+	if err != nil {
+		core.lg.Error("disable totp error", "err", err.Error())
+		return 0, fmt.Errorf("disable totp err: %w", err)
+	}
+	if required {
+		return 0, ErrMfaRequired
+	}
+
+	retryAfter, err := core.codeRetryAfter(ctx, login, ip)
+	if err != nil {
+		return retryAfter, fmt.Errorf("disable totp err: %w", err)
+	}
+
+	ok, err := core.checkSecondFactor(ctx, login, code)
+	if err != nil {
+		return 0, fmt.Errorf("disable totp err: %w", err)
+	}
+	if !ok {
+		return core.codeFailed(ctx, login, ip)
+	}
+
+	err = core.users.DisableTotp(login)
+	if err != nil {
+		core.lg.Error("disable totp error", "err", err.Error())
+		return 0, fmt.Errorf("disable totp err: %w", err)
+	}
+
+	return 0, nil
+}
+
+// SetRoleMfaRequired makes 2FA mandatory for the role. It needs the
+// rbac.ManageMfaRoles permission.
+func (core *Core) SetRoleMfaRequired(role string, required bool, currentUserLogin string) error {
+	allowed, err := core.users.HasPermission(currentUserLogin, rbac.ManageMfaRoles)
+	if err != nil {
+		core.lg.Error("set role mfa required error", "err", err.Error())
+		return fmt.Errorf("set role mfa required err: %w", err)
+	}
+	if !allowed {
+		return ErrNotAllowed
+	}
+
+	err = core.users.SetMfaRequired(role, required)
+	if err != nil {
+		core.lg.Error("set role mfa required error", "err", err.Error())
+		return fmt.Errorf("set role mfa required err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/oauth"
+)
+
+const (
+	oauthStatePurpose = "oauth_state"
+	oauthStateTTL     = 10 * time.Minute
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown oauth provider")
+	ErrOAuthDenied     = errors.New("oauth denied")
+	// ErrIdentityConflict means the identity cannot be used for the user:
+	// it is linked to another profile, or its email belongs to a profile
+	// the user has to sign in to and link the identity from.
+	ErrIdentityConflict = errors.New("identity conflict")
+)
+
+// OAuthStart returns the page of the provider to send the user to. If
+// linkLogin is set, the identity will be linked to that profile.
+func (core *Core) OAuthStart(ctx context.Context, provider string, remember bool, linkLogin string) (string, error) {
+	p, found := core.providers[provider]
+	if !found {
+		return "", ErrUnknownProvider
+	}
+
+	verifier, err := oauth.NewVerifier()
+	if err != nil {
+		return "", err
+	}
+
+	state, err := core.issueToken(ctx, oauthStatePurpose, url.Values{
+		"provider": {provider},
+		"verifier": {verifier},
+		"remember": {strconv.FormatBool(remember)},
+		"link":     {linkLogin},
+	}, oauthStateTTL)
+	if err != nil {
+		core.lg.Error("oauth start error", "err", err.Error())
+		return "", fmt.Errorf("oauth start err: %w", err)
+	}
+
+	authURL, err := p.AuthCodeURL(ctx, state, oauth.Challenge(verifier))
+	if err != nil {
+		core.lg.Error("oauth start error", "err", err.Error())
+		return "", fmt.Errorf("oauth start err: %w", err)
+	}
+
+	return authURL, nil
+}
+
+// OAuthCallback finishes the flow and returns the login to create a
+// session for. Users seen for the first time get a new profile.
+func (core *Core) OAuthCallback(ctx context.Context, provider string, callback url.Values) (string, bool, error) {
+	p, found := core.providers[provider]
+	if !found {
+		return "", false, ErrUnknownProvider
+	}
+
+	values, err := core.consumeToken(ctx, oauthStatePurpose, callback.Get("state"))
+	if err != nil {
+		return "", false, err
+	}
+	if values.Get("provider") != provider {
+		return "", false, ErrInvalidToken
+	}
+	remember, _ := strconv.ParseBool(values.Get("remember"))
+	linkLogin := values.Get("link")
+
+	if callback.Get("error") != "" {
+		return "", false, ErrOAuthDenied
+	}
+
+	identity, err := p.Exchange(ctx, callback, values.Get("verifier"))
+	if err != nil {
+		core.lg.Error("oauth exchange error", "err", err.Error())
+		return "", false, ErrOAuthDenied
+	}
+
+	login, found, err := core.users.GetExternalIdentity(provider, identity.Subject)
+	if err != nil {
+		core.lg.Error("oauth callback error", "err", err.Error())
+		return "", false, fmt.Errorf("oauth callback err: %w", err)
+	}
+	if found {
+		if linkLogin != "" && linkLogin != login {
+			return "", false, ErrIdentityConflict
+		}
+		return login, remember, nil
+	}
+
+	if linkLogin != "" {
+		err = core.users.LinkExternalIdentity(linkLogin, provider, identity.Subject, identity.Email)
+		if err != nil {
+			core.lg.Error("oauth callback error", "err", err.Error())
+			return "", false, fmt.Errorf("oauth callback err: %w", err)
+		}
+		return linkLogin, remember, nil
+	}
+
+	login, err = core.createExternalUser(provider, identity)
+	if err != nil {
+		return "", false, err
+	}
+
+	return login, remember, nil
+}
+
+// createExternalUser creates a profile for the identity. Its login is
+// derived from the identity and its password is random: the user can set
+// one through the password reset.
+func (core *Core) createExternalUser(provider string, identity *oauth.Identity) (string, error) {
+	if identity.Email != "" {
+		_, found, err := core.users.GetLoginByEmail(identity.Email)
+		if err != nil {
+			core.lg.Error("create external user error", "err", err.Error())
+			return "", fmt.Errorf("create external user err: %w", err)
+		}
+		if found {
+			return "", ErrIdentityConflict
+		}
+	}
+
+	sum := sha256.Sum256([]byte(provider + ":" + identity.Subject))
+	login := provider + "_" + hex.EncodeToString(sum[:6])
+
+	password, err := GenerateToken()
+	if err != nil {
+		return "", err
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return "", fmt.Errorf("create external user err: %w", err)
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = login
+	}
+
+	err = core.users.CreateExternalUser(login, hash, name, identity.Email, identity.EmailVerified, provider, identity.Subject)
+	if err != nil {
+		core.lg.Error("create external user error", "err", err.Error())
+		return "", fmt.Errorf("create external user err: %w", err)
+	}
+
+	return login, nil
+}
+
+// OAuthRedirect is where users are sent back to when the flow is done.
+func (core *Core) OAuthRedirect() string {
+	return core.oauthRedirect
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argonTime    uint32 = 1
+	argonMemory  uint32 = 64 * 1024
+	argonThreads uint8  = 4
+	argonKeyLen  uint32 = 32
+	argonSaltLen        = 16
+
+	argonPrefix = "$argon2id$"
+)
+
+var ErrInvalidHash = errors.New("invalid password hash")
+
+// dummyHash is verified against when the login does not exist, so that
+// a missing user takes as long to reject as a wrong password.
+var dummyHash, _ = HashPassword("dummy password")
+
+type argonParams struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+// HashPassword returns the password encoded in PHC string format:
+// $argon2id$v=19$m=65536,t=1,p=4$<salt>$<key>
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argonSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("hash password err: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argonPrefix, argon2.Version,
+		argonMemory, argonTime, argonThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// VerifyPassword compares password with the stored value in constant time.
+// Rows written before hashing was introduced hold the plaintext password;
+// they are still accepted, and needsRehash reports that the caller should
+// replace the stored value with a fresh hash.
+func VerifyPassword(password string, stored string) (match bool, needsRehash bool) {
+	if !strings.HasPrefix(stored, argonPrefix) {
+		match = subtle.ConstantTimeCompare([]byte(password), []byte(stored)) == 1
+		return match, match
+	}
+
+	params, salt, key, err := decodeHash(stored)
+	if err != nil {
+		return false, false
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return false, false
+	}
+
+	outdated := params.memory != argonMemory || params.time != argonTime || params.threads != argonThreads ||
+		uint32(len(key)) != argonKeyLen
+	return true, outdated
+}
+
+func decodeHash(encoded string) (*argonParams, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	params := &argonParams{}
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads)
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, ErrInvalidHash
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("p1")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !strings.HasPrefix(hash, argonPrefix) {
+		t.Errorf("wanted argon2id hash, had %s", hash)
+		return
+	}
+
+	other, err := HashPassword("p1")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if hash == other {
+		t.Errorf("wanted different salts")
+		return
+	}
+
+	match, needsRehash := VerifyPassword("p1", hash)
+	if !match || needsRehash {
+		t.Errorf("wanted match without rehash, had %v %v", match, needsRehash)
+		return
+	}
+
+	match, _ = VerifyPassword("p2", hash)
+	if match {
+		t.Errorf("wanted mismatch")
+		return
+	}
+}
+
+func TestVerifyPlaintextPassword(t *testing.T) {
+	match, needsRehash := VerifyPassword("p1", "p1")
+	if !match || !needsRehash {
+		t.Errorf("wanted match with rehash, had %v %v", match, needsRehash)
+		return
+	}
+
+	match, needsRehash = VerifyPassword("p2", "p1")
+	if match || needsRehash {
+		t.Errorf("wanted mismatch, had %v %v", match, needsRehash)
+		return
+	}
+}
+
+func TestVerifyOutdatedHash(t *testing.T) {
+	hash, _ := HashPassword("p1")
+	outdated := strings.Replace(hash, "t=1", "t=2", 1)
+
+	match, _ := VerifyPassword("p1", outdated)
+	if match {
+		t.Errorf("wanted mismatch for tampered parameters")
+		return
+	}
+
+	match, _ = VerifyPassword("p1", "$argon2id$broken")
+	if match {
+		t.Errorf("wanted mismatch for malformed hash")
+		return
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// tokenBytes is the amount of entropy in session IDs and CSRF tokens: 256 bits.
+const tokenBytes = 32
+
+// GenerateToken returns a random token read from crypto/rand and encoded
+// as unpadded base64url, so it can be used in cookies and headers as is.
+func GenerateToken() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate token err: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// sessionBinding is the value a CSRF token is bound to. The session ID
+// itself is not stored next to the token, only its digest; requests
+// without a session bind to the empty string.
+func sessionBinding(sid string) string {
+	if sid == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(sid))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func sameBinding(stored string, sid string) bool {
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(sessionBinding(sid))) == 1
+}
+
+// signToken returns id with an HMAC of the purpose and the id appended, so
+// that a token for one purpose cannot be replayed for another and forged
+// tokens are rejected before the store is queried.
+func signToken(secret []byte, purpose string, id string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose + ":" + id))
+
+	return id + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifyToken checks the signature of token and returns its id.
+func verifyToken(secret []byte, purpose string, token string) (string, bool) {
+	id, _, found := strings.Cut(token, ".")
+	if !found || id == "" {
+		return "", false
+	}
+
+	expected := signToken(secret, purpose, id)
+	if !hmac.Equal([]byte(expected), []byte(token)) {
+		return "", false
+	}
+
+	return id, true
+}
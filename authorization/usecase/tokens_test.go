@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerateToken(t *testing.T) {
+	token, err := GenerateToken()
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Errorf("wanted base64url token, had %s", token)
+		return
+	}
+	if len(raw) != tokenBytes {
+		t.Errorf("wanted %d bytes, had %d", tokenBytes, len(raw))
+		return
+	}
+
+	other, _ := GenerateToken()
+	if token == other {
+		t.Errorf("wanted different tokens")
+		return
+	}
+}
+
+func TestSessionBinding(t *testing.T) {
+	if !sameBinding(sessionBinding("s1"), "s1") {
+		t.Errorf("wanted token bound to s1")
+		return
+	}
+	if sameBinding(sessionBinding("s1"), "s2") {
+		t.Errorf("wanted token rejected for s2")
+		return
+	}
+	if sameBinding(sessionBinding(""), "s1") {
+		t.Errorf("wanted anonymous token rejected for s1")
+		return
+	}
+	if !sameBinding(sessionBinding(""), "") {
+		t.Errorf("wanted anonymous token accepted without session")
+		return
+	}
+}
+
+func TestSignToken(t *testing.T) {
+	secret := []byte("secret")
+	token := signToken(secret, "verify_email", "id1")
+
+	id, ok := verifyToken(secret, "verify_email", token)
+	if !ok || id != "id1" {
+		t.Errorf("wanted id1, had %q", id)
+		return
+	}
+
+	if _, ok := verifyToken(secret, "reset_password", token); ok {
+		t.Errorf("wanted token rejected for another purpose")
+		return
+	}
+	if _, ok := verifyToken([]byte("other"), "verify_email", token); ok {
+		t.Errorf("wanted token rejected for another secret")
+		return
+	}
+	if _, ok := verifyToken(secret, "verify_email", "id2"+token[3:]); ok {
+		t.Errorf("wanted forged token rejected")
+		return
+	}
+}
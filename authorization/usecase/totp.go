@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters from RFC 6238 that every authenticator app supports:
+// HMAC-SHA1, 6 digits, 30 second steps.
+const (
+	totpIssuer      = "movie-hub"
+	totpSecretBytes = 20
+	totpDigits      = 6
+	totpPeriod      = 30 * time.Second
+	// totpSkew is the number of steps accepted before and after the
+	// current one, to tolerate clock drift.
+	totpSkew = 1
+
+	recoveryCodes     = 10
+	recoveryCodeBytes = 5
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret returns a random base32 secret for an authenticator.
+func GenerateTotpSecret() (string, error) {
+	buf := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate totp secret err: %w", err)
+	}
+
+	return totpEncoding.EncodeToString(buf), nil
+}
+
+// TotpURI returns the otpauth:// provisioning URI shown to the user as a QR
+// code.
+func TotpURI(login string, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", totpIssuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(totpDigits))
+	values.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+
+	label := url.PathEscape(totpIssuer + ":" + login)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod/time.Second)
+}
+
+// totpCode computes the code for the step as described in RFC 4226.
+func totpCode(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// ValidateTotp checks code against the secret at time t and returns the
+// matched step, so that the caller can reject a replayed code.
+func ValidateTotp(secret string, code string, t time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := totpStep(t)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns one-time codes for the user and their
+// digests for the storage. The codes are random enough for a fast hash.
+func GenerateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodes)
+	hashes := make([]string, 0, recoveryCodes)
+	for i := 0; i < recoveryCodes; i++ {
+		buf := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery codes err: %w", err)
+		}
+
+		code := strings.ToLower(totpEncoding.EncodeToString(buf))
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
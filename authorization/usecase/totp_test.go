@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTotpCode(t *testing.T) {
+	// Test vectors from RFC 6238, appendix B, truncated to 6 digits.
+	key := []byte("12345678901234567890")
+	testCases := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+
+	for _, testCase := range testCases {
+		code := totpCode(key, totpStep(time.Unix(testCase.unix, 0)))
+		if code != testCase.code {
+			t.Errorf("at %d wanted %s, had %s", testCase.unix, testCase.code, code)
+			return
+		}
+	}
+}
+
+func TestValidateTotp(t *testing.T) {
+	secret, err := GenerateTotpSecret()
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+
+	key, _ := totpEncoding.DecodeString(secret)
+	now := time.Unix(1700000000, 0)
+
+	step, ok := ValidateTotp(secret, totpCode(key, totpStep(now)-1), now)
+	if !ok || step != totpStep(now)-1 {
+		t.Errorf("wanted previous step accepted")
+		return
+	}
+
+	if _, ok := ValidateTotp(secret, totpCode(key, totpStep(now)-3), now); ok {
+		t.Errorf("wanted stale code rejected")
+		return
+	}
+
+	if _, ok := ValidateTotp(secret, "12345", now); ok {
+		t.Errorf("wanted short code rejected")
+		return
+	}
+}
+
+func TestTotpURI(t *testing.T) {
+	uri := TotpURI("l1", "SECRET")
+	if !strings.HasPrefix(uri, "otpauth://totp/movie-hub:l1?") || !strings.Contains(uri, "secret=SECRET") {
+		t.Errorf("wrong uri %s", uri)
+		return
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if len(codes) != recoveryCodes || len(hashes) != recoveryCodes {
+		t.Errorf("wanted %d codes, had %d", recoveryCodes, len(codes))
+		return
+	}
+
+	if hashRecoveryCode(strings.ToUpper(codes[0])) != hashes[0] {
+		t.Errorf("wanted recovery code to be case insensitive")
+		return
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	delivery_auth_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/delivery/grpc"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
 )
 
 func main() {
@@ -33,17 +34,47 @@ func main() {
 		return
 	}
 
-	core, err := usecase.GetCore(config, *configCsrf, *configSession, lg)
+	configLimiter, err := configs.ReadLimiterConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	configAccount, err := configs.ReadAccountConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	configToken, err := configs.ReadTokenConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	configOAuth, err := configs.ReadOAuthConfig()
+	if err != nil {
+		lg.Error("read config error", "err", err.Error())
+		return
+	}
+
+	keys, err := token.GetKeyRing(*configToken, lg)
+	if err != nil {
+		lg.Error("get signing keys error", "err", err.Error())
+		return
+	}
+
+	core, err := usecase.GetCore(config, *configCsrf, *configSession, *configLimiter, *configAccount, *configToken, keys, *configOAuth, lg)
 	if err != nil {
 		lg.Error("cant create core")
 		return
 	}
 
-	api := delivery_auth.GetApi(core, lg)
+	api := delivery_auth.GetApi(core, lg, *configLimiter)
 
 	errs := make(chan error, 2)
 
-	grpcServ, err := delivery_auth_grpc.NewServer(lg)
+	grpcServ, err := delivery_auth_grpc.NewServer(lg, keys)
 	if err != nil {
 		lg.Error("cant create server")
 		return
@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery"
+	delivery_comments_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/delivery/grpc"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
@@ -36,5 +37,10 @@ func main() {
 	core := usecase.GetCore(config, lg, comments)
 	api := delivery.GetApi(core, lg, config)
 
+	grpcServ := delivery_comments_grpc.NewServer(core, lg, config.GrpcServerPort, config.GrpcClient.Secret)
+	go func() {
+		_ = grpcServ.ListenAndServeGrpc()
+	}()
+
 	api.ListenAndServe()
 }
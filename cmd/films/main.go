@@ -1,17 +1,20 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log/slog"
 	"os"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery"
+	delivery_films_grpc "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/delivery/grpc"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/calendar"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/crew"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/genre"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/profession"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/suggest"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
 )
 
@@ -88,8 +91,25 @@ func main() {
 		lg.Error("cant create redis repo")
 		return
 	}
-	core := usecase.GetCore(config, lg, films, genres, actors, professions, news, redisFilms)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	suggestions, err := suggest.GetSuggestRepo(ctx, config, lg)
+	if err != nil {
+		lg.Error("cant create suggest repo")
+		return
+	}
+	suggestCache, err := suggest.GetSuggestCache(*redisConfig, config.SuggestRefresh)
+	if err != nil {
+		lg.Error("cant create suggest cache")
+		return
+	}
+	core := usecase.GetCore(config, lg, films, genres, actors, professions, news, suggestions, suggestCache, redisFilms)
 	api := delivery.GetApi(core, lg, config)
 
+	grpcServ := delivery_films_grpc.NewServer(core, lg, config.GrpcServerPort, config.GrpcClient.Secret)
+	go func() {
+		_ = grpcServ.ListenAndServeGrpc()
+	}()
+
 	api.ListenAndServe()
 }
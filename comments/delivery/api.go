@@ -1,15 +1,19 @@
 package delivery
 
 import (
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/rbac"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/mailru/easyjson"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -34,15 +38,24 @@ func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.CommentCfg) *API {
 	}
 
 	api.mx.Handle("/metrics", promhttp.Handler())
-	api.mx.HandleFunc("/api/v1/comment", api.Comment)
+	api.mx.Handle("/api/v1/comment", middleware.AuthCheck(http.HandlerFunc(api.Comment), c, l))
+	api.mx.HandleFunc("/api/v1/comment/replies", api.Replies)
+	api.mx.Handle("/api/v1/comment/reply", middleware.AuthCheck(http.HandlerFunc(api.AddReply), c, l))
+	api.mx.Handle("/api/v1/comment/react", middleware.AuthCheck(http.HandlerFunc(api.React), c, l))
 	api.mx.Handle("/api/v1/comment/add", middleware.AuthCheck(http.HandlerFunc(api.AddComment), c, l))
+	api.mx.Handle("/api/v1/comment/edit", middleware.AuthCheck(http.HandlerFunc(api.EditComment), c, l))
 	api.mx.Handle("/api/v1/comment/delete", middleware.AuthCheck(http.HandlerFunc(api.DeleteComment), c, l))
+	api.mx.Handle("/api/v1/comment/report", middleware.AuthCheck(http.HandlerFunc(api.ReportComment), c, l))
+	api.mx.Handle("/api/v1/comment/moderate/queue", middleware.AuthCheck(
+		middleware.RequirePermission(http.HandlerFunc(api.ModerationQueue), c, rbac.ModerateComments, l), c, l))
+	api.mx.Handle("/api/v1/comment/moderate", middleware.AuthCheck(
+		middleware.RequirePermission(http.HandlerFunc(api.Moderate), c, rbac.ModerateComments, l), c, l))
 
 	return api
 }
 
 func (a *API) ListenAndServe() {
-	err := http.ListenAndServe(a.adress, a.mx)
+	err := http.ListenAndServe(a.adress, middleware.RequestID(a.mx))
 	if err != nil {
 		a.lg.Error("listen and serve error", "err", err.Error())
 	}
@@ -63,26 +76,33 @@ func (a *API) Comment(w http.ResponseWriter, r *http.Request) {
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 10)
 	if err != nil {
-		page = 1
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
-	pageSize, err := strconv.ParseUint(r.URL.Query().Get("per_page"), 10, 64)
-	if err != nil {
-		pageSize = 10
+	sort := r.URL.Query().Get("sort")
+	if sort == "" {
+		sort = comment.SortNew
 	}
+	// Guests see the comments without a reaction of their own.
+	userId, _ := r.Context().Value(middleware.UserIDKey).(uint64)
 
-	comments, err := a.core.GetFilmComments(filmId, (page-1)*pageSize, pageSize)
+	comments, err := a.core.GetFilmComments(r.Context(), filmId, userId, sort, page)
 	if err != nil {
+		if errors.Is(err, usecase.ErrUnknownSort) || errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("Comment", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	commentsResponse := requests.CommentResponse{Comments: comments}
-
-	response.Body = commentsResponse
+	response.Body = comments
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
@@ -107,26 +127,207 @@ func (a *API) AddComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = easyjson.Unmarshal(body, &commentRequest); err != nil {
+	if err = easyjson.Unmarshal(body, &commentRequest); err != nil || commentRequest.Text == "" {
 		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	found, err := a.core.AddComment(commentRequest.FilmId, userId, commentRequest.Rating, commentRequest.Text)
+	_, err = a.core.AddComment(commentRequest.FilmId, userId, commentRequest.Title, commentRequest.Text, commentRequest.Spoiler)
 	if err != nil {
 		a.lg.Error("Add Comment error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 	}
-	if found {
-		response.Status = http.StatusNotAcceptable
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// EditComment replaces the review of the user to the film.
+func (a *API) EditComment(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPut {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var commentRequest requests.CommentRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &commentRequest); err != nil || commentRequest.Text == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.EditComment(commentRequest.FilmId, userId, commentRequest.Title, commentRequest.Text, commentRequest.Spoiler)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("edit comment error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// Replies returns the replies to the comment, oldest first.
+func (a *API) Replies(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	commentId, err := strconv.ParseUint(r.URL.Query().Get("comment_id"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 10)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	replies, err := a.core.GetReplies(r.Context(), commentId, page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("replies error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = replies
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// AddReply replies to the comment.
+func (a *API) AddReply(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ReplyRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Text == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	id, err := a.core.AddReply(request.CommentId, userId, request.Text)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("add reply error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.AddReplyResponse{Id: id}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// React likes or dislikes the comment, or takes the reaction back.
+func (a *API) React(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ReactionRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil {
+		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
+	err = a.core.SetReaction(request.CommentId, userId, request.Reaction)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrBadReaction):
+			response.Status = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrNotFound):
+			response.Status = http.StatusNotFound
+		default:
+			a.lg.Error("react error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+	}
+
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// DeleteComment deletes the comment of the user to the film.
 func (a *API) DeleteComment(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -137,6 +338,13 @@ func (a *API) DeleteComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
 	var request requests.DeleteCommentRequest
 
 	body, err := io.ReadAll(r.Body)
@@ -152,11 +360,143 @@ func (a *API) DeleteComment(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = a.core.DeleteComment(request.IdUser, request.IdFilm)
+	err = a.core.DeleteComment(userId, request.IdFilm)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// ReportComment reports the comment to the moderators.
+func (a *API) ReportComment(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ReportRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Reason == "" {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	err = a.core.ReportComment(request.CommentId, userId, request.Reason)
 	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("report comment error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+	}
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// ModerationQueue lists the comments with unresolved reports.
+func (a *API) ModerationQueue(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 20)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	queue, err := a.core.GetModerationQueue(r.Context(), page)
+	if err != nil {
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("moderation queue error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = queue
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// Moderate hides, restores or deletes the comment of any user.
+func (a *API) Moderate(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	if r.Method != http.MethodPost {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	moderatorId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.ModerateRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &request); err != nil || request.Reason == "" {
 		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+
+	err = a.core.Moderate(request.CommentId, moderatorId, request.Action, request.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrBadAction):
+			response.Status = http.StatusBadRequest
+		case errors.Is(err, usecase.ErrNotFound):
+			response.Status = http.StatusNotFound
+		default:
+			a.lg.Error("moderate error", "err", err.Error())
+			response.Status = http.StatusInternalServerError
+		}
+	}
+
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
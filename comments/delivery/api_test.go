@@ -12,7 +12,10 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/golang/mock/gomock"
 	"github.com/mailru/easyjson"
@@ -30,6 +33,16 @@ func getResponse(w *httptest.ResponseRecorder) (*requests.Response, error) {
 	return &response, nil
 }
 
+func getExpectedResult(res requests.Response) requests.Response {
+	jsonResponse, _ := easyjson.Marshal(res)
+	var response requests.Response
+	err := easyjson.Unmarshal(jsonResponse, &response)
+	if err != nil {
+		fmt.Println("unexpected error")
+	}
+	return response
+}
+
 func createBody(req requests.CommentRequest) io.Reader {
 	jsonReq, _ := easyjson.Marshal(req)
 
@@ -40,6 +53,12 @@ func createBody(req requests.CommentRequest) io.Reader {
 var collector *requests.Collector = requests.GetCollector()
 
 func TestComment(t *testing.T) {
+	expected := requests.CommentResponse{
+		Comments:   []models.CommentItem{{Id: 3, IdUser: 1, Title: "t", Comment: "c"}},
+		Total:      6,
+		NextCursor: pagination.Encode([]string{"0", "3"}),
+	}
+
 	testCases := map[string]struct {
 		method string
 		params map[string]string
@@ -60,13 +79,32 @@ func TestComment(t *testing.T) {
 			params: map[string]string{"film_id": "0"},
 			result: requests.Response{Status: http.StatusInternalServerError, Body: nil},
 		},
+		"Unknown sort": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "1", "sort": "old"},
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Bad cursor": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "1", "cursor": "bad"},
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodGet,
+			params: map[string]string{"film_id": "2", "sort": "top", "cursor": pagination.Encode([]string{"1", "2"}), "per_page": "5"},
+			result: getExpectedResult(requests.Response{Status: http.StatusOK, Body: expected}),
+		},
 	}
 
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().GetFilmComments(uint64(0), uint64(0), uint64(10)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmComments(gomock.Any(), uint64(0), uint64(0), "new", pagination.Page{Limit: 10}).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmComments(gomock.Any(), uint64(1), uint64(0), "old", pagination.Page{Limit: 10}).
+		Return(nil, fmt.Errorf("get comments err: %w", usecase.ErrUnknownSort)).Times(1)
+	mockCore.EXPECT().GetFilmComments(gomock.Any(), uint64(2), uint64(0), "top", pagination.Page{After: []string{"1", "2"}, Limit: 5}).
+		Return(&expected, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -114,20 +152,25 @@ func TestCommentAdd(t *testing.T) {
 			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
 			body:   nil,
 		},
+		"empty text": {
+			method: http.MethodPost,
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 1, Text: ""}),
+		},
 		"add comment error": {
 			method: http.MethodPost,
 			result: requests.Response{Status: http.StatusInternalServerError, Body: nil},
-			body:   createBody(requests.CommentRequest{Rating: 10, FilmId: 1, Text: ""}),
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 1, Text: "c"}),
 		},
 		"found error": {
 			method: http.MethodPost,
-			result: requests.Response{Status: http.StatusNotAcceptable, Body: nil},
-			body:   createBody(requests.CommentRequest{Rating: 10, FilmId: 2, Text: ""}),
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 2, Text: "c"}),
 		},
 		"Ok": {
 			method: http.MethodPost,
 			result: requests.Response{Status: http.StatusOK, Body: nil},
-			body:   createBody(requests.CommentRequest{Rating: 10, FilmId: 3, Text: ""}),
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 3, Text: "c", Spoiler: true}),
 		},
 	}
 
@@ -135,9 +178,9 @@ func TestCommentAdd(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().AddComment(uint64(1), uint64(1), uint16(10), string("")).Return(false, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().AddComment(uint64(2), uint64(1), uint16(10), string("")).Return(true, nil).Times(1)
-	mockCore.EXPECT().AddComment(uint64(3), uint64(1), uint16(10), string("")).Return(false, nil).Times(1)
+	mockCore.EXPECT().AddComment(uint64(1), uint64(1), "t", "c", false).Return(false, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().AddComment(uint64(2), uint64(1), "t", "c", false).Return(true, nil).Times(1)
+	mockCore.EXPECT().AddComment(uint64(3), uint64(1), "t", "c", true).Return(false, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -165,3 +208,244 @@ func TestCommentAdd(t *testing.T) {
 		}
 	}
 }
+
+func TestEditComment(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		userId interface{}
+		result requests.Response
+		body   io.Reader
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			userId: uint64(1),
+			result: requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"Anonymous": {
+			method: http.MethodPut,
+			result: requests.Response{Status: http.StatusUnauthorized, Body: nil},
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 1, Text: "c"}),
+		},
+		"Not found": {
+			method: http.MethodPut,
+			userId: uint64(1),
+			result: requests.Response{Status: http.StatusNotFound, Body: nil},
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 1, Text: "c"}),
+		},
+		"Ok": {
+			method: http.MethodPut,
+			userId: uint64(1),
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+			body:   createBody(requests.CommentRequest{Title: "t", FilmId: 2, Text: "c"}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().EditComment(uint64(1), uint64(1), "t", "c", false).Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().EditComment(uint64(2), uint64(1), "t", "c", false).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/comment/edit", curr.body)
+		if curr.userId != nil {
+			r = r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, curr.userId))
+		}
+		w := httptest.NewRecorder()
+
+		api.EditComment(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, wanted: %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
+
+func TestReact(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		body   requests.ReactionRequest
+		result requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			result: requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"Bad reaction": {
+			method: http.MethodPost,
+			body:   requests.ReactionRequest{CommentId: 1, Reaction: 2},
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Not found": {
+			method: http.MethodPost,
+			body:   requests.ReactionRequest{CommentId: 2, Reaction: 1},
+			result: requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			body:   requests.ReactionRequest{CommentId: 3, Reaction: -1},
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().SetReaction(uint64(1), uint64(1), int8(2)).Return(usecase.ErrBadReaction).Times(1)
+	mockCore.EXPECT().SetReaction(uint64(2), uint64(1), int8(1)).Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().SetReaction(uint64(3), uint64(1), int8(-1)).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		jsonReq, _ := easyjson.Marshal(curr.body)
+		r := httptest.NewRequest(curr.method, "/api/v1/comment/react", bytes.NewBuffer(jsonReq))
+		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		w := httptest.NewRecorder()
+
+		api.React(w, newReq)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, wanted: %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
+
+func TestModerate(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		body   requests.ModerateRequest
+		result requests.Response
+	}{
+		"Bad method": {
+			method: http.MethodGet,
+			result: requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"No reason": {
+			method: http.MethodPost,
+			body:   requests.ModerateRequest{CommentId: 1, Action: "hide"},
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Bad action": {
+			method: http.MethodPost,
+			body:   requests.ModerateRequest{CommentId: 1, Action: "ban", Reason: "spam"},
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Not found": {
+			method: http.MethodPost,
+			body:   requests.ModerateRequest{CommentId: 2, Action: "hide", Reason: "spam"},
+			result: requests.Response{Status: http.StatusNotFound, Body: nil},
+		},
+		"Ok": {
+			method: http.MethodPost,
+			body:   requests.ModerateRequest{CommentId: 3, Action: "delete", Reason: "abuse"},
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().Moderate(uint64(1), uint64(1), "ban", "spam").Return(usecase.ErrBadAction).Times(1)
+	mockCore.EXPECT().Moderate(uint64(2), uint64(1), "hide", "spam").Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().Moderate(uint64(3), uint64(1), "delete", "abuse").Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		jsonReq, _ := easyjson.Marshal(curr.body)
+		r := httptest.NewRequest(curr.method, "/api/v1/comment/moderate", bytes.NewBuffer(jsonReq))
+		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+		w := httptest.NewRecorder()
+
+		api.Moderate(w, newReq)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, wanted: %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
+
+func TestModerationQueue(t *testing.T) {
+	testCases := map[string]struct {
+		query  string
+		result requests.Response
+	}{
+		"Default page": {
+			query:  "",
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+		},
+		"Capped page": {
+			query:  "?page=2&per_page=1000000",
+			result: requests.Response{Status: http.StatusOK, Body: nil},
+		},
+		"Core error": {
+			query:  "?page=3&per_page=5",
+			result: requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		"Bad cursor": {
+			query:  "?cursor=%21%21",
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+		"Stale cursor": {
+			query:  "?cursor=" + pagination.Encode([]string{"1"}),
+			result: requests.Response{Status: http.StatusBadRequest, Body: nil},
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetModerationQueue(gomock.Any(), pagination.Page{Limit: 20}).Return(&requests.ModerationQueueResponse{}, nil).Times(1)
+	mockCore.EXPECT().GetModerationQueue(gomock.Any(), pagination.Page{Offset: pagination.MaxLimit, Limit: pagination.MaxLimit}).Return(&requests.ModerationQueueResponse{}, nil).Times(1)
+	mockCore.EXPECT().GetModerationQueue(gomock.Any(), pagination.Page{Offset: 10, Limit: 5}).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetModerationQueue(gomock.Any(), pagination.Page{After: []string{"1"}, Limit: 20}).
+		Return(nil, fmt.Errorf("get moderation queue err: %w", pagination.ErrBadCursor)).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/comment/moderate/queue"+curr.query, nil)
+		w := httptest.NewRecorder()
+
+		api.ModerationQueue(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, wanted: %d", name, response.Status, curr.result.Status)
+			return
+		}
+	}
+}
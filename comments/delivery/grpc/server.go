@@ -0,0 +1,84 @@
+package delivery_comments_grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcserver"
+	"google.golang.org/grpc"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/proto"
+)
+
+type commentsGrpc struct {
+	grpcServ *grpc.Server
+	lg       *slog.Logger
+	port     string
+}
+
+type server struct {
+	pb.UnimplementedCommentsServer
+	core usecase.ICore
+	lg   *slog.Logger
+}
+
+// NewServer serves the comments API. Export and deletion of user data are
+// kept to the authorization service, which calls with secret.
+func NewServer(core usecase.ICore, l *slog.Logger, port string, secret string) *commentsGrpc {
+	s := grpcserver.NewServer(l, grpcserver.Internal{
+		Secret:  secret,
+		Methods: []string{pb.Comments_ExportUserData_FullMethodName, pb.Comments_DeleteUserData_FullMethodName},
+	})
+	pb.RegisterCommentsServer(s, &server{
+		core: core,
+		lg:   l,
+	})
+
+	return &commentsGrpc{grpcServ: s, lg: l, port: port}
+}
+
+func (s *server) ExportUserData(ctx context.Context, req *pb.UserDataRequest) (*pb.UserDataResponse, error) {
+	comments, err := s.core.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.UserDataResponse{Comments: make([]*pb.Comment, 0, len(comments))}
+	for _, comment := range comments {
+		response.Comments = append(response.Comments, &pb.Comment{
+			FilmId:  comment.IdFilm,
+			Title:   comment.Title,
+			Text:    comment.Comment,
+			Spoiler: comment.Spoiler,
+		})
+	}
+
+	return response, nil
+}
+
+func (s *server) DeleteUserData(ctx context.Context, req *pb.UserDataRequest) (*pb.DeleteUserDataResponse, error) {
+	err := s.core.DeleteUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteUserDataResponse{}, nil
+}
+
+func (s *commentsGrpc) ListenAndServeGrpc() error {
+	lis, err := net.Listen("tcp", s.port)
+	if err != nil {
+		s.lg.Error("failed to listen", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	if err := s.grpcServ.Serve(lis); err != nil {
+		s.lg.Error("failed to serve", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	requests "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -36,18 +38,48 @@ func (m *MockICore) EXPECT() *MockICoreMockRecorder {
 }
 
 // AddComment mocks base method.
-func (m *MockICore) AddComment(filmId, userId uint64, rating uint16, text string) (bool, error) {
+func (m *MockICore) AddComment(filmId, userId uint64, title, text string, spoiler bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddComment", filmId, userId, rating, text)
+	ret := m.ctrl.Call(m, "AddComment", filmId, userId, title, text, spoiler)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddComment indicates an expected call of AddComment.
-func (mr *MockICoreMockRecorder) AddComment(filmId, userId, rating, text interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) AddComment(filmId, userId, title, text, spoiler interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockICore)(nil).AddComment), filmId, userId, rating, text)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockICore)(nil).AddComment), filmId, userId, title, text, spoiler)
+}
+
+// AddReply mocks base method.
+func (m *MockICore) AddReply(commentId, userId uint64, text string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReply", commentId, userId, text)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReply indicates an expected call of AddReply.
+func (mr *MockICoreMockRecorder) AddReply(commentId, userId, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReply", reflect.TypeOf((*MockICore)(nil).AddReply), commentId, userId, text)
+}
+
+// CheckPermission mocks base method.
+func (m *MockICore) CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPermission", ctx, userId, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPermission indicates an expected call of CheckPermission.
+func (mr *MockICoreMockRecorder) CheckPermission(ctx, userId, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockICore)(nil).CheckPermission), ctx, userId, permission)
 }
 
 // DeleteComment mocks base method.
@@ -64,19 +96,92 @@ func (mr *MockICoreMockRecorder) DeleteComment(idUser, idFilm interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockICore)(nil).DeleteComment), idUser, idFilm)
 }
 
-// GetFilmComments mocks base method.
-func (m *MockICore) GetFilmComments(filmId, first, limit uint64) ([]models.CommentItem, error) {
+// DeleteUserData mocks base method.
+func (m *MockICore) DeleteUserData(ctx context.Context, userId uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmComments", filmId, first, limit)
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockICoreMockRecorder) DeleteUserData(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockICore)(nil).DeleteUserData), ctx, userId)
+}
+
+// EditComment mocks base method.
+func (m *MockICore) EditComment(filmId, userId uint64, title, text string, spoiler bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditComment", filmId, userId, title, text, spoiler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditComment indicates an expected call of EditComment.
+func (mr *MockICoreMockRecorder) EditComment(filmId, userId, title, text, spoiler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockICore)(nil).EditComment), filmId, userId, title, text, spoiler)
+}
+
+// ExportUserData mocks base method.
+func (m *MockICore) ExportUserData(ctx context.Context, userId uint64) ([]models.CommentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userId)
 	ret0, _ := ret[0].([]models.CommentItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockICoreMockRecorder) ExportUserData(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockICore)(nil).ExportUserData), ctx, userId)
+}
+
+// GetFilmComments mocks base method.
+func (m *MockICore) GetFilmComments(ctx context.Context, filmId, userId uint64, sort string, page pagination.Page) (*requests.CommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmComments", ctx, filmId, userId, sort, page)
+	ret0, _ := ret[0].(*requests.CommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetFilmComments indicates an expected call of GetFilmComments.
-func (mr *MockICoreMockRecorder) GetFilmComments(filmId, first, limit interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetFilmComments(ctx, filmId, userId, sort, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICore)(nil).GetFilmComments), filmId, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICore)(nil).GetFilmComments), ctx, filmId, userId, sort, page)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockICore) GetModerationQueue(ctx context.Context, page pagination.Page) (*requests.ModerationQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", ctx, page)
+	ret0, _ := ret[0].(*requests.ModerationQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockICoreMockRecorder) GetModerationQueue(ctx, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockICore)(nil).GetModerationQueue), ctx, page)
+}
+
+// GetReplies mocks base method.
+func (m *MockICore) GetReplies(ctx context.Context, commentId uint64, page pagination.Page) (*requests.RepliesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", ctx, commentId, page)
+	ret0, _ := ret[0].(*requests.RepliesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockICoreMockRecorder) GetReplies(ctx, commentId, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockICore)(nil).GetReplies), ctx, commentId, page)
 }
 
 // GetUserId mocks base method.
@@ -93,3 +198,60 @@ func (mr *MockICoreMockRecorder) GetUserId(ctx, sid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserId", reflect.TypeOf((*MockICore)(nil).GetUserId), ctx, sid)
 }
+
+// Moderate mocks base method.
+func (m *MockICore) Moderate(commentId, moderatorId uint64, action, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderate", commentId, moderatorId, action, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Moderate indicates an expected call of Moderate.
+func (mr *MockICoreMockRecorder) Moderate(commentId, moderatorId, action, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderate", reflect.TypeOf((*MockICore)(nil).Moderate), commentId, moderatorId, action, reason)
+}
+
+// ReportComment mocks base method.
+func (m *MockICore) ReportComment(commentId, userId uint64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportComment", commentId, userId, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportComment indicates an expected call of ReportComment.
+func (mr *MockICoreMockRecorder) ReportComment(commentId, userId, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportComment", reflect.TypeOf((*MockICore)(nil).ReportComment), commentId, userId, reason)
+}
+
+// SetReaction mocks base method.
+func (m *MockICore) SetReaction(commentId, userId uint64, reaction int8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReaction", commentId, userId, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReaction indicates an expected call of SetReaction.
+func (mr *MockICoreMockRecorder) SetReaction(commentId, userId, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReaction", reflect.TypeOf((*MockICore)(nil).SetReaction), commentId, userId, reaction)
+}
+
+// VerifyAccessToken mocks base method.
+func (m *MockICore) VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAccessToken", ctx, accessToken)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAccessToken indicates an expected call of VerifyAccessToken.
+func (mr *MockICoreMockRecorder) VerifyAccessToken(ctx, accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAccessToken", reflect.TypeOf((*MockICore)(nil).VerifyAccessToken), ctx, accessToken)
+}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,17 +36,49 @@ func (m *MockICommentRepo) EXPECT() *MockICommentRepoMockRecorder {
 }
 
 // AddComment mocks base method.
-func (m *MockICommentRepo) AddComment(filmId, userId uint64, rating uint16, text string) error {
+func (m *MockICommentRepo) AddComment(filmId, userId uint64, title, text string, spoiler bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddComment", filmId, userId, rating, text)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddComment", filmId, userId, title, text, spoiler)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddComment indicates an expected call of AddComment.
-func (mr *MockICommentRepoMockRecorder) AddComment(filmId, userId, rating, text interface{}) *gomock.Call {
+func (mr *MockICommentRepoMockRecorder) AddComment(filmId, userId, title, text, spoiler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockICommentRepo)(nil).AddComment), filmId, userId, title, text, spoiler)
+}
+
+// AddReply mocks base method.
+func (m *MockICommentRepo) AddReply(commentId, userId uint64, text string) (uint64, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReply", commentId, userId, text)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddReply indicates an expected call of AddReply.
+func (mr *MockICommentRepoMockRecorder) AddReply(commentId, userId, text interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockICommentRepo)(nil).AddComment), filmId, userId, rating, text)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReply", reflect.TypeOf((*MockICommentRepo)(nil).AddReply), commentId, userId, text)
+}
+
+// CountFilmComments mocks base method.
+func (m *MockICommentRepo) CountFilmComments(filmId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilmComments", filmId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilmComments indicates an expected call of CountFilmComments.
+func (mr *MockICommentRepoMockRecorder) CountFilmComments(filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilmComments", reflect.TypeOf((*MockICommentRepo)(nil).CountFilmComments), filmId)
 }
 
 // DeleteComment mocks base method.
@@ -62,19 +95,81 @@ func (mr *MockICommentRepoMockRecorder) DeleteComment(idUser, idFilm interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockICommentRepo)(nil).DeleteComment), idUser, idFilm)
 }
 
+// DeleteUserComments mocks base method.
+func (m *MockICommentRepo) DeleteUserComments(userId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserComments", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserComments indicates an expected call of DeleteUserComments.
+func (mr *MockICommentRepoMockRecorder) DeleteUserComments(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserComments", reflect.TypeOf((*MockICommentRepo)(nil).DeleteUserComments), userId)
+}
+
 // GetFilmComments mocks base method.
-func (m *MockICommentRepo) GetFilmComments(filmId, first, limit uint64) ([]models.CommentItem, error) {
+func (m *MockICommentRepo) GetFilmComments(filmId, userId uint64, sort string, page pagination.Page) ([]models.CommentItem, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmComments", filmId, userId, sort, page)
+	ret0, _ := ret[0].([]models.CommentItem)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFilmComments indicates an expected call of GetFilmComments.
+func (mr *MockICommentRepoMockRecorder) GetFilmComments(filmId, userId, sort, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICommentRepo)(nil).GetFilmComments), filmId, userId, sort, page)
+}
+
+// GetReplies mocks base method.
+func (m *MockICommentRepo) GetReplies(commentId uint64, page pagination.Page) ([]models.ReplyItem, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", commentId, page)
+	ret0, _ := ret[0].([]models.ReplyItem)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReplies indicates an expected call of GetReplies.
+func (mr *MockICommentRepoMockRecorder) GetReplies(commentId, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockICommentRepo)(nil).GetReplies), commentId, page)
+}
+
+// GetReportedComments mocks base method.
+func (m *MockICommentRepo) GetReportedComments(page pagination.Page) ([]models.ReportedComment, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportedComments", page)
+	ret0, _ := ret[0].([]models.ReportedComment)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReportedComments indicates an expected call of GetReportedComments.
+func (mr *MockICommentRepoMockRecorder) GetReportedComments(page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportedComments", reflect.TypeOf((*MockICommentRepo)(nil).GetReportedComments), page)
+}
+
+// GetUserComments mocks base method.
+func (m *MockICommentRepo) GetUserComments(userId uint64) ([]models.CommentItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmComments", filmId, first, limit)
+	ret := m.ctrl.Call(m, "GetUserComments", userId)
 	ret0, _ := ret[0].([]models.CommentItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFilmComments indicates an expected call of GetFilmComments.
-func (mr *MockICommentRepoMockRecorder) GetFilmComments(filmId, first, limit interface{}) *gomock.Call {
+// GetUserComments indicates an expected call of GetUserComments.
+func (mr *MockICommentRepoMockRecorder) GetUserComments(userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmComments", reflect.TypeOf((*MockICommentRepo)(nil).GetFilmComments), filmId, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserComments", reflect.TypeOf((*MockICommentRepo)(nil).GetUserComments), userId)
 }
 
 // HasUsersComment mocks base method.
@@ -91,3 +186,63 @@ func (mr *MockICommentRepoMockRecorder) HasUsersComment(userId, filmId interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUsersComment", reflect.TypeOf((*MockICommentRepo)(nil).HasUsersComment), userId, filmId)
 }
+
+// Moderate mocks base method.
+func (m *MockICommentRepo) Moderate(commentId, moderatorId uint64, action, reason string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Moderate", commentId, moderatorId, action, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Moderate indicates an expected call of Moderate.
+func (mr *MockICommentRepoMockRecorder) Moderate(commentId, moderatorId, action, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Moderate", reflect.TypeOf((*MockICommentRepo)(nil).Moderate), commentId, moderatorId, action, reason)
+}
+
+// ReportComment mocks base method.
+func (m *MockICommentRepo) ReportComment(commentId, userId uint64, reason string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportComment", commentId, userId, reason)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportComment indicates an expected call of ReportComment.
+func (mr *MockICommentRepoMockRecorder) ReportComment(commentId, userId, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportComment", reflect.TypeOf((*MockICommentRepo)(nil).ReportComment), commentId, userId, reason)
+}
+
+// SetReaction mocks base method.
+func (m *MockICommentRepo) SetReaction(commentId, userId uint64, reaction int8) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReaction", commentId, userId, reaction)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReaction indicates an expected call of SetReaction.
+func (mr *MockICommentRepoMockRecorder) SetReaction(commentId, userId, reaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReaction", reflect.TypeOf((*MockICommentRepo)(nil).SetReaction), commentId, userId, reaction)
+}
+
+// UpdateComment mocks base method.
+func (m *MockICommentRepo) UpdateComment(filmId, userId uint64, title, text string, spoiler bool) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", filmId, userId, title, text, spoiler)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockICommentRepoMockRecorder) UpdateComment(filmId, userId, title, text, spoiler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockICommentRepo)(nil).UpdateComment), filmId, userId, title, text, spoiler)
+}
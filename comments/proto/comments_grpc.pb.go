@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: comments.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Comments_ExportUserData_FullMethodName = "/comments.Comments/ExportUserData"
+	Comments_DeleteUserData_FullMethodName = "/comments.Comments/DeleteUserData"
+)
+
+// CommentsClient is the client API for Comments service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentsClient interface {
+	ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
+}
+
+type commentsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentsClient(cc grpc.ClientConnInterface) CommentsClient {
+	return &commentsClient{cc}
+}
+
+func (c *commentsClient) ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error) {
+	out := new(UserDataResponse)
+	err := c.cc.Invoke(ctx, Comments_ExportUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) DeleteUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, Comments_DeleteUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentsServer is the server API for Comments service.
+// All implementations must embed UnimplementedCommentsServer
+// for forward compatibility
+type CommentsServer interface {
+	ExportUserData(context.Context, *UserDataRequest) (*UserDataResponse, error)
+	DeleteUserData(context.Context, *UserDataRequest) (*DeleteUserDataResponse, error)
+	mustEmbedUnimplementedCommentsServer()
+}
+
+// UnimplementedCommentsServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentsServer struct {
+}
+
+func (UnimplementedCommentsServer) ExportUserData(context.Context, *UserDataRequest) (*UserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedCommentsServer) DeleteUserData(context.Context, *UserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedCommentsServer) mustEmbedUnimplementedCommentsServer() {}
+
+// UnsafeCommentsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentsServer will
+// result in compilation errors.
+type UnsafeCommentsServer interface {
+	mustEmbedUnimplementedCommentsServer()
+}
+
+func RegisterCommentsServer(s grpc.ServiceRegistrar, srv CommentsServer) {
+	s.RegisterService(&Comments_ServiceDesc, srv)
+}
+
+func _Comments_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).ExportUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Comments_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).DeleteUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Comments_ServiceDesc is the grpc.ServiceDesc for Comments service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Comments_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "comments.Comments",
+	HandlerType: (*CommentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Comments_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _Comments_DeleteUserData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comments.proto",
+}
@@ -2,24 +2,102 @@ package comment
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 
 	_ "github.com/jackc/pgx/stdlib"
 )
 
 //go:generate mockgen -source=repo_comment.go -destination=../../mocks/repo_mock.go -package=mocks
 
+const (
+	SortNew           = "new"
+	SortTop           = "top"
+	SortControversial = "controversial"
+)
+
+// commentOrders are the orders of the sorts of the comments. Controversial
+// comments are the ones with many reactions of both kinds.
+var commentOrders = map[string]pagination.Order{
+	SortNew: {
+		Columns: []pagination.Column{
+			{Expr: "reviews.date", Type: "TIMESTAMP"},
+			{Expr: "reviews.id", Type: "BIGINT"},
+		},
+		Desc: true,
+	},
+	SortTop: {
+		Columns: []pagination.Column{
+			{Expr: "(reviews.likes - reviews.dislikes)", Type: "INTEGER"},
+			{Expr: "reviews.date", Type: "TIMESTAMP"},
+			{Expr: "reviews.id", Type: "BIGINT"},
+		},
+		Desc: true,
+	},
+	SortControversial: {
+		Columns: []pagination.Column{
+			{Expr: "LEAST(reviews.likes, reviews.dislikes)", Type: "INTEGER"},
+			{Expr: "(reviews.likes + reviews.dislikes)", Type: "INTEGER"},
+			{Expr: "reviews.id", Type: "BIGINT"},
+		},
+		Desc: true,
+	},
+}
+
+// repliesOrder is the order of the replies, oldest first.
+var repliesOrder = pagination.Order{
+	Columns: []pagination.Column{
+		{Expr: "comment_replies.date", Type: "TIMESTAMP"},
+		{Expr: "comment_replies.id", Type: "BIGINT"},
+	},
+}
+
+// reportsOrder is the order of the moderation queue, the most reported
+// comments first.
+var reportsOrder = pagination.Order{
+	Columns: []pagination.Column{
+		{Expr: "queue.report_count", Type: "BIGINT"},
+		{Expr: "queue.last_reported", Type: "TIMESTAMP"},
+		{Expr: "queue.id", Type: "BIGINT"},
+	},
+	Desc: true,
+}
+
+// Moderator actions, as they are written to moderation_log.
+const (
+	ActionHide    = "hide"
+	ActionRestore = "restore"
+	ActionDelete  = "delete"
+)
+
+func IsSort(sort string) bool {
+	_, found := commentOrders[sort]
+	return found
+}
+
 type ICommentRepo interface {
-	GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
-	AddComment(filmId uint64, userId uint64, rating uint16, text string) error
+	GetFilmComments(filmId uint64, userId uint64, sort string, page pagination.Page) ([]models.CommentItem, string, error)
+	CountFilmComments(filmId uint64) (uint64, error)
+	AddReply(commentId uint64, userId uint64, text string) (uint64, bool, error)
+	GetReplies(commentId uint64, page pagination.Page) ([]models.ReplyItem, string, error)
+	SetReaction(commentId uint64, userId uint64, reaction int8) (bool, error)
+	ReportComment(commentId uint64, userId uint64, reason string) (bool, error)
+	GetReportedComments(page pagination.Page) ([]models.ReportedComment, string, error)
+	Moderate(commentId uint64, moderatorId uint64, action string, reason string) (bool, error)
+	AddComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error)
+	UpdateComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error)
 	HasUsersComment(userId uint64, filmId uint64) (bool, error)
 	DeleteComment(idUser uint64, idFilm uint64) error
+	GetUserComments(userId uint64) ([]models.CommentItem, error)
+	DeleteUserComments(userId uint64) error
 }
 
 type RepoPostgre struct {
@@ -58,45 +136,464 @@ func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
 	}
 }
 
-func (repo *RepoPostgre) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
+// GetFilmComments returns the page of the comments of the film in the sort
+// order, with the reaction of the user to each of them, and the cursor of
+// the next page.
+func (repo *RepoPostgre) GetFilmComments(filmId uint64, userId uint64, sort string, page pagination.Page) ([]models.CommentItem, string, error) {
 	comments := []models.CommentItem{}
 
-	rows, err := repo.db.Query(
-		"SELECT id_user, rating, comment FROM users_comment "+
-			"WHERE id_film = $1 "+
-			"OFFSET $2 LIMIT $3", filmId, first, limit)
+	order, found := commentOrders[sort]
+	if !found {
+		return nil, "", fmt.Errorf("GetFilmComments err: unknown sort %q", sort)
+	}
+
+	var s strings.Builder
+	s.WriteString("SELECT reviews.id, reviews.id_user, title, body, spoiler, edited_at, likes, dislikes, reply_count, " +
+		"COALESCE(comment_reactions.value, 0), " + order.Key() + " FROM reviews " +
+		"LEFT JOIN comment_reactions ON comment_reactions.id_comment = reviews.id AND comment_reactions.id_user = $2 " +
+		"WHERE reviews.id_film = $1 AND NOT reviews.hidden ")
+	params, err := page.Write(&s, order, true, 3)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilmComments err: %w", err)
+	}
+
+	rows, err := repo.db.Query(s.String(), append([]interface{}{filmId, userId}, params...)...)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("GetFilmRating err: %w", err)
+		return nil, "", fmt.Errorf("GetFilmRating err: %w", err)
 	}
 	defer rows.Close()
 
+	var next string
+	var lastKey []string
 	for rows.Next() {
-		post := models.CommentItem{}
-		err := rows.Scan(&post.IdUser, &post.Rating, &post.Comment)
+		post := models.CommentItem{IdFilm: filmId}
+		var editedAt sql.NullTime
+		key, keyDest := order.KeyDest()
+		err := rows.Scan(append([]interface{}{&post.Id, &post.IdUser, &post.Title, &post.Comment, &post.Spoiler, &editedAt,
+			&post.Likes, &post.Dislikes, &post.ReplyCount, &post.Reaction}, keyDest...)...)
 		if err != nil {
-			return nil, fmt.Errorf("GetFilmRating scan err: %w", err)
+			return nil, "", fmt.Errorf("GetFilmRating scan err: %w", err)
+		}
+		if page.Full(len(comments)) {
+			next = pagination.Encode(lastKey)
+			break
+		}
+		if editedAt.Valid {
+			post.EditedAt = editedAt.Time.Format(time.RFC3339)
 		}
 		comments = append(comments, post)
+		lastKey = key
 	}
 
-	return comments, nil
+	return comments, next, nil
 }
 
-func (repo *RepoPostgre) AddComment(filmId uint64, userId uint64, rating uint16, text string) error {
-	_, err := repo.db.Exec(
-		"INSERT INTO users_comment(id_film, rating, comment, id_user) "+
-			"VALUES($1, $2, $3, $4)", filmId, rating, text, userId)
+// CountFilmComments counts the comments of the film shown to the users.
+func (repo *RepoPostgre) CountFilmComments(filmId uint64) (uint64, error) {
+	var count uint64
+	err := repo.db.QueryRow(
+		"SELECT COUNT(*) FROM reviews WHERE id_film = $1 AND NOT hidden", filmId).Scan(&count)
 	if err != nil {
-		return fmt.Errorf("AddComment: %w", err)
+		return 0, fmt.Errorf("CountFilmComments err: %w", err)
 	}
 
-	return nil
+	return count, nil
+}
+
+// AddReply replies to the comment and returns the id of the reply. It
+// returns false if there is no such comment or it is hidden.
+func (repo *RepoPostgre) AddReply(commentId uint64, userId uint64, text string) (uint64, bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return 0, false, fmt.Errorf("add reply err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec("UPDATE reviews SET reply_count = reply_count + 1 WHERE id = $1 AND NOT hidden", commentId)
+	if err != nil {
+		return 0, false, fmt.Errorf("add reply err: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, false, fmt.Errorf("add reply err: %w", err)
+	}
+	if affected == 0 {
+		return 0, false, nil
+	}
+
+	var id uint64
+	err = tx.QueryRow("INSERT INTO comment_replies(parent_id, id_user, body) "+
+		"VALUES($1, $2, $3) RETURNING id", commentId, userId, text).Scan(&id)
+	if err != nil {
+		return 0, false, fmt.Errorf("add reply err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, false, fmt.Errorf("add reply err: %w", err)
+	}
+
+	return id, true, nil
+}
+
+// GetReplies returns the page of the replies to the comment, oldest first,
+// and the cursor of the next page. A hidden comment has no replies to show.
+func (repo *RepoPostgre) GetReplies(commentId uint64, page pagination.Page) ([]models.ReplyItem, string, error) {
+	replies := []models.ReplyItem{}
+
+	var s strings.Builder
+	s.WriteString("SELECT comment_replies.id, comment_replies.id_user, comment_replies.body, comment_replies.date, " +
+		repliesOrder.Key() + " FROM comment_replies " +
+		"JOIN reviews ON reviews.id = comment_replies.parent_id " +
+		"WHERE comment_replies.parent_id = $1 AND NOT reviews.hidden ")
+	params, err := page.Write(&s, repliesOrder, true, 2)
+	if err != nil {
+		return nil, "", fmt.Errorf("get replies err: %w", err)
+	}
+
+	rows, err := repo.db.Query(s.String(), append([]interface{}{commentId}, params...)...)
+	if err != nil {
+		return nil, "", fmt.Errorf("get replies err: %w", err)
+	}
+	defer rows.Close()
+
+	var next string
+	var lastKey []string
+	for rows.Next() {
+		reply := models.ReplyItem{ParentId: commentId}
+		var date time.Time
+		key, keyDest := repliesOrder.KeyDest()
+		err := rows.Scan(append([]interface{}{&reply.Id, &reply.IdUser, &reply.Text, &date}, keyDest...)...)
+		if err != nil {
+			return nil, "", fmt.Errorf("get replies scan err: %w", err)
+		}
+		if page.Full(len(replies)) {
+			next = pagination.Encode(lastKey)
+			break
+		}
+		reply.Date = date.Format(time.RFC3339)
+		replies = append(replies, reply)
+		lastKey = key
+	}
+
+	return replies, next, nil
+}
+
+// SetReaction sets the reaction of the user to the comment, 0 takes it
+// back. It returns false if there is no such comment or it is hidden.
+func (repo *RepoPostgre) SetReaction(commentId uint64, userId uint64, reaction int8) (bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var id uint64
+	err = tx.QueryRow("SELECT id FROM reviews WHERE id = $1 AND NOT hidden FOR UPDATE", commentId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+
+	var previous int8
+	err = tx.QueryRow("SELECT value FROM comment_reactions "+
+		"WHERE id_comment = $1 AND id_user = $2", commentId, userId).Scan(&previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+	if previous == reaction {
+		return true, nil
+	}
+
+	if reaction == 0 {
+		_, err = tx.Exec("DELETE FROM comment_reactions WHERE id_comment = $1 AND id_user = $2", commentId, userId)
+	} else {
+		_, err = tx.Exec("INSERT INTO comment_reactions(id_comment, id_user, value) VALUES($1, $2, $3) "+
+			"ON CONFLICT (id_comment, id_user) DO UPDATE SET value = EXCLUDED.value", commentId, userId, reaction)
+	}
+	if err != nil {
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+
+	likes, dislikes := reactionCount(reaction)
+	previousLikes, previousDislikes := reactionCount(previous)
+	_, err = tx.Exec("UPDATE reviews SET likes = likes + $2, dislikes = dislikes + $3 WHERE id = $1",
+		commentId, likes-previousLikes, dislikes-previousDislikes)
+	if err != nil {
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("set reaction err: %w", err)
+	}
+
+	return true, nil
+}
+
+// ReportComment reports the comment to the moderators. A user has one
+// report per comment: reporting it again replaces the reason and reopens
+// a resolved report. It returns false if there is no such comment or it
+// is hidden.
+func (repo *RepoPostgre) ReportComment(commentId uint64, userId uint64, reason string) (bool, error) {
+	result, err := repo.db.Exec(
+		"INSERT INTO comment_reports(id_comment, id_user, reason) "+
+			"SELECT id, $2, $3 FROM reviews WHERE id = $1 AND NOT hidden "+
+			"ON CONFLICT (id_comment, id_user) DO UPDATE "+
+			"SET reason = EXCLUDED.reason, date = CURRENT_TIMESTAMP, resolved = FALSE", commentId, userId, reason)
+	if err != nil {
+		return false, fmt.Errorf("report comment err: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("report comment err: %w", err)
+	}
+
+	return affected != 0, nil
+}
+
+// GetReportedComments returns the page of the moderation queue: the
+// comments with unresolved reports, the most reported first, and the
+// cursor of the next page.
+func (repo *RepoPostgre) GetReportedComments(page pagination.Page) ([]models.ReportedComment, string, error) {
+	queue := []models.ReportedComment{}
+
+	// The reports are counted in a subquery, so that the cursor compares
+	// the counts in the WHERE of the page.
+	var s strings.Builder
+	s.WriteString("SELECT queue.id, queue.id_user, queue.id_film, queue.title, queue.body, queue.spoiler, queue.hidden, " +
+		"queue.report_count, queue.last_reported, queue.reasons, " + reportsOrder.Key() + " FROM (" +
+		"SELECT reviews.id, reviews.id_user, reviews.id_film, reviews.title, reviews.body, reviews.spoiler, reviews.hidden, " +
+		"COUNT(*) AS report_count, MAX(comment_reports.date) AS last_reported, " +
+		"json_agg(comment_reports.reason ORDER BY comment_reports.date) AS reasons " +
+		"FROM comment_reports JOIN reviews ON reviews.id = comment_reports.id_comment " +
+		"WHERE NOT comment_reports.resolved " +
+		"GROUP BY reviews.id, reviews.id_user, reviews.id_film, reviews.title, reviews.body, reviews.spoiler, reviews.hidden" +
+		") AS queue ")
+	params, err := page.Write(&s, reportsOrder, false, 1)
+	if err != nil {
+		return nil, "", fmt.Errorf("get reported comments err: %w", err)
+	}
+
+	rows, err := repo.db.Query(s.String(), params...)
+	if err != nil {
+		return nil, "", fmt.Errorf("get reported comments err: %w", err)
+	}
+	defer rows.Close()
+
+	var next string
+	var lastKey []string
+	for rows.Next() {
+		item := models.ReportedComment{}
+		var lastReported time.Time
+		var reasons []byte
+		key, keyDest := reportsOrder.KeyDest()
+		err := rows.Scan(append([]interface{}{&item.Comment.Id, &item.Comment.IdUser, &item.Comment.IdFilm, &item.Comment.Title,
+			&item.Comment.Comment, &item.Comment.Spoiler, &item.Hidden, &item.ReportCount, &lastReported, &reasons}, keyDest...)...)
+		if err != nil {
+			return nil, "", fmt.Errorf("get reported comments scan err: %w", err)
+		}
+		if page.Full(len(queue)) {
+			next = pagination.Encode(lastKey)
+			break
+		}
+		if err := json.Unmarshal(reasons, &item.Reasons); err != nil {
+			return nil, "", fmt.Errorf("get reported comments reasons err: %w", err)
+		}
+		item.LastReported = lastReported.Format(time.RFC3339)
+		queue = append(queue, item)
+		lastKey = key
+	}
+
+	return queue, next, nil
+}
+
+// Moderate hides, restores or deletes the comment, resolves its reports
+// and writes the action to the moderation log. It returns false if there
+// is no such comment.
+func (repo *RepoPostgre) Moderate(commentId uint64, moderatorId uint64, action string, reason string) (bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("moderate err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var userId, filmId uint64
+	err = tx.QueryRow("SELECT id_user, id_film FROM reviews WHERE id = $1 FOR UPDATE", commentId).Scan(&userId, &filmId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("moderate err: %w", err)
+	}
+
+	_, err = tx.Exec("INSERT INTO moderation_log(id_moderator, id_comment, id_user, id_film, action, reason) "+
+		"VALUES($1, $2, $3, $4, $5, $6)", moderatorId, commentId, userId, filmId, action, reason)
+	if err != nil {
+		return false, fmt.Errorf("moderate log err: %w", err)
+	}
+
+	switch action {
+	case ActionHide:
+		_, err = tx.Exec("UPDATE reviews SET hidden = TRUE WHERE id = $1", commentId)
+	case ActionRestore:
+		_, err = tx.Exec("UPDATE reviews SET hidden = FALSE WHERE id = $1", commentId)
+	case ActionDelete:
+		// Replies, reactions and reports go with the review.
+		_, err = tx.Exec("DELETE FROM reviews WHERE id = $1", commentId)
+	default:
+		return false, fmt.Errorf("moderate err: unknown action %q", action)
+	}
+	if err != nil {
+		return false, fmt.Errorf("moderate err: %w", err)
+	}
+
+	if action != ActionDelete {
+		_, err = tx.Exec("UPDATE comment_reports SET resolved = TRUE WHERE id_comment = $1", commentId)
+		if err != nil {
+			return false, fmt.Errorf("moderate err: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("moderate err: %w", err)
+	}
+
+	return true, nil
+}
+
+// reactionCount is how much the reaction adds to the likes and dislikes.
+func reactionCount(reaction int8) (int, int) {
+	switch {
+	case reaction > 0:
+		return 1, 0
+	case reaction < 0:
+		return 0, 1
+	default:
+		return 0, 0
+	}
+}
+
+// commentAttempts bounds the retries of AddComment when another request
+// reviews the same film for the user at the same time.
+const commentAttempts = 3
+
+// errCommentRace means the upsert replaced a review the transaction did not
+// see, so the replaced one is missing from the history.
+var errCommentRace = errors.New("concurrent comment")
+
+// AddComment reviews the film with a single upsert. If the user has already
+// reviewed it, the review is replaced, the previous one is kept in the
+// history and true is returned.
+func (repo *RepoPostgre) AddComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error) {
+	var err error
+	for attempt := 0; attempt < commentAttempts; attempt++ {
+		var replaced bool
+		replaced, err = repo.addComment(filmId, userId, title, text, spoiler)
+		if !errors.Is(err, errCommentRace) {
+			return replaced, err
+		}
+	}
+
+	return false, fmt.Errorf("AddComment: %w", err)
+}
+
+func (repo *RepoPostgre) addComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("AddComment: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec(
+		"INSERT INTO reviews_history(id_user, id_film, title, body, spoiler) "+
+			"SELECT id_user, id_film, title, body, spoiler FROM reviews "+
+			"WHERE id_user = $1 AND id_film = $2 FOR UPDATE", userId, filmId)
+	if err != nil {
+		return false, fmt.Errorf("AddComment history: %w", err)
+	}
+	found, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("AddComment: %w", err)
+	}
+
+	var replaced bool
+	err = tx.QueryRow(
+		"INSERT INTO reviews(id_film, id_user, title, body, spoiler) VALUES($1, $2, $3, $4, $5) "+
+			"ON CONFLICT (id_user, id_film) DO UPDATE SET title = EXCLUDED.title, body = EXCLUDED.body, "+
+			"spoiler = EXCLUDED.spoiler, edited_at = CURRENT_TIMESTAMP "+
+			"RETURNING xmax <> 0", filmId, userId, title, text, spoiler).Scan(&replaced)
+	if err != nil {
+		return false, fmt.Errorf("AddComment: %w", err)
+	}
+	if replaced && found == 0 {
+		return false, errCommentRace
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("AddComment: %w", err)
+	}
+
+	return replaced, nil
+}
+
+// UpdateComment replaces the review of the user to the film and keeps the
+// previous one in the history. It returns false if there was no review.
+func (repo *RepoPostgre) UpdateComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("update comment err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.Exec(
+		"INSERT INTO reviews_history(id_user, id_film, title, body, spoiler) "+
+			"SELECT id_user, id_film, title, body, spoiler FROM reviews "+
+			"WHERE id_user = $1 AND id_film = $2 FOR UPDATE", userId, filmId)
+	if err != nil {
+		return false, fmt.Errorf("update comment history err: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("update comment err: %w", err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.Exec(
+		"UPDATE reviews SET title = $3, body = $4, spoiler = $5, edited_at = CURRENT_TIMESTAMP "+
+			"WHERE id_user = $1 AND id_film = $2", userId, filmId, title, text, spoiler)
+	if err != nil {
+		return false, fmt.Errorf("update comment err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("update comment err: %w", err)
+	}
+
+	return true, nil
 }
 
 func (repo *RepoPostgre) HasUsersComment(userId uint64, filmId uint64) (bool, error) {
 	var id uint64
 	err := repo.db.QueryRow(
-		"SELECT id_user FROM users_comment "+
+		"SELECT id_user FROM reviews "+
 			"WHERE id_user = $1 AND id_film = $2", userId, filmId).Scan(&id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -110,9 +607,72 @@ func (repo *RepoPostgre) HasUsersComment(userId uint64, filmId uint64) (bool, er
 }
 
 func (repo *RepoPostgre) DeleteComment(idUser uint64, idFilm uint64) error {
-	_, err := repo.db.Exec("DELETE FROM users_comment WHERE id_user = $1 AND id_film = $2", idUser, idFilm)
+	_, err := repo.db.Exec("DELETE FROM reviews WHERE id_user = $1 AND id_film = $2", idUser, idFilm)
 	if err != nil {
 		return fmt.Errorf("delete comment err: %w", err)
 	}
+
+	return nil
+}
+
+func (repo *RepoPostgre) GetUserComments(userId uint64) ([]models.CommentItem, error) {
+	comments := []models.CommentItem{}
+
+	rows, err := repo.db.Query(
+		"SELECT id_film, title, body, spoiler FROM reviews WHERE id_user = $1", userId)
+	if err != nil {
+		return nil, fmt.Errorf("get user comments err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.CommentItem{IdUser: userId}
+		err := rows.Scan(&post.IdFilm, &post.Title, &post.Comment, &post.Spoiler)
+		if err != nil {
+			return nil, fmt.Errorf("get user comments scan err: %w", err)
+		}
+		comments = append(comments, post)
+	}
+
+	return comments, nil
+}
+
+// DeleteUserComments deletes the reviews, replies, reactions and reports of
+// the user and the history of the reviews. The moderation log is kept.
+func (repo *RepoPostgre) DeleteUserComments(userId uint64) error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return fmt.Errorf("delete user comments err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// The counters of the comments of other users go down with the
+	// reactions and replies of the user. Replies and reactions to the
+	// reviews of the user are deleted with them.
+	for _, query := range []string{
+		"WITH deleted AS (DELETE FROM comment_reactions WHERE id_user = $1 RETURNING id_comment, value) " +
+			"UPDATE reviews SET likes = likes - counts.likes, dislikes = dislikes - counts.dislikes FROM (" +
+			"SELECT id_comment, COUNT(*) FILTER (WHERE value = 1) AS likes, COUNT(*) FILTER (WHERE value = -1) AS dislikes " +
+			"FROM deleted GROUP BY id_comment) counts WHERE reviews.id = counts.id_comment",
+		"WITH deleted AS (DELETE FROM comment_replies WHERE id_user = $1 RETURNING parent_id) " +
+			"UPDATE reviews SET reply_count = reply_count - counts.replies FROM (" +
+			"SELECT parent_id, COUNT(*) AS replies FROM deleted GROUP BY parent_id) counts WHERE reviews.id = counts.parent_id",
+		"DELETE FROM comment_reports WHERE id_user = $1",
+		"DELETE FROM reviews WHERE id_user = $1",
+		"DELETE FROM reviews_history WHERE id_user = $1",
+	} {
+		_, err = tx.Exec(query, userId)
+		if err != nil {
+			return fmt.Errorf("delete user comments err: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("delete user comments err: %w", err)
+	}
+
 	return nil
 }
@@ -2,13 +2,16 @@ package comment
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 )
 
 func TestGetFilmComments(t *testing.T) {
@@ -18,26 +21,36 @@ func TestGetFilmComments(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Id", "Rating", "Comment"})
+	editedAt := time.Date(2023, 12, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"Id", "IdUser", "Title", "Comment", "Spoiler", "EditedAt", "Likes", "Dislikes", "Replies", "Reaction",
+		"Score", "Date", "Id"}).
+		AddRow(10, 1, "t1", "c1", false, nil, 3, 1, 2, 0, "2", "2023-12-01 09:00:00", "10").
+		AddRow(11, 2, "t2", "c2", true, editedAt, 1, 0, 0, 1, "1", "2023-12-01 08:00:00", "11").
+		AddRow(12, 3, "t3", "c3", false, nil, 0, 0, 0, 0, "0", "2023-12-01 07:00:00", "12")
 
 	expect := []models.CommentItem{
-		{IdUser: 1, Rating: 4, Comment: "c1"},
+		{Id: 10, IdUser: 1, IdFilm: 1, Title: "t1", Comment: "c1", Likes: 3, Dislikes: 1, ReplyCount: 2},
+		{Id: 11, IdUser: 2, IdFilm: 1, Title: "t2", Comment: "c2", Spoiler: true, EditedAt: "2023-12-01T10:00:00Z",
+			Likes: 1, Reaction: 1},
 	}
 
-	for _, item := range expect {
-		rows = rows.AddRow(item.IdUser, item.Rating, item.Comment)
-	}
+	query := "SELECT reviews.id, reviews.id_user, title, body, spoiler, edited_at, likes, dislikes, reply_count, " +
+		"COALESCE(comment_reactions.value, 0), " +
+		"(reviews.likes - reviews.dislikes)::TEXT, reviews.date::TEXT, reviews.id::TEXT FROM reviews " +
+		"LEFT JOIN comment_reactions ON comment_reactions.id_comment = reviews.id AND comment_reactions.id_user = $2 " +
+		"WHERE reviews.id_film = $1 AND NOT reviews.hidden " +
+		"ORDER BY (reviews.likes - reviews.dislikes) DESC, reviews.date DESC, reviews.id DESC " +
+		"LIMIT $3 OFFSET $4"
 
-	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT id_user, rating, comment FROM users_comment WHERE id_film = $1 OFFSET $2 LIMIT $3")).
-		WithArgs(1, 0, 5).
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, 2, 3, 0).
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	comments, err := repo.GetFilmComments(1, 0, 5)
+	comments, next, err := repo.GetFilmComments(1, 2, SortTop, pagination.Page{Limit: 2})
 	if err != nil {
 		t.Errorf("GetFilm error: %s", err)
 	}
@@ -51,13 +64,26 @@ func TestGetFilmComments(t *testing.T) {
 		t.Errorf("results not match, want %v, have %v", expect, comments)
 		return
 	}
+	if want := pagination.Encode([]string{"1", "2023-12-01 08:00:00", "11"}); next != want {
+		t.Errorf("results not match, want %v, have %v", want, next)
+		return
+	}
 
-	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT id_user, rating, comment FROM users_comment WHERE id_film = $1 OFFSET $2 LIMIT $3")).
-		WithArgs(1, 0, 5).
+	query = "SELECT reviews.id, reviews.id_user, title, body, spoiler, edited_at, likes, dislikes, reply_count, " +
+		"COALESCE(comment_reactions.value, 0), " +
+		"(reviews.likes - reviews.dislikes)::TEXT, reviews.date::TEXT, reviews.id::TEXT FROM reviews " +
+		"LEFT JOIN comment_reactions ON comment_reactions.id_comment = reviews.id AND comment_reactions.id_user = $2 " +
+		"WHERE reviews.id_film = $1 AND NOT reviews.hidden " +
+		"AND ((reviews.likes - reviews.dislikes), reviews.date, reviews.id) < ($3::INTEGER, $4::TIMESTAMP, $5::BIGINT) " +
+		"ORDER BY (reviews.likes - reviews.dislikes) DESC, reviews.date DESC, reviews.id DESC " +
+		"LIMIT $6 OFFSET $7"
+
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, 2, "1", "2023-12-01 08:00:00", "11", 3, 0).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	comments, err = repo.GetFilmComments(1, 0, 5)
+	comments, _, err = repo.GetFilmComments(1, 2, SortTop,
+		pagination.Page{After: []string{"1", "2023-12-01 08:00:00", "11"}, Limit: 2})
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -80,45 +106,73 @@ func TestAddComment(t *testing.T) {
 
 	testComment := models.CommentItem{
 		IdFilm:  1,
-		Rating:  1,
+		Title:   "t1",
 		Comment: "c1",
+		Spoiler: true,
 	}
 	idUser := uint64(1)
 
-	sqlQuery := "INSERT INTO users_comment(id_film, rating, comment, id_user) VALUES($1, $2, $3, $4)"
-
-	mock.ExpectExec(
-		regexp.QuoteMeta(sqlQuery)).
-		WithArgs(testComment.IdFilm, testComment.Rating, testComment.Comment, idUser).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	historyQuery := "INSERT INTO reviews_history(id_user, id_film, title, body, spoiler) " +
+		"SELECT id_user, id_film, title, body, spoiler FROM reviews WHERE id_user = $1 AND id_film = $2 FOR UPDATE"
+	sqlQuery := "INSERT INTO reviews(id_film, id_user, title, body, spoiler) VALUES($1, $2, $3, $4, $5) " +
+		"ON CONFLICT (id_user, id_film) DO UPDATE SET title = EXCLUDED.title, body = EXCLUDED.body, " +
+		"spoiler = EXCLUDED.spoiler, edited_at = CURRENT_TIMESTAMP RETURNING xmax <> 0"
+
+	testCases := map[string]struct {
+		history  int64
+		replaced []bool
+		err      error
+		found    bool
+	}{
+		"New comment":      {history: 0, replaced: []bool{false}},
+		"Replaced comment": {history: 1, replaced: []bool{true}, found: true},
+		"Concurrent add":   {history: 0, replaced: []bool{true, true, true}, err: errCommentRace},
+		"Db error":         {history: 0, err: fmt.Errorf("db_error")},
+	}
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	err = repo.AddComment(testComment.IdFilm, idUser, testComment.Rating, testComment.Comment)
-	if err != nil {
-		t.Errorf("unexpected error: %s", err)
-		return
-	}
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
-		return
-	}
-
-	mock.ExpectExec(
-		regexp.QuoteMeta(sqlQuery)).
-		WithArgs(testComment.IdFilm, testComment.Rating, testComment.Comment, idUser).
-		WillReturnError(fmt.Errorf("db_error"))
-
-	err = repo.AddComment(testComment.IdFilm, idUser, testComment.Rating, testComment.Comment)
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
-		return
-	}
-	if err == nil {
-		t.Errorf("expected error, got nil")
-		return
+	for name, curr := range testCases {
+		if curr.replaced == nil {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(historyQuery)).
+				WithArgs(idUser, testComment.IdFilm).
+				WillReturnResult(sqlmock.NewResult(0, curr.history))
+			mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+				WithArgs(testComment.IdFilm, idUser, testComment.Title, testComment.Comment, testComment.Spoiler).
+				WillReturnError(curr.err)
+			mock.ExpectRollback()
+		}
+		for _, replaced := range curr.replaced {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(historyQuery)).
+				WithArgs(idUser, testComment.IdFilm).
+				WillReturnResult(sqlmock.NewResult(0, curr.history))
+			mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).
+				WithArgs(testComment.IdFilm, idUser, testComment.Title, testComment.Comment, testComment.Spoiler).
+				WillReturnRows(sqlmock.NewRows([]string{"replaced"}).AddRow(replaced))
+			if curr.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+		}
+
+		found, err := repo.AddComment(testComment.IdFilm, idUser, testComment.Title, testComment.Comment, testComment.Spoiler)
+		if !errors.Is(err, curr.err) {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.err, err)
+			return
+		}
+		if found != curr.found {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.found, found)
+			return
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s: there were unfulfilled expectations: %s", name, err)
+			return
+		}
 	}
 }
 
@@ -135,7 +189,7 @@ func TestHasUsersComment(t *testing.T) {
 	rows := sqlmock.NewRows([]string{"Id"})
 	rows = rows.AddRow(idUser)
 
-	sqlQuery := "SELECT id_user FROM users_comment WHERE id_user = $1 AND id_film = $2"
+	sqlQuery := "SELECT id_user FROM reviews WHERE id_user = $1 AND id_film = $2"
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(sqlQuery)).
@@ -194,3 +248,517 @@ func TestHasUsersComment(t *testing.T) {
 		return
 	}
 }
+
+func TestGetUserComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"IdFilm", "Title", "Comment", "Spoiler"})
+
+	expect := []models.CommentItem{
+		{IdUser: 1, IdFilm: 2, Title: "t1", Comment: "c1", Spoiler: true},
+	}
+
+	for _, item := range expect {
+		rows = rows.AddRow(item.IdFilm, item.Title, item.Comment, item.Spoiler)
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id_film, title, body, spoiler FROM reviews WHERE id_user = $1")).
+		WithArgs(1).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	comments, err := repo.GetUserComments(1)
+	if err != nil {
+		t.Errorf("GetUserComments error: %s", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+
+	if !reflect.DeepEqual(comments, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comments)
+		return
+	}
+
+	mock.ExpectQuery(
+		regexp.QuoteMeta("SELECT id_film, title, body, spoiler FROM reviews WHERE id_user = $1")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.GetUserComments(1)
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+func TestUpdateComment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	history := "INSERT INTO reviews_history(id_user, id_film, title, body, spoiler) " +
+		"SELECT id_user, id_film, title, body, spoiler FROM reviews WHERE id_user = $1 AND id_film = $2 FOR UPDATE"
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(history)).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reviews SET title = $3, body = $4, spoiler = $5, edited_at = CURRENT_TIMESTAMP")).
+		WithArgs(1, 2, "t1", "c1", true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	found, err := repo.UpdateComment(2, 1, "t1", "c1", true)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found {
+		t.Errorf("waited to find comment")
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(history)).
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	found, err = repo.UpdateComment(3, 1, "t1", "c1", true)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("waited not to find comment")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestAddReply(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	update := "UPDATE reviews SET reply_count = reply_count + 1 WHERE id = $1 AND NOT hidden"
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(update)).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO comment_replies(parent_id, id_user, body) VALUES($1, $2, $3) RETURNING id")).
+		WithArgs(10, 1, "r1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	id, found, err := repo.AddReply(10, 1, "r1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found || id != 5 {
+		t.Errorf("results not match, want %v, have %v", 5, id)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(update)).
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, found, err = repo.AddReply(11, 1, "r1")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("waited not to find comment")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestSetReaction(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	lock := "SELECT id FROM reviews WHERE id = $1 AND NOT hidden FOR UPDATE"
+	previous := "SELECT value FROM comment_reactions WHERE id_comment = $1 AND id_user = $2"
+	counters := "UPDATE reviews SET likes = likes + $2, dislikes = dislikes + $3 WHERE id = $1"
+
+	// A dislike turns into a like.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta(previous)).WithArgs(10, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(-1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO comment_reactions(id_comment, id_user, value) VALUES($1, $2, $3)")).
+		WithArgs(10, 1, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(counters)).WithArgs(10, 1, -1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	found, err := repo.SetReaction(10, 1, 1)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found {
+		t.Errorf("waited to find comment")
+		return
+	}
+
+	// The like is taken back.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta(previous)).WithArgs(10, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM comment_reactions WHERE id_comment = $1 AND id_user = $2")).
+		WithArgs(10, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(counters)).WithArgs(10, -1, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err = repo.SetReaction(10, 1, 0)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(11).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	found, err = repo.SetReaction(11, 1, 1)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("waited not to find comment")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestReportComment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	query := "INSERT INTO comment_reports(id_comment, id_user, reason) " +
+		"SELECT id, $2, $3 FROM reviews WHERE id = $1 AND NOT hidden " +
+		"ON CONFLICT (id_comment, id_user) DO UPDATE " +
+		"SET reason = EXCLUDED.reason, date = CURRENT_TIMESTAMP, resolved = FALSE"
+
+	mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs(10, 1, "spam").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	found, err := repo.ReportComment(10, 1, "spam")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found {
+		t.Errorf("waited to find comment")
+		return
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(query)).
+		WithArgs(11, 1, "spam").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	found, err = repo.ReportComment(11, 1, "spam")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("waited not to find comment")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestModerate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	lock := "SELECT id_user, id_film FROM reviews WHERE id = $1 FOR UPDATE"
+	log := "INSERT INTO moderation_log(id_moderator, id_comment, id_user, id_film, action, reason) " +
+		"VALUES($1, $2, $3, $4, $5, $6)"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id_user", "id_film"}).AddRow(2, 3))
+	mock.ExpectExec(regexp.QuoteMeta(log)).WithArgs(1, 10, 2, 3, ActionHide, "spam").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE reviews SET hidden = TRUE WHERE id = $1")).WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE comment_reports SET resolved = TRUE WHERE id_comment = $1")).WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	found, err := repo.Moderate(10, 1, ActionHide, "spam")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !found {
+		t.Errorf("waited to find comment")
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id_user", "id_film"}).AddRow(2, 3))
+	mock.ExpectExec(regexp.QuoteMeta(log)).WithArgs(1, 10, 2, 3, ActionDelete, "abuse").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM reviews WHERE id = $1")).WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	_, err = repo.Moderate(10, 1, ActionDelete, "abuse")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(lock)).WithArgs(11).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	found, err = repo.Moderate(11, 1, ActionRestore, "ok")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("waited not to find comment")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCountFilmComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	query := "SELECT COUNT(*) FROM reviews WHERE id_film = $1 AND NOT hidden"
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(2).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	count, err := repo.CountFilmComments(1)
+	if err != nil {
+		t.Errorf("CountFilmComments error: %s", err)
+	}
+	if count != 3 {
+		t.Errorf("results not match, want %v, have %v", 3, count)
+		return
+	}
+
+	_, err = repo.CountFilmComments(2)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetReplies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	date := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	query := "SELECT comment_replies.id, comment_replies.id_user, comment_replies.body, comment_replies.date, " +
+		"comment_replies.date::TEXT, comment_replies.id::TEXT FROM comment_replies " +
+		"JOIN reviews ON reviews.id = comment_replies.parent_id " +
+		"WHERE comment_replies.parent_id = $1 AND NOT reviews.hidden " +
+		"ORDER BY comment_replies.date ASC, comment_replies.id ASC LIMIT $2 OFFSET $3"
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(1, uint64(2), uint64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_user", "body", "date", "key_date", "key_id"}).
+			AddRow(5, 2, "reply", date, "2023-11-01 00:00:00", "5").
+			AddRow(6, 3, "next", date, "2023-11-01 00:00:00", "6"))
+
+	afterQuery := "WHERE comment_replies.parent_id = $1 AND NOT reviews.hidden " +
+		"AND (comment_replies.date, comment_replies.id) > ($2::TIMESTAMP, $3::BIGINT) " +
+		"ORDER BY comment_replies.date ASC, comment_replies.id ASC LIMIT $4 OFFSET $5"
+	mock.ExpectQuery(regexp.QuoteMeta(afterQuery)).
+		WithArgs(2, "2023-11-01 00:00:00", "5", uint64(2), uint64(0)).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	replies, next, err := repo.GetReplies(1, pagination.Page{Limit: 1})
+	if err != nil {
+		t.Errorf("GetReplies error: %s", err)
+	}
+	expected := []models.ReplyItem{{Id: 5, ParentId: 1, IdUser: 2, Text: "reply", Date: date.Format(time.RFC3339)}}
+	if !reflect.DeepEqual(replies, expected) {
+		t.Errorf("results not match, want %v, have %v", expected, replies)
+		return
+	}
+	if next != pagination.Encode([]string{"2023-11-01 00:00:00", "5"}) {
+		t.Errorf("unexpected next cursor %q", next)
+		return
+	}
+
+	_, _, err = repo.GetReplies(2, pagination.Page{After: []string{"2023-11-01 00:00:00", "5"}, Limit: 1})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	_, _, err = repo.GetReplies(2, pagination.Page{After: []string{"yesterday", "5"}, Limit: 1})
+	if !errors.Is(err, pagination.ErrBadCursor) {
+		t.Errorf("results not match, want %v, have %v", pagination.ErrBadCursor, err)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetReportedComments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	date := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+	query := "FROM comment_reports JOIN reviews ON reviews.id = comment_reports.id_comment " +
+		"WHERE NOT comment_reports.resolved " +
+		"GROUP BY reviews.id, reviews.id_user, reviews.id_film, reviews.title, reviews.body, reviews.spoiler, reviews.hidden" +
+		") AS queue " +
+		"WHERE (queue.report_count, queue.last_reported, queue.id) < ($1::BIGINT, $2::TIMESTAMP, $3::BIGINT) " +
+		"ORDER BY queue.report_count DESC, queue.last_reported DESC, queue.id DESC LIMIT $4 OFFSET $5"
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("3", "2023-11-02 00:00:00", "9", uint64(21), uint64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "id_user", "id_film", "title", "body", "spoiler", "hidden",
+			"report_count", "last_reported", "reasons", "key_count", "key_date", "key_id"}).
+			AddRow(7, 2, 1, "t", "b", false, true, 2, date, []byte(`["spam"]`), "2", "2023-11-01 00:00:00", "7"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	queue, next, err := repo.GetReportedComments(pagination.Page{After: []string{"3", "2023-11-02 00:00:00", "9"}, Limit: 20})
+	if err != nil {
+		t.Errorf("GetReportedComments error: %s", err)
+		return
+	}
+	expected := []models.ReportedComment{{
+		Comment:      models.CommentItem{Id: 7, IdUser: 2, IdFilm: 1, Title: "t", Comment: "b"},
+		Hidden:       true,
+		ReportCount:  2,
+		LastReported: date.Format(time.RFC3339),
+		Reasons:      []string{"spam"},
+	}}
+	if !reflect.DeepEqual(queue, expected) {
+		t.Errorf("results not match, want %v, have %v", expected, queue)
+		return
+	}
+	if next != "" {
+		t.Errorf("unexpected next cursor %q", next)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
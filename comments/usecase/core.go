@@ -2,34 +2,59 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/repository/comment"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 //go:generate mockgen -source=core.go -destination=../mocks/core_mock.go -package=mocks
 
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrUnknownSort = errors.New("unknown sort")
+	ErrBadReaction = errors.New("bad reaction")
+	ErrBadAction   = errors.New("bad moderator action")
+)
+
 type ICore interface {
-	GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error)
-	AddComment(filmId uint64, userId uint64, rating uint16, text string) (bool, error)
+	GetFilmComments(ctx context.Context, filmId uint64, userId uint64, sort string, page pagination.Page) (*requests.CommentResponse, error)
+	AddReply(commentId uint64, userId uint64, text string) (uint64, error)
+	GetReplies(ctx context.Context, commentId uint64, page pagination.Page) (*requests.RepliesResponse, error)
+	SetReaction(commentId uint64, userId uint64, reaction int8) error
+	ReportComment(commentId uint64, userId uint64, reason string) error
+	GetModerationQueue(ctx context.Context, page pagination.Page) (*requests.ModerationQueueResponse, error)
+	Moderate(commentId uint64, moderatorId uint64, action string, reason string) error
+	AddComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error)
+	EditComment(filmId uint64, userId uint64, title string, text string, spoiler bool) error
 	GetUserId(ctx context.Context, sid string) (uint64, error)
+	VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error)
+	CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error)
 	DeleteComment(idUser uint64, idFilm uint64) error
+	ExportUserData(ctx context.Context, userId uint64) ([]models.CommentItem, error)
+	DeleteUserData(ctx context.Context, userId uint64) error
 }
 
 type Core struct {
 	lg       *slog.Logger
 	comments comment.ICommentRepo
 	client   auth.AuthorizationClient
+	keys     *token.RemoteKeySet
 }
 
-func GetClient(port string) (auth.AuthorizationClient, error) {
-	conn, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func GetClient(addr string, cfg configs.GrpcClientCfg, lg *slog.Logger) (auth.AuthorizationClient, error) {
+	conn, err := grpcclient.Dial(addr, cfg, lg)
 	if err != nil {
 		return nil, fmt.Errorf("grpc connect err: %w", err)
 	}
@@ -38,8 +63,31 @@ func GetClient(port string) (auth.AuthorizationClient, error) {
 	return client, nil
 }
 
+// keysRefresh is how often the signing keys of the authorization service
+// are refetched when no token with an unknown key forces it earlier.
+const keysRefresh = 10 * time.Minute
+
+func signingKeys(client auth.AuthorizationClient) token.FetchKeys {
+	return func(ctx context.Context) ([]token.Key, error) {
+		response, err := client.GetSigningKeys(ctx, &auth.SigningKeysRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]token.Key, 0, len(response.Keys))
+		for _, key := range response.Keys {
+			if key.Alg != token.Algorithm {
+				continue
+			}
+			keys = append(keys, token.Key{Kid: key.Kid, Public: key.PublicKey})
+		}
+
+		return keys, nil
+	}
+}
+
 func GetCore(cfg_sql *configs.CommentCfg, lg *slog.Logger, comments comment.ICommentRepo) *Core {
-	client, err := GetClient(cfg_sql.GrpcPort)
+	client, err := GetClient(cfg_sql.GrpcPort, cfg_sql.GrpcClient, lg)
 	if err != nil {
 		lg.Error("get client error", "err", err.Error())
 		return nil
@@ -48,56 +96,211 @@ func GetCore(cfg_sql *configs.CommentCfg, lg *slog.Logger, comments comment.ICom
 		lg:       lg.With("module", "core"),
 		comments: comments,
 		client:   client,
+		keys:     token.NewRemoteKeySet(signingKeys(client), keysRefresh),
 	}
 	return &core
 }
 
-func (core *Core) GetFilmComments(filmId uint64, first uint64, limit uint64) ([]models.CommentItem, error) {
-	comments, err := core.comments.GetFilmComments(filmId, first, limit)
+// GetFilmComments returns the comments of the film in the sort order. The
+// reaction of the user is set on each of them, userId is 0 for guests.
+func (core *Core) GetFilmComments(ctx context.Context, filmId uint64, userId uint64, sort string, page pagination.Page) (*requests.CommentResponse, error) {
+	if !comment.IsSort(sort) {
+		return nil, ErrUnknownSort
+	}
+
+	comments, next, err := core.comments.GetFilmComments(filmId, userId, sort, page)
 	if err != nil {
 		core.lg.Error("Get Film Comments error", "err", err.Error())
 		return nil, fmt.Errorf("GetFilmComments err: %w", err)
 	}
-	ids := make([]int32, len(comments))
+	total, err := core.comments.CountFilmComments(filmId)
+	if err != nil {
+		core.lg.Error("Count Film Comments error", "err", err.Error())
+		return nil, fmt.Errorf("GetFilmComments err: %w", err)
+	}
+	ids := make([]uint64, len(comments))
 	for i := 0; i < len(ids); i++ {
-		ids[i] = int32(comments[i].IdUser)
+		ids[i] = comments[i].IdUser
 	}
 
-	namesAndPhotos, err := core.client.GetIdsAndPaths(context.Background(), &auth.NamesAndPathsListRequest{Ids: ids})
+	users, err := core.getUsers(ctx, ids)
 	if err != nil {
-		core.lg.Error("get film comments grpc error", "err", err.Error())
-		return nil, fmt.Errorf("get film comments grpc err: %w", err)
+		return nil, fmt.Errorf("GetFilmComments err: %w", err)
 	}
-	for i := 0; i < len(namesAndPhotos.Names); i++ {
-		comments[i].Username = namesAndPhotos.Names[i]
-		comments[i].Photo = namesAndPhotos.Paths[i]
+	// Comments of deleted users keep an empty name and photo.
+	for i := range comments {
+		if user, found := users[comments[i].IdUser]; found {
+			comments[i].Username = user.Login
+			comments[i].Photo = user.Photo
+		}
 	}
-	return comments, nil
+	return &requests.CommentResponse{Comments: comments, Total: total, NextCursor: next}, nil
+}
+
+func (core *Core) getUsers(ctx context.Context, ids []uint64) (map[uint64]*auth.User, error) {
+	users, err := core.client.GetUsers(ctx, &auth.UsersRequest{Ids: ids})
+	if err != nil {
+		core.lg.Error("get users grpc error", "err", err.Error())
+		return nil, fmt.Errorf("get users grpc err: %w", err)
+	}
+
+	return users.Users, nil
 }
 
-func (core *Core) AddComment(filmId uint64, userId uint64, rating uint16, text string) (bool, error) {
-	found, err := core.comments.HasUsersComment(userId, filmId)
+// AddReply replies to the comment and returns the id of the reply. It
+// returns ErrNotFound if there is no such comment.
+func (core *Core) AddReply(commentId uint64, userId uint64, text string) (uint64, error) {
+	id, found, err := core.comments.AddReply(commentId, userId, text)
 	if err != nil {
-		core.lg.Error("find users comment error", "err", err.Error())
-		return false, fmt.Errorf("find users comment error: %w", err)
+		core.lg.Error("add reply error", "err", err.Error())
+		return 0, fmt.Errorf("add reply err: %w", err)
 	}
-	if found {
-		return found, nil
+	if !found {
+		return 0, ErrNotFound
 	}
 
-	err = core.comments.AddComment(filmId, userId, rating, text)
+	return id, nil
+}
+
+func (core *Core) GetReplies(ctx context.Context, commentId uint64, page pagination.Page) (*requests.RepliesResponse, error) {
+	replies, next, err := core.comments.GetReplies(commentId, page)
+	if err != nil {
+		core.lg.Error("get replies error", "err", err.Error())
+		return nil, fmt.Errorf("get replies err: %w", err)
+	}
+	ids := make([]uint64, len(replies))
+	for i := range replies {
+		ids[i] = replies[i].IdUser
+	}
+
+	users, err := core.getUsers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get replies err: %w", err)
+	}
+	for i := range replies {
+		if user, found := users[replies[i].IdUser]; found {
+			replies[i].Username = user.Login
+			replies[i].Photo = user.Photo
+		}
+	}
+
+	return &requests.RepliesResponse{Replies: replies, NextCursor: next}, nil
+}
+
+// SetReaction likes the comment for 1, dislikes it for -1 and takes the
+// reaction back for 0. It returns ErrNotFound if there is no such comment.
+func (core *Core) SetReaction(commentId uint64, userId uint64, reaction int8) error {
+	if reaction < -1 || reaction > 1 {
+		return ErrBadReaction
+	}
+
+	found, err := core.comments.SetReaction(commentId, userId, reaction)
+	if err != nil {
+		core.lg.Error("set reaction error", "err", err.Error())
+		return fmt.Errorf("set reaction err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// AddComment adds a review of the film. If the user has already reviewed
+// it, the review is replaced and true is returned.
+func (core *Core) AddComment(filmId uint64, userId uint64, title string, text string, spoiler bool) (bool, error) {
+	replaced, err := core.comments.AddComment(filmId, userId, title, text, spoiler)
 	if err != nil {
 		core.lg.Error("add Comment error", "err", err.Error())
 		return false, fmt.Errorf("add comment err: %w", err)
 	}
 
-	return false, nil
+	return replaced, nil
+}
+
+// EditComment replaces the review of the user to the film. It returns
+// ErrNotFound if there is no such review.
+func (core *Core) EditComment(filmId uint64, userId uint64, title string, text string, spoiler bool) error {
+	found, err := core.comments.UpdateComment(filmId, userId, title, text, spoiler)
+	if err != nil {
+		core.lg.Error("edit comment error", "err", err.Error())
+		return fmt.Errorf("edit comment err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ReportComment reports the comment to the moderators. It returns
+// ErrNotFound if there is no such comment.
+func (core *Core) ReportComment(commentId uint64, userId uint64, reason string) error {
+	found, err := core.comments.ReportComment(commentId, userId, reason)
+	if err != nil {
+		core.lg.Error("report comment error", "err", err.Error())
+		return fmt.Errorf("report comment err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (core *Core) GetModerationQueue(ctx context.Context, page pagination.Page) (*requests.ModerationQueueResponse, error) {
+	queue, next, err := core.comments.GetReportedComments(page)
+	if err != nil {
+		core.lg.Error("get moderation queue error", "err", err.Error())
+		return nil, fmt.Errorf("get moderation queue err: %w", err)
+	}
+	ids := make([]uint64, len(queue))
+	for i := range queue {
+		ids[i] = queue[i].Comment.IdUser
+	}
+
+	users, err := core.getUsers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get moderation queue err: %w", err)
+	}
+	for i := range queue {
+		if user, found := users[queue[i].Comment.IdUser]; found {
+			queue[i].Comment.Username = user.Login
+			queue[i].Comment.Photo = user.Photo
+		}
+	}
+
+	return &requests.ModerationQueueResponse{Comments: queue, NextCursor: next}, nil
+}
+
+// Moderate hides, restores or deletes the comment on behalf of the
+// moderator. It returns ErrNotFound if there is no such comment.
+func (core *Core) Moderate(commentId uint64, moderatorId uint64, action string, reason string) error {
+	switch action {
+	case comment.ActionHide, comment.ActionRestore, comment.ActionDelete:
+	default:
+		return ErrBadAction
+	}
+
+	found, err := core.comments.Moderate(commentId, moderatorId, action, reason)
+	if err != nil {
+		core.lg.Error("moderate error", "err", err.Error())
+		return fmt.Errorf("moderate err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
 }
 
 func (core *Core) GetUserId(ctx context.Context, sid string) (uint64, error) {
 	request := auth.FindIdRequest{Sid: sid}
 
 	response, err := core.client.GetId(ctx, &request)
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unauthenticated {
+		return 0, ErrNotFound
+	}
 	if err != nil {
 		core.lg.Error("get user id error", "err", err.Error())
 		return 0, fmt.Errorf("get user id err: %w", err)
@@ -114,3 +317,44 @@ func (core *Core) DeleteComment(idUser uint64, idFilm uint64) error {
 
 	return nil
 }
+
+// VerifyAccessToken checks an access token locally, without a call to the
+// authorization service, and returns the id of the user it was issued to.
+func (core *Core) VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error) {
+	claims, err := core.keys.Verify(ctx, accessToken)
+	if err != nil {
+		return 0, fmt.Errorf("verify access token err: %w", err)
+	}
+
+	return claims.UserId, nil
+}
+
+func (core *Core) CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error) {
+	response, err := core.client.CheckPermission(ctx, &auth.PermissionRequest{UserId: int64(userId), Permission: permission})
+	if err != nil {
+		core.lg.Error("check permission error", "err", err.Error())
+		return false, fmt.Errorf("check permission err: %w", err)
+	}
+
+	return response.Allowed, nil
+}
+
+func (core *Core) ExportUserData(ctx context.Context, userId uint64) ([]models.CommentItem, error) {
+	comments, err := core.comments.GetUserComments(userId)
+	if err != nil {
+		core.lg.Error("export user data error", "err", err.Error())
+		return nil, fmt.Errorf("export user data err: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (core *Core) DeleteUserData(ctx context.Context, userId uint64) error {
+	err := core.comments.DeleteUserComments(userId)
+	if err != nil {
+		core.lg.Error("delete user data error", "err", err.Error())
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+
+	return nil
+}
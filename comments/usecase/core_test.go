@@ -2,12 +2,20 @@ package usecase
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"reflect"
 	"testing"
 
+	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/comments/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 )
 
 func TestAddComment(t *testing.T) {
@@ -15,18 +23,15 @@ func TestAddComment(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockObj := mocks.NewMockICommentRepo(mockCtrl)
-	mockObj.EXPECT().HasUsersComment(uint64(1), uint64(1)).Return(false, nil)
-	mockObj.EXPECT().HasUsersComment(uint64(0), uint64(1)).Return(false, fmt.Errorf("repo_error"))
-	mockObj.EXPECT().HasUsersComment(uint64(2), uint64(1)).Return(true, nil)
-	mockObj.EXPECT().HasUsersComment(uint64(2), uint64(2)).Return(false, nil)
-	mockObj.EXPECT().AddComment(uint64(1), uint64(1), uint16(1), string("t")).Return(nil)
-	mockObj.EXPECT().AddComment(uint64(2), uint64(2), uint16(1), string("t")).Return(fmt.Errorf("repo_error"))
+	mockObj.EXPECT().AddComment(uint64(1), uint64(1), "h", "t", false).Return(false, nil)
+	mockObj.EXPECT().AddComment(uint64(1), uint64(2), "h", "t", false).Return(true, nil)
+	mockObj.EXPECT().AddComment(uint64(2), uint64(2), "h", "t", false).Return(false, fmt.Errorf("repo_error"))
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{comments: mockObj, lg: logger}
 
-	found, err := core.AddComment(1, 1, 1, "t")
+	found, err := core.AddComment(1, 1, "h", "t", false)
 	if err != nil {
 		t.Errorf("waited no errors")
 		return
@@ -36,17 +41,7 @@ func TestAddComment(t *testing.T) {
 		return
 	}
 
-	found, err = core.AddComment(1, 0, 1, "t")
-	if err == nil {
-		t.Errorf("waited error")
-		return
-	}
-	if found {
-		t.Errorf("waited not found")
-		return
-	}
-
-	found, err = core.AddComment(1, 2, 1, "t")
+	found, err = core.AddComment(1, 2, "h", "t", false)
 	if err != nil {
 		t.Errorf("waited no errors")
 		return
@@ -56,7 +51,7 @@ func TestAddComment(t *testing.T) {
 		return
 	}
 
-	found, err = core.AddComment(2, 2, 1, "t")
+	found, err = core.AddComment(2, 2, "h", "t", false)
 	if err == nil {
 		t.Errorf("waited find error")
 		return
@@ -66,3 +61,59 @@ func TestAddComment(t *testing.T) {
 		return
 	}
 }
+
+type usersClient struct {
+	auth.AuthorizationClient
+	users map[uint64]*auth.User
+}
+
+func (c *usersClient) GetUsers(ctx context.Context, in *auth.UsersRequest, opts ...grpc.CallOption) (*auth.UsersResponse, error) {
+	return &auth.UsersResponse{Users: c.users}, nil
+}
+
+func TestGetFilmComments(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockObj := mocks.NewMockICommentRepo(mockCtrl)
+	page := pagination.Page{Limit: 10}
+	mockObj.EXPECT().GetFilmComments(uint64(1), uint64(2), "new", page).Return([]models.CommentItem{
+		{IdUser: 2, Title: "h1", Comment: "c1"},
+		{IdUser: 3, Title: "h2", Comment: "c2", Spoiler: true},
+		{IdUser: 2, Title: "h3", Comment: "c3"},
+	}, "next", nil)
+	mockObj.EXPECT().CountFilmComments(uint64(1)).Return(uint64(30), nil)
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	client := &usersClient{users: map[uint64]*auth.User{
+		2: {Id: 2, Login: "l2", Photo: "p2"},
+	}}
+	core := Core{comments: mockObj, lg: logger, client: client}
+
+	_, err := core.GetFilmComments(context.Background(), 1, 2, "old", page)
+	if !errors.Is(err, ErrUnknownSort) {
+		t.Errorf("wanted unknown sort error, had %v", err)
+		return
+	}
+
+	comments, err := core.GetFilmComments(context.Background(), 1, 2, "new", page)
+	if err != nil {
+		t.Errorf("waited no errors")
+		return
+	}
+
+	expect := &requests.CommentResponse{
+		Comments: []models.CommentItem{
+			{IdUser: 2, Username: "l2", Photo: "p2", Title: "h1", Comment: "c1"},
+			{IdUser: 3, Title: "h2", Comment: "c2", Spoiler: true},
+			{IdUser: 2, Username: "l2", Photo: "p2", Title: "h3", Comment: "c3"},
+		},
+		Total:      30,
+		NextCursor: "next",
+	}
+	if !reflect.DeepEqual(comments, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, comments)
+		return
+	}
+}
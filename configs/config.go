@@ -3,6 +3,7 @@ package configs
 import (
 	"flag"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -23,6 +24,17 @@ type DbDsnCfg struct {
 	CalendarDb   string `yaml:"calendar_db"`
 	ServerAdress string `yaml:"server_adress"`
 	GrpcPort     string `yaml:"grpc_port"`
+	// GrpcClient configures calls to the other services.
+	GrpcClient GrpcClientCfg `yaml:"grpc_client"`
+	// GrpcServerPort is where the films service serves its own gRPC API.
+	GrpcServerPort string `yaml:"grpc_server_port"`
+	// Addresses of the films and comments gRPC APIs, used by the
+	// authorization service.
+	FilmsGrpcAddr    string `yaml:"films_grpc_addr"`
+	CommentsGrpcAddr string `yaml:"comments_grpc_addr"`
+	// SuggestRefresh is how often the search suggestions are rebuilt, the
+	// cached suggestions expire after it too.
+	SuggestRefresh time.Duration `yaml:"suggest_refresh"`
 }
 
 type CommentCfg struct {
@@ -37,6 +49,10 @@ type CommentCfg struct {
 	CommentsDb   string `yaml:"comment_db"`
 	ServerAdress string `yaml:"server_adress"`
 	GrpcPort     string `yaml:"grpc_port"`
+	// GrpcClient configures calls to the other services.
+	GrpcClient GrpcClientCfg `yaml:"grpc_client"`
+	// GrpcServerPort is where the comments service serves its own gRPC API.
+	GrpcServerPort string `yaml:"grpc_server_port"`
 }
 
 type DbRedisCfg struct {
@@ -44,6 +60,111 @@ type DbRedisCfg struct {
 	Password string `yaml:"password"`
 	DbNumber int    `yaml:"db"`
 	Timer    int    `yaml:"timer"`
+
+	// Session lifetimes, used by the session storage only. A session dies
+	// after AbsoluteTimeout (RememberTimeout for "remember me" sessions) or
+	// after IdleTimeout without requests, whichever comes first.
+	AbsoluteTimeout time.Duration `yaml:"absolute_timeout"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout"`
+	RememberTimeout time.Duration `yaml:"remember_timeout"`
+}
+
+// LimiterCfg configures sign in brute-force protection. After
+// LoginAttempts failures for a login (IpAttempts for a client IP) within
+// Window the login (IP) is locked out for BaseLockout, and every further
+// failure doubles the lockout up to MaxLockout. The client IP is taken from
+// X-Real-IP only for requests coming from TrustedProxies (IPs or CIDRs).
+// Letters are limited to MailPerAddress per address and MailPerIp per
+// client IP within MailWindow.
+type LimiterCfg struct {
+	DbRedisCfg     `yaml:",inline"`
+	LoginAttempts  int64         `yaml:"login_attempts"`
+	IpAttempts     int64         `yaml:"ip_attempts"`
+	Window         time.Duration `yaml:"window"`
+	BaseLockout    time.Duration `yaml:"base_lockout"`
+	MaxLockout     time.Duration `yaml:"max_lockout"`
+	TrustedProxies []string      `yaml:"trusted_proxies"`
+	MailPerAddress int64         `yaml:"mail_per_address"`
+	MailPerIp      int64         `yaml:"mail_per_ip"`
+	MailWindow     time.Duration `yaml:"mail_window"`
+}
+
+// AccountCfg configures email verification and password reset. Tokens are
+// signed with TokenSecret and links in the letters point to LinkBase.
+type AccountCfg struct {
+	TokenSecret string        `yaml:"token_secret"`
+	VerifyTTL   time.Duration `yaml:"verify_ttl"`
+	ResetTTL    time.Duration `yaml:"reset_ttl"`
+	LinkBase    string        `yaml:"link_base"`
+	Mail        MailCfg       `yaml:"mail"`
+}
+
+// MailCfg selects the mailer: "smtp" sends letters through the SMTP server,
+// "log" appends them to the file at Path instead.
+type MailCfg struct {
+	Driver   string `yaml:"driver"`
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+	Path     string `yaml:"path"`
+	// QueueSize is the number of letters waiting to be sent in the
+	// background before Send starts to fail.
+	QueueSize int `yaml:"queue_size"`
+}
+
+// TokenCfg configures access tokens issued next to session cookies. The
+// first of Keys signs new tokens, the rest are kept for verification after
+// a rotation. A seed is 32 bytes in base64. Access tokens outlive logout
+// until they expire, so AccessTTL is capped at 15 minutes.
+type TokenCfg struct {
+	Enabled    bool          `yaml:"enabled"`
+	AccessTTL  time.Duration `yaml:"access_ttl"`
+	RefreshTTL time.Duration `yaml:"refresh_ttl"`
+	Keys       []struct {
+		Kid  string `yaml:"kid"`
+		Seed string `yaml:"seed"`
+	} `yaml:"keys"`
+}
+
+// OAuthCfg configures social login. Providers are keyed by the name used in
+// /api/v1/oauth/{provider} URLs; users are sent back to Redirect when done.
+type OAuthCfg struct {
+	Redirect  string                      `yaml:"redirect"`
+	Providers map[string]OAuthProviderCfg `yaml:"providers"`
+}
+
+// OAuthProviderCfg describes a provider of the "vk", "yandex" or "oidc"
+// type. Issuer is used by OIDC providers only.
+type OAuthProviderCfg struct {
+	Type         string   `yaml:"type"`
+	ClientId     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	RedirectUrl  string   `yaml:"redirect_url"`
+	Issuer       string   `yaml:"issuer"`
+	Scopes       []string `yaml:"scopes"`
+}
+
+// GrpcClientCfg configures calls to other services. Every call gets Timeout
+// for all of its attempts; calls failed with UNAVAILABLE are retried up to
+// MaxAttempts times. After BreakerFailures failures in a row calls fail
+// fast for BreakerTimeout. TLS is used when CaFile is set, and becomes
+// mutual when CertFile and KeyFile are set too. Secret is shared by the
+// services: calls carry it, and servers keep their internal methods, such
+// as the deletion of user data, to the calls that do.
+type GrpcClientCfg struct {
+	Timeout         time.Duration `yaml:"timeout"`
+	MaxAttempts     int           `yaml:"max_attempts"`
+	InitialBackoff  time.Duration `yaml:"initial_backoff"`
+	MaxBackoff      time.Duration `yaml:"max_backoff"`
+	BreakerFailures int           `yaml:"breaker_failures"`
+	BreakerTimeout  time.Duration `yaml:"breaker_timeout"`
+	CaFile          string        `yaml:"ca_file"`
+	CertFile        string        `yaml:"cert_file"`
+	KeyFile         string        `yaml:"key_file"`
+	ServerName      string        `yaml:"server_name"`
+	Secret          string        `yaml:"secret"`
 }
 
 type GrpcConfig struct {
@@ -104,6 +225,66 @@ func ReadSessionRedisConfig() (*DbRedisCfg, error) {
 	return &sessionConfig, nil
 }
 
+func ReadLimiterConfig() (*LimiterCfg, error) {
+	limiterConfig := LimiterCfg{}
+	limiterFile, err := os.ReadFile("../../configs/db_limiter.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(limiterFile, &limiterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &limiterConfig, nil
+}
+
+func ReadAccountConfig() (*AccountCfg, error) {
+	accountConfig := AccountCfg{}
+	accountFile, err := os.ReadFile("../../configs/account.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(accountFile, &accountConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountConfig, nil
+}
+
+func ReadTokenConfig() (*TokenCfg, error) {
+	tokenConfig := TokenCfg{}
+	tokenFile, err := os.ReadFile("../../configs/token.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(tokenFile, &tokenConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tokenConfig, nil
+}
+
+func ReadOAuthConfig() (*OAuthCfg, error) {
+	oauthConfig := OAuthCfg{}
+	oauthFile, err := os.ReadFile("../../configs/oauth.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(oauthFile, &oauthConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauthConfig, nil
+}
+
 func ReadConfig() (*DbDsnCfg, error) {
 	dsnConfig := DbDsnCfg{}
 	dsnFile, err := os.ReadFile("../../configs/db_dsn.yaml")
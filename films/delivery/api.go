@@ -11,9 +11,13 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/ratingstats"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/rbac"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/mailru/easyjson"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -48,9 +52,13 @@ func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.DbDsnCfg) *API {
 	api.mx.Handle("/api/v1/favorite/actor/remove", middleware.AuthCheck(http.HandlerFunc(api.FavoriteActorsRemove), c, l))
 	api.mx.HandleFunc("/api/v1/find", api.FindFilm)
 	api.mx.HandleFunc("/api/v1/search/actor", api.FindActor)
+	api.mx.HandleFunc("/api/v1/suggest", api.Suggest)
 	api.mx.HandleFunc("/api/v1/calendar", api.Calendar)
+	api.mx.Handle("/api/v1/rating", middleware.AuthCheck(http.HandlerFunc(api.Rating), c, l))
 	api.mx.Handle("/api/v1/rating/add", middleware.AuthCheck(http.HandlerFunc(api.AddRating), c, l))
-	api.mx.HandleFunc("/api/v1/add/film", api.AddFilm)
+	api.mx.Handle("/api/v1/rating/update", middleware.AuthCheck(http.HandlerFunc(api.UpdateRating), c, l))
+	api.mx.Handle("/api/v1/add/film", middleware.AuthCheck(
+		middleware.RequirePermission(http.HandlerFunc(api.AddFilm), c, rbac.AddFilm, l), c, l))
 	api.mx.Handle("/api/v1/rating/delete", middleware.AuthCheck(http.HandlerFunc(api.DeleteRating), c, l))
 	api.mx.Handle("/api/v1/statistics", middleware.AuthCheck(http.HandlerFunc(api.UsersStatistics), c, l))
 	api.mx.HandleFunc("/api/v1/trends", api.Trends)
@@ -60,12 +68,24 @@ func GetApi(c *usecase.Core, l *slog.Logger, cfg *configs.DbDsnCfg) *API {
 }
 
 func (a *API) ListenAndServe() {
-	err := http.ListenAndServe(a.adress, a.mx)
+	err := http.ListenAndServe(a.adress, middleware.RequestID(a.mx))
 	if err != nil {
 		a.lg.Error("listen and serve error", "err", err.Error())
 	}
 }
 
+// listSort reads the sort of a film list and its direction from the
+// query. It tells if they are valid, the empty sort is defaultSort.
+func listSort(r *http.Request, defaultSort string) (string, string, bool) {
+	sort := r.URL.Query().Get("sort")
+	if sort == "" {
+		sort = defaultSort
+	}
+	order := r.URL.Query().Get("order")
+
+	return sort, order, film.IsSort(sort) && film.IsOrder(order)
+}
+
 func (a *API) Films(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -76,13 +96,11 @@ func (a *API) Films(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	page, err := pagination.FromQuery(r.URL.Query(), "page_size", 8)
 	if err != nil {
-		page = 1
-	}
-	pageSize, err := strconv.ParseUint(r.URL.Query().Get("page_size"), 10, 64)
-	if err != nil {
-		pageSize = 8
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
 	genreId, err := strconv.ParseUint(r.URL.Query().Get("collection_id"), 10, 64)
@@ -90,23 +108,30 @@ func (a *API) Films(w http.ResponseWriter, r *http.Request) {
 		genreId = 0
 	}
 
-	var films []models.FilmItem
+	sort, order, ok := listSort(r, film.SortReleaseDate)
+	if !ok {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
 
-	films, genre, err := a.core.GetFilmsAndGenreTitle(genreId, uint64((page-1)*pageSize), pageSize)
+	filmsResponse, err := a.core.GetFilmsAndGenreTitle(genreId, sort, order, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("get films error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	filmsResponse := requests.FilmsResponse{
-		Page:           page,
-		PageSize:       pageSize,
-		Total:          uint64(len(films)),
-		CollectionName: genre,
-		Films:          films,
+	if page.After == nil {
+		filmsResponse.Page = page.Offset/page.Limit + 1
 	}
+	filmsResponse.PageSize = page.Limit
 	response.Body = filmsResponse
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
@@ -145,10 +170,10 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 
-	userId, errAuth := r.Context().Value(middleware.UserIDKey).(uint64)
-
-	if errAuth {
-		a.lg.Error("User StatusUnauthorized", "err", errAuth)
+	// Only the users keep the films they have seen, the views of the
+	// guests are not counted.
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
 		return
 	}
 
@@ -164,7 +189,7 @@ func (a *API) Film(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !addedNearFilm {
-		a.lg.Error("Failed to add near film", "error", err.Error())
+		a.lg.Error("Failed to add near film")
 		return
 	}
 }
@@ -229,14 +254,28 @@ func (a *API) FindFilm(w http.ResponseWriter, r *http.Request) {
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
+	if request.Sort == "" {
+		request.Sort = film.SortTitle
+	}
+	if !film.IsSearchSort(request.Sort) || !film.IsOrder(request.Order) {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+	page := pagination.FromNumber(request.Page, request.PerPage, 8)
 	films, err := a.core.FindFilm(request.Title, request.DateFrom, request.DateTo, request.RatingFrom, request.RatingTo,
-		request.Mpaa, request.Genres, request.Actors, (request.Page-1)*request.PerPage, request.PerPage)
+		request.Mpaa, request.Genres, request.Actors, request.Sort, request.Order, page.Offset, page.Limit)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
 			a.ct.SendResponse(w, r, response, a.lg, start)
 			return
 		}
+		if errors.Is(err, usecase.ErrUnknownSort) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 
 		a.lg.Error("find film error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
@@ -244,11 +283,7 @@ func (a *API) FindFilm(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	filmsResponse := requests.FilmsResponse{
-		Films: films,
-		Total: uint64(len((films))),
-	}
-	response.Body = filmsResponse
+	response.Body = films
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
@@ -328,18 +363,27 @@ func (a *API) FavoriteFilms(w http.ResponseWriter, r *http.Request) {
 
 	userId := r.Context().Value(middleware.UserIDKey).(uint64)
 
-	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 8)
 	if err != nil {
-		page = 1
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
-	pageSize, err := strconv.ParseUint(r.URL.Query().Get("per_page"), 10, 64)
-	if err != nil {
-		pageSize = 8
+	sort, order, ok := listSort(r, film.SortRecentlyAdded)
+	if !ok {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
-	films, err := a.core.FavoriteFilms(userId, uint64((page-1)*pageSize), pageSize)
+	films, err := a.core.FavoriteFilms(userId, sort, order, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("favorite films error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -397,7 +441,8 @@ func (a *API) FindActor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	actors, err := a.core.FindActor(request.Name, request.BirthDate, request.Films, request.Career, request.Country, (request.Page-1)*request.PerPage, request.PerPage)
+	page := pagination.FromNumber(request.Page, request.PerPage, 8)
+	actors, err := a.core.FindActor(request.Name, request.BirthDate, request.Films, request.Career, request.Country, page.Offset, page.Limit)
 	if err != nil {
 		if errors.Is(err, usecase.ErrNotFound) {
 			response.Status = http.StatusNotFound
@@ -411,15 +456,58 @@ func (a *API) FindActor(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	actorsResponse := requests.ActorsResponse{
-		Actors: actors,
-		Total:  uint64(len(actors)),
+	response.Body = actors
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// Rating returns the rating the user has given to the film.
+func (a *API) Rating(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	filmId, err := strconv.ParseUint(r.URL.Query().Get("film_id"), 10, 64)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
-	response.Body = actorsResponse
+
+	rating, err := a.core.GetUserRating(userId, filmId)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("get rating error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = rating
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// validRating accepts ratings from 1 to 10.
+func validRating(rating uint16) bool {
+	return rating >= 1 && rating <= ratingstats.MaxRating
+}
+
 func (a *API) AddRating(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -431,7 +519,7 @@ func (a *API) AddRating(w http.ResponseWriter, r *http.Request) {
 
 	userId := r.Context().Value(middleware.UserIDKey).(uint64)
 
-	var commentRequest requests.CommentRequest
+	var ratingRequest requests.RatingRequest
 
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -440,23 +528,64 @@ func (a *API) AddRating(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = easyjson.Unmarshal(body, &commentRequest); err != nil {
+	if err = easyjson.Unmarshal(body, &ratingRequest); err != nil || !validRating(ratingRequest.Rating) {
 		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
-	found, err := a.core.AddRating(commentRequest.FilmId, userId, commentRequest.Rating)
+	_, err = a.core.AddRating(ratingRequest.FilmId, userId, ratingRequest.Rating)
 	if err != nil {
 		a.lg.Error("add rating error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 	}
-	if found {
-		response.Status = http.StatusNotAcceptable
+
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
+// UpdateRating replaces the rating the user has given to the film.
+func (a *API) UpdateRating(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+	if r.Method != http.MethodPut {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var ratingRequest requests.RatingRequest
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	if err = easyjson.Unmarshal(body, &ratingRequest); err != nil || !validRating(ratingRequest.Rating) {
+		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
 
+	err = a.core.UpdateRating(ratingRequest.FilmId, userId, ratingRequest.Rating)
+	if err != nil {
+		if errors.Is(err, usecase.ErrNotFound) {
+			response.Status = http.StatusNotFound
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
+		a.lg.Error("update rating error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+	}
+
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
@@ -659,32 +788,31 @@ func (a *API) FavoriteActors(w http.ResponseWriter, r *http.Request) {
 
 	userId := r.Context().Value(middleware.UserIDKey).(uint64)
 
-	page, err := strconv.ParseUint(r.URL.Query().Get("page"), 10, 64)
+	page, err := pagination.FromQuery(r.URL.Query(), "per_page", 8)
 	if err != nil {
-		page = 1
-	}
-	pageSize, err := strconv.ParseUint(r.URL.Query().Get("per_page"), 10, 64)
-	if err != nil {
-		pageSize = 8
+		response.Status = http.StatusBadRequest
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
 	}
 
-	actors, err := a.core.FavoriteActors(userId, uint64((page-1)*pageSize), pageSize)
+	actors, err := a.core.FavoriteActors(userId, page)
 	if err != nil {
+		if errors.Is(err, pagination.ErrBadCursor) {
+			response.Status = http.StatusBadRequest
+			a.ct.SendResponse(w, r, response, a.lg, start)
+			return
+		}
 		a.lg.Error("favorite actors error", "err", err.Error())
 		response.Status = http.StatusInternalServerError
 		a.ct.SendResponse(w, r, response, a.lg, start)
 		return
 	}
-
-	actorsResponse := requests.ActorsResponse{
-		Actors: actors,
-	}
-
-	response.Body = actorsResponse
+	response.Body = actors
 
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// DeleteRating deletes the rating of the user to the film.
 func (a *API) DeleteRating(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -695,7 +823,14 @@ func (a *API) DeleteRating(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var request requests.DeleteCommentRequest
+	userId, ok := r.Context().Value(middleware.UserIDKey).(uint64)
+	if !ok {
+		response.Status = http.StatusUnauthorized
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	var request requests.DeleteRatingRequest
 
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -710,7 +845,7 @@ func (a *API) DeleteRating(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = a.core.DeleteRating(request.IdUser, request.IdFilm)
+	err = a.core.DeleteRating(userId, request.IdFilm)
 	if err != nil {
 		response.Status = http.StatusBadRequest
 		a.ct.SendResponse(w, r, response, a.lg, start)
@@ -743,6 +878,29 @@ func (a *API) UsersStatistics(w http.ResponseWriter, r *http.Request) {
 	a.ct.SendResponse(w, r, response, a.lg, start)
 }
 
+// Suggest returns the films and people for the search box by the prefix.
+func (a *API) Suggest(w http.ResponseWriter, r *http.Request) {
+	response := requests.Response{Status: http.StatusOK, Body: nil}
+	start := time.Now()
+
+	if r.Method != http.MethodGet {
+		response.Status = http.StatusMethodNotAllowed
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	items, err := a.core.Suggest(r.Context(), r.URL.Query().Get("q"))
+	if err != nil {
+		a.lg.Error("suggest error", "err", err.Error())
+		response.Status = http.StatusInternalServerError
+		a.ct.SendResponse(w, r, response, a.lg, start)
+		return
+	}
+
+	response.Body = requests.SuggestResponse{Items: items}
+	a.ct.SendResponse(w, r, response, a.lg, start)
+}
+
 func (a *API) Trends(w http.ResponseWriter, r *http.Request) {
 	response := requests.Response{Status: http.StatusOK, Body: nil}
 	start := time.Now()
@@ -15,6 +15,7 @@ import (
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/golang/mock/gomock"
 	"github.com/mailru/easyjson"
@@ -56,7 +57,7 @@ func createActorBody(req requests.FindActorRequest) io.Reader {
 	return body
 }
 
-func createRatingBody(req requests.CommentRequest) io.Reader {
+func createRatingBody(req requests.RatingRequest) io.Reader {
 	jsonReq, _ := easyjson.Marshal(req)
 
 	body := bytes.NewBuffer(jsonReq)
@@ -69,11 +70,19 @@ func TestFilms(t *testing.T) {
 	expectedGenre := "g1"
 	filmItem := models.FilmItem{Title: "t1"}
 	expectedFilms := []models.FilmItem{filmItem}
+	cursor := pagination.Encode([]string{"2023-01-01", "1"})
 	expectedResponse := requests.FilmsResponse{
 		Page:           1,
 		PageSize:       8,
 		CollectionName: expectedGenre,
-		Total:          uint64(len(expectedFilms)),
+		Total:          10,
+		NextCursor:     cursor,
+		Films:          expectedFilms,
+	}
+	expectedCursorResponse := requests.FilmsResponse{
+		PageSize:       2,
+		CollectionName: expectedGenre,
+		Total:          10,
 		Films:          expectedFilms,
 	}
 
@@ -95,6 +104,31 @@ func TestFilms(t *testing.T) {
 			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
 			params: map[string]string{"collection_id": "1"},
 		},
+		"Cursor": {
+			method: http.MethodGet,
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedCursorResponse}),
+			params: map[string]string{"collection_id": "2", "cursor": cursor, "page_size": "2", "sort": "rating", "order": "asc"},
+		},
+		"Bad cursor": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			params: map[string]string{"cursor": "bad"},
+		},
+		"Unknown sort": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			params: map[string]string{"sort": "year"},
+		},
+		"Unknown order": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			params: map[string]string{"sort": "title", "order": "up"},
+		},
+		"Stale cursor": {
+			method: http.MethodGet,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			params: map[string]string{"collection_id": "3", "cursor": pagination.Encode([]string{"1"})},
+		},
 	}
 
 	mockCtrl := gomock.NewController(t)
@@ -102,8 +136,20 @@ func TestFilms(t *testing.T) {
 
 	mockCore := mocks.NewMockICore(mockCtrl)
 
-	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(0), uint64(0), uint64(8)).Return(nil, "", fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(1), uint64(0), uint64(8)).Return(expectedFilms, expectedGenre, nil).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(0), "release_date", "", pagination.Page{Limit: 8}).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(1), "release_date", "", pagination.Page{Limit: 8}).Return(&requests.FilmsResponse{
+		CollectionName: expectedGenre,
+		Total:          10,
+		NextCursor:     cursor,
+		Films:          expectedFilms,
+	}, nil).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(2), "rating", "asc", pagination.Page{After: []string{"2023-01-01", "1"}, Limit: 2}).Return(&requests.FilmsResponse{
+		CollectionName: expectedGenre,
+		Total:          10,
+		Films:          expectedFilms,
+	}, nil).Times(1)
+	mockCore.EXPECT().GetFilmsAndGenreTitle(uint64(3), "release_date", "", pagination.Page{After: []string{"1"}, Limit: 8}).
+		Return(nil, fmt.Errorf("get films err: %w", pagination.ErrBadCursor)).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -190,6 +236,7 @@ func TestFilm(t *testing.T) {
 	mockCore.EXPECT().GetFilmInfo(uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
 	mockCore.EXPECT().GetFilmInfo(uint64(2)).Return(nil, usecase.ErrNotFound).Times(1)
 	mockCore.EXPECT().GetFilmInfo(uint64(3)).Return(expectedResponse, nil).Times(1)
+	mockCore.EXPECT().AddNearFilm(gomock.Any(), models.NearFilm{IdFilm: 3, IdUser: 1}, gomock.Any()).Return(true, nil).Times(1)
 
 	api := API{core: mockCore, lg: logger, ct: collector}
 
@@ -299,11 +346,18 @@ func TestActor(t *testing.T) {
 
 func TestFindFilm(t *testing.T) {
 	filmItem := models.FilmItem{Title: "t3"}
-	films := []models.FilmItem{filmItem}
-	expectedResponse := requests.FilmsResponse{
-		Films: films,
-		Total: uint64(len(films)),
+	films := &requests.FindFilmResponse{
+		Films: []models.FilmItem{filmItem},
+		Total: 7,
+		Facets: models.FilmFacets{
+			Genres:    []models.FacetBucket{{Id: 1, Value: "драма", Count: 7}},
+			Mpaa:      []models.FacetBucket{},
+			Countries: []models.FacetBucket{},
+			Decades:   []models.FacetBucket{{Value: "1990", Count: 7}},
+			Ratings:   []models.FacetBucket{},
+		},
 	}
+	expectedResponse := *films
 
 	testCases := map[string]struct {
 		method string
@@ -329,6 +383,21 @@ func TestFindFilm(t *testing.T) {
 			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
 			body:   createBody(requests.FindFilmRequest{Title: "t2", Genres: nil, Actors: nil}),
 		},
+		"Unknown sort": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createBody(requests.FindFilmRequest{Title: "t4", Sort: "year", Genres: nil, Actors: nil}),
+		},
+		"Unknown order": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createBody(requests.FindFilmRequest{Title: "t4", Sort: "rating", Order: "up", Genres: nil, Actors: nil}),
+		},
+		"Sorted": {
+			method: http.MethodPost,
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+			body:   createBody(requests.FindFilmRequest{Title: "t5", Sort: "popularity", Order: "asc", Genres: nil, Actors: nil, Page: 2, PerPage: 1000}),
+		},
 		"Ok": {
 			method: http.MethodPost,
 			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
@@ -340,9 +409,10 @@ func TestFindFilm(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FindFilm(string("t1"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().FindFilm(string("t2"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(nil, usecase.ErrNotFound).Times(1)
-	mockCore.EXPECT().FindFilm(string("t3"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, uint64(0), uint64(0)).Return(films, nil).Times(1)
+	mockCore.EXPECT().FindFilm(string("t1"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, string("title"), string(""), uint64(0), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FindFilm(string("t2"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, string("title"), string(""), uint64(0), uint64(8)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().FindFilm(string("t3"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, string("title"), string(""), uint64(0), uint64(8)).Return(films, nil).Times(1)
+	mockCore.EXPECT().FindFilm(string("t5"), string(""), string(""), float32(0), float32(0), string(""), nil, nil, string("popularity"), string("asc"), uint64(pagination.MaxLimit), uint64(pagination.MaxLimit)).Return(films, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -371,11 +441,15 @@ func TestFindFilm(t *testing.T) {
 
 func TestFindActor(t *testing.T) {
 	actorItem := models.Character{NameActor: "n1"}
-	actors := []models.Character{actorItem}
-	expectedResponse := requests.ActorsResponse{
-		Actors: actors,
-		Total:  1,
+	actors := &requests.FindActorResponse{
+		Actors: []models.Character{actorItem},
+		Total:  4,
+		Facets: models.ActorFacets{
+			Countries:   []models.FacetBucket{{Value: "США", Count: 4}},
+			Professions: []models.FacetBucket{},
+		},
 	}
+	expectedResponse := *actors
 
 	testCases := map[string]struct {
 		method string
@@ -412,7 +486,7 @@ func TestFindActor(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FindActor(string("n1"), string(""), nil, nil, string(""), uint64(0), uint64(0)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FindActor(string("n1"), string(""), nil, nil, string(""), uint64(0), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
 	mockCore.EXPECT().FindActor(string("n2"), string(""), nil, nil, string(""), uint64(1), uint64(1)).Return(nil, usecase.ErrNotFound).Times(1)
 	mockCore.EXPECT().FindActor(string("n3"), string(""), nil, nil, string(""), uint64(0), uint64(1)).Return(actors, nil).Times(1)
 	var buff bytes.Buffer
@@ -501,6 +575,68 @@ func TestCalendar(t *testing.T) {
 	}
 }
 
+func TestSuggest(t *testing.T) {
+	items := []models.SuggestItem{{Kind: "film", Id: 1, Title: "Star Wars", Thumbnail: "p1"}}
+	expectedResponse := requests.SuggestResponse{Items: items}
+
+	testCases := []struct {
+		testName string
+		method   string
+		query    string
+		result   *requests.Response
+	}{
+		{
+			testName: "Bad method",
+			method:   http.MethodPost,
+			query:    "star",
+			result:   &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		{
+			testName: "Core error",
+			method:   http.MethodGet,
+			query:    "sta",
+			result:   &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+		},
+		{
+			testName: "Ok",
+			method:   http.MethodGet,
+			query:    "star",
+			result:   getExpectedResult(&requests.Response{Status: http.StatusOK, Body: expectedResponse}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().Suggest(gomock.Any(), "sta").Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().Suggest(gomock.Any(), "star").Return(items, nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for _, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/suggest?q="+curr.query, nil)
+		w := httptest.NewRecorder()
+
+		api.Suggest(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("%s: unexpected status: %d, want %d", curr.testName, response.Status, curr.result.Status)
+			return
+		}
+		if !reflect.DeepEqual(response.Body, curr.result.Body) {
+			t.Errorf("%s: wanted %v, got %v", curr.testName, curr.result.Body, response.Body)
+			return
+		}
+	}
+}
+
 func TestFavoriteFilmsAdd(t *testing.T) {
 	testCases := map[string]struct {
 		method string
@@ -640,6 +776,7 @@ func TestFavoriteFilmsRemove(t *testing.T) {
 func TestFavoriteFilms(t *testing.T) {
 	filmItem := models.FilmItem{Title: "t"}
 	films := []models.FilmItem{filmItem}
+	response := requests.FilmsResponse{Total: 1, Films: films}
 
 	testCases := map[string]struct {
 		method string
@@ -652,13 +789,18 @@ func TestFavoriteFilms(t *testing.T) {
 		},
 		"Core error": {
 			method: http.MethodGet,
-			params: map[string]string{"page": "2", "page_size": "8"},
+			params: map[string]string{"page": "2", "per_page": "8"},
 			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
 		},
 		"Ok": {
 			method: http.MethodGet,
-			params: map[string]string{"page": "1", "page_size": "8"},
-			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: films}),
+			params: map[string]string{"page": "1", "per_page": "8"},
+			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: response}),
+		},
+		"Bad cursor": {
+			method: http.MethodGet,
+			params: map[string]string{"cursor": "bad"},
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
 		},
 	}
 
@@ -666,8 +808,8 @@ func TestFavoriteFilms(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FavoriteFilms(uint64(1), uint64(8), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().FavoriteFilms(uint64(1), uint64(0), uint64(8)).Return(films, nil).Times(1)
+	mockCore.EXPECT().FavoriteFilms(uint64(1), "recently_added", "", pagination.Page{Offset: 8, Limit: 8}).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FavoriteFilms(uint64(1), "recently_added", "", pagination.Page{Limit: 8}).Return(&response, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -839,7 +981,7 @@ func TestFavoriteActorsRemove(t *testing.T) {
 func TestFavoriteActors(t *testing.T) {
 	actorItem := models.Character{NameActor: "n"}
 	actors := []models.Character{actorItem}
-	response := requests.ActorsResponse{Actors: actors}
+	response := requests.ActorsResponse{Actors: actors, Total: 1}
 
 	testCases := map[string]struct {
 		method string
@@ -852,12 +994,12 @@ func TestFavoriteActors(t *testing.T) {
 		},
 		"Core error": {
 			method: http.MethodGet,
-			params: map[string]string{"page": "2", "page_size": "8"},
+			params: map[string]string{"page": "2", "per_page": "8"},
 			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
 		},
 		"Ok": {
 			method: http.MethodGet,
-			params: map[string]string{"page": "1", "page_size": "8"},
+			params: map[string]string{"page": "1", "per_page": "8"},
 			result: getExpectedResult(&requests.Response{Status: http.StatusOK, Body: response}),
 		},
 	}
@@ -866,8 +1008,8 @@ func TestFavoriteActors(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().FavoriteActors(uint64(1), uint64(8), uint64(8)).Return(nil, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().FavoriteActors(uint64(1), uint64(0), uint64(8)).Return(actors, nil).Times(1)
+	mockCore.EXPECT().FavoriteActors(uint64(1), pagination.Page{Offset: 8, Limit: 8}).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().FavoriteActors(uint64(1), pagination.Page{Limit: 8}).Return(&response, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -918,17 +1060,27 @@ func TestAddRating(t *testing.T) {
 		"Core error": {
 			method: http.MethodPost,
 			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
-			body:   createRatingBody(requests.CommentRequest{FilmId: 1}),
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1, Rating: 5}),
 		},
-		"found error": {
+		"Zero rating": {
 			method: http.MethodPost,
-			result: &requests.Response{Status: http.StatusNotAcceptable, Body: nil},
-			body:   createRatingBody(requests.CommentRequest{FilmId: 2}),
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1}),
+		},
+		"Too high rating": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1, Rating: 11}),
+		},
+		"Updated": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 2, Rating: 1}),
 		},
 		"Ok": {
 			method: http.MethodPost,
 			result: &requests.Response{Status: http.StatusOK, Body: nil},
-			body:   createRatingBody(requests.CommentRequest{FilmId: 3}),
+			body:   createRatingBody(requests.RatingRequest{FilmId: 3, Rating: 10}),
 		},
 	}
 
@@ -936,9 +1088,9 @@ func TestAddRating(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockCore := mocks.NewMockICore(mockCtrl)
-	mockCore.EXPECT().AddRating(uint64(1), uint64(1), uint16(0)).Return(false, fmt.Errorf("core_err")).Times(1)
-	mockCore.EXPECT().AddRating(uint64(2), uint64(1), uint16(0)).Return(true, nil).Times(1)
-	mockCore.EXPECT().AddRating(uint64(3), uint64(1), uint16(0)).Return(false, nil).Times(1)
+	mockCore.EXPECT().AddRating(uint64(1), uint64(1), uint16(5)).Return(false, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().AddRating(uint64(2), uint64(1), uint16(1)).Return(true, nil).Times(1)
+	mockCore.EXPECT().AddRating(uint64(3), uint64(1), uint16(10)).Return(false, nil).Times(1)
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 
@@ -966,3 +1118,136 @@ func TestAddRating(t *testing.T) {
 		}
 	}
 }
+
+func TestRating(t *testing.T) {
+	testCases := map[string]struct {
+		query  string
+		userId interface{}
+		status int
+	}{
+		"Anonymous": {query: "?film_id=1", status: http.StatusUnauthorized},
+		"Bad film":  {query: "?film_id=a", userId: uint64(1), status: http.StatusBadRequest},
+		"Not rated": {query: "?film_id=1", userId: uint64(1), status: http.StatusNotFound},
+		"Ok":        {query: "?film_id=2", userId: uint64(1), status: http.StatusOK},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetUserRating(uint64(1), uint64(1)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().GetUserRating(uint64(1), uint64(2)).Return(&models.RatingItem{}, nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for name, curr := range testCases {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/rating"+curr.query, nil)
+		if curr.userId != nil {
+			r = r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, curr.userId))
+		}
+		w := httptest.NewRecorder()
+
+		api.Rating(w, r)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.status {
+			t.Errorf("%s: unexpected status: %d, want %d", name, response.Status, curr.status)
+			return
+		}
+	}
+}
+
+func TestUpdateRating(t *testing.T) {
+	testCases := map[string]struct {
+		method string
+		result *requests.Response
+		body   io.Reader
+	}{
+		"Bad method": {
+			method: http.MethodPost,
+			result: &requests.Response{Status: http.StatusMethodNotAllowed, Body: nil},
+		},
+		"no body error": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   nil,
+		},
+		"Core error": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusInternalServerError, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1, Rating: 5}),
+		},
+		"Zero rating": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1}),
+		},
+		"Too high rating": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusBadRequest, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 1, Rating: 11}),
+		},
+		"Not rated": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusNotFound, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 2, Rating: 1}),
+		},
+		"Ok": {
+			method: http.MethodPut,
+			result: &requests.Response{Status: http.StatusOK, Body: nil},
+			body:   createRatingBody(requests.RatingRequest{FilmId: 3, Rating: 10}),
+		},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().UpdateRating(uint64(1), uint64(1), uint16(5)).Return(fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().UpdateRating(uint64(2), uint64(1), uint16(1)).Return(usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().UpdateRating(uint64(3), uint64(1), uint16(10)).Return(nil).Times(1)
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+
+	api := API{core: mockCore, lg: logger, ct: collector}
+
+	for _, curr := range testCases {
+		r := httptest.NewRequest(curr.method, "/api/v1/rating/update", curr.body)
+		newReq := r.WithContext(context.WithValue(r.Context(), middleware.UserIDKey, uint64(1)))
+
+		w := httptest.NewRecorder()
+
+		api.UpdateRating(w, newReq)
+		response, err := getResponse(w)
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+		if response.Status != curr.result.Status {
+			t.Errorf("unexpected status: %d, want %d", response.Status, curr.result.Status)
+			return
+		}
+		if !reflect.DeepEqual(response.Body, curr.result.Body) {
+			t.Errorf("wanted %v, got %v", curr.result.Body, response.Body)
+			return
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/rating/update", createRatingBody(requests.RatingRequest{FilmId: 3, Rating: 10}))
+	w := httptest.NewRecorder()
+	api.UpdateRating(w, r)
+	response, err := getResponse(w)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if response.Status != http.StatusUnauthorized {
+		t.Errorf("unexpected status: %d, want %d", response.Status, http.StatusUnauthorized)
+		return
+	}
+}
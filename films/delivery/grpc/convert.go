@@ -0,0 +1,69 @@
+package delivery_films_grpc
+
+import (
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+)
+
+func filmToProto(film models.FilmItem) *pb.Film {
+	return &pb.Film{
+		Id:          film.Id,
+		Title:       film.Title,
+		Info:        film.Info,
+		Poster:      film.Poster,
+		ReleaseDate: film.ReleaseDate,
+		Country:     film.Country,
+		Mpaa:        film.Mpaa,
+		Rating:      film.Rating,
+		Snippet:     film.Snippet,
+	}
+}
+
+func filmsToProto(films []models.FilmItem) []*pb.Film {
+	result := make([]*pb.Film, 0, len(films))
+	for _, film := range films {
+		result = append(result, filmToProto(film))
+	}
+
+	return result
+}
+
+func genresToProto(genres []models.GenreItem) []*pb.Genre {
+	result := make([]*pb.Genre, 0, len(genres))
+	for _, genre := range genres {
+		result = append(result, &pb.Genre{Id: genre.Id, Title: genre.Title})
+	}
+
+	return result
+}
+
+func crewToProto(crew []models.CrewItem) []*pb.Crew {
+	result := make([]*pb.Crew, 0, len(crew))
+	for _, person := range crew {
+		result = append(result, &pb.Crew{
+			Id:        person.Id,
+			Name:      person.Name,
+			BirthDate: person.Birthdate,
+			Photo:     person.Photo,
+			Country:   person.Country,
+			Info:      person.Info,
+		})
+	}
+
+	return result
+}
+
+func charactersToProto(characters []models.Character) []*pb.Character {
+	result := make([]*pb.Character, 0, len(characters))
+	for _, character := range characters {
+		result = append(result, &pb.Character{
+			ActorId:       character.IdActor,
+			ActorPhoto:    character.ActorPhoto,
+			ActorName:     character.NameActor,
+			CharacterName: character.NameCharacter,
+		})
+	}
+
+	return result
+}
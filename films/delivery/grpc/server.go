@@ -0,0 +1,277 @@
+package delivery_films_grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcserver"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/ratingstats"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+)
+
+type filmsGrpc struct {
+	grpcServ *grpc.Server
+	lg       *slog.Logger
+	port     string
+}
+
+type server struct {
+	pb.UnimplementedFilmsServer
+	core usecase.ICore
+	lg   *slog.Logger
+}
+
+// NewServer serves the films API. Export and deletion of user data are
+// kept to the authorization service, which calls with secret.
+func NewServer(core usecase.ICore, l *slog.Logger, port string, secret string) *filmsGrpc {
+	s := grpcserver.NewServer(l, grpcserver.Internal{
+		Secret:  secret,
+		Methods: []string{pb.Films_ExportUserData_FullMethodName, pb.Films_DeleteUserData_FullMethodName},
+	})
+	pb.RegisterFilmsServer(s, &server{
+		core: core,
+		lg:   l,
+	})
+
+	return &filmsGrpc{grpcServ: s, lg: l, port: port}
+}
+
+// toStatus turns the errors of the core into gRPC statuses, so that typed
+// clients can tell a missing film from a broken database.
+func (s *server) toStatus(err error, msg string) error {
+	switch {
+	case errors.Is(err, usecase.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, usecase.ErrFoundFavorite):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, usecase.ErrUnknownSort):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.lg.Error(msg, "err", err.Error())
+	return status.Error(codes.Internal, msg)
+}
+
+func (s *server) GetFilmInfo(ctx context.Context, req *pb.FilmRequest) (*pb.FilmInfoResponse, error) {
+	film, err := s.core.GetFilmInfo(req.FilmId)
+	if err != nil {
+		return nil, s.toStatus(err, "get film info error")
+	}
+
+	return &pb.FilmInfoResponse{
+		Film:               filmToProto(film.Film),
+		Genres:             genresToProto(film.Genres),
+		Rating:             film.Rating,
+		Number:             film.Number,
+		Directors:          crewToProto(film.Directors),
+		Scenarists:         crewToProto(film.Scenarists),
+		Characters:         charactersToProto(film.Characters),
+		RatingDistribution: film.Distribution,
+	}, nil
+}
+
+func (s *server) GetFilmsByGenre(ctx context.Context, req *pb.FilmsByGenreRequest) (*pb.FilmsByGenreResponse, error) {
+	films, err := s.core.GetFilmsAndGenreTitle(req.GenreId, film.SortReleaseDate, "", pagination.Page{Offset: req.Start, Limit: req.End})
+	if err != nil {
+		return nil, s.toStatus(err, "get films by genre error")
+	}
+
+	return &pb.FilmsByGenreResponse{Genre: films.CollectionName, Films: filmsToProto(films.Films)}, nil
+}
+
+func (s *server) SearchFilms(ctx context.Context, req *pb.SearchFilmsRequest) (*pb.FilmsResponse, error) {
+	sort := req.Sort
+	if sort == "" {
+		sort = film.SortTitle
+	}
+	films, err := s.core.FindFilm(req.Title, req.DateFrom, req.DateTo, req.RatingFrom, req.RatingTo,
+		req.Mpaa, req.Genres, req.Actors, sort, req.Order, req.First, req.Limit)
+	if err != nil {
+		return nil, s.toStatus(err, "search films error")
+	}
+
+	return &pb.FilmsResponse{Films: filmsToProto(films.Films)}, nil
+}
+
+func (s *server) SearchActors(ctx context.Context, req *pb.SearchActorsRequest) (*pb.ActorsResponse, error) {
+	actors, err := s.core.FindActor(req.Name, req.BirthDate, req.Films, req.Career, req.Country, req.First, req.Limit)
+	if err != nil {
+		return nil, s.toStatus(err, "search actors error")
+	}
+
+	return &pb.ActorsResponse{Actors: charactersToProto(actors.Actors)}, nil
+}
+
+func (s *server) GetActorInfo(ctx context.Context, req *pb.ActorRequest) (*pb.ActorInfoResponse, error) {
+	actor, err := s.core.GetActorInfo(req.ActorId)
+	if err != nil {
+		return nil, s.toStatus(err, "get actor info error")
+	}
+
+	career := make([]*pb.Profession, 0, len(actor.Career))
+	for _, profession := range actor.Career {
+		career = append(career, &pb.Profession{Id: profession.Id, Title: profession.Title})
+	}
+
+	return &pb.ActorInfoResponse{
+		Name:      actor.Name,
+		Photo:     actor.Photo,
+		Career:    career,
+		BirthDate: actor.BirthDate,
+		Country:   actor.Country,
+		Info:      actor.Info,
+	}, nil
+}
+
+func (s *server) GetFavoriteFilms(ctx context.Context, req *pb.FavoritesRequest) (*pb.FilmsResponse, error) {
+	films, err := s.core.FavoriteFilms(req.UserId, film.SortRecentlyAdded, "", pagination.Page{Offset: req.Start, Limit: req.End})
+	if err != nil {
+		return nil, s.toStatus(err, "get favorite films error")
+	}
+
+	return &pb.FilmsResponse{Films: filmsToProto(films.Films)}, nil
+}
+
+func (s *server) AddFavoriteFilm(ctx context.Context, req *pb.FavoriteRequest) (*pb.FavoriteResponse, error) {
+	err := s.core.FavoriteFilmsAdd(req.UserId, req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "add favorite film error")
+	}
+
+	return &pb.FavoriteResponse{}, nil
+}
+
+func (s *server) RemoveFavoriteFilm(ctx context.Context, req *pb.FavoriteRequest) (*pb.FavoriteResponse, error) {
+	err := s.core.FavoriteFilmsRemove(req.UserId, req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "remove favorite film error")
+	}
+
+	return &pb.FavoriteResponse{}, nil
+}
+
+func (s *server) GetFavoriteActors(ctx context.Context, req *pb.FavoritesRequest) (*pb.ActorsResponse, error) {
+	actors, err := s.core.FavoriteActors(req.UserId, pagination.Page{Offset: req.Start, Limit: req.End})
+	if err != nil {
+		return nil, s.toStatus(err, "get favorite actors error")
+	}
+
+	return &pb.ActorsResponse{Actors: charactersToProto(actors.Actors)}, nil
+}
+
+func (s *server) AddFavoriteActor(ctx context.Context, req *pb.FavoriteRequest) (*pb.FavoriteResponse, error) {
+	err := s.core.FavoriteActorsAdd(req.UserId, req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "add favorite actor error")
+	}
+
+	return &pb.FavoriteResponse{}, nil
+}
+
+func (s *server) RemoveFavoriteActor(ctx context.Context, req *pb.FavoriteRequest) (*pb.FavoriteResponse, error) {
+	err := s.core.FavoriteActorsRemove(req.UserId, req.Id)
+	if err != nil {
+		return nil, s.toStatus(err, "remove favorite actor error")
+	}
+
+	return &pb.FavoriteResponse{}, nil
+}
+
+func (s *server) AddRating(ctx context.Context, req *pb.AddRatingRequest) (*pb.AddRatingResponse, error) {
+	if req.Rating < 1 || req.Rating > ratingstats.MaxRating {
+		return nil, status.Error(codes.InvalidArgument, "rating must be from 1 to 10")
+	}
+
+	found, err := s.core.AddRating(req.FilmId, req.UserId, uint16(req.Rating))
+	if err != nil {
+		return nil, s.toStatus(err, "add rating error")
+	}
+
+	return &pb.AddRatingResponse{AlreadyRated: found}, nil
+}
+
+func (s *server) DeleteRating(ctx context.Context, req *pb.DeleteRatingRequest) (*pb.DeleteRatingResponse, error) {
+	err := s.core.DeleteRating(req.UserId, req.FilmId)
+	if err != nil {
+		return nil, s.toStatus(err, "delete rating error")
+	}
+
+	return &pb.DeleteRatingResponse{}, nil
+}
+
+func (s *server) GetCalendar(ctx context.Context, req *pb.CalendarRequest) (*pb.CalendarResponse, error) {
+	calendar, err := s.core.GetCalendar()
+	if err != nil {
+		return nil, s.toStatus(err, "get calendar error")
+	}
+
+	days := make([]*pb.Day, 0, len(calendar.Days))
+	for _, day := range calendar.Days {
+		days = append(days, &pb.Day{
+			DayNumber: uint32(day.DayNumber),
+			DayNews:   day.DayNews,
+			FilmId:    day.IdFilm,
+			Poster:    day.Poster,
+		})
+	}
+
+	return &pb.CalendarResponse{
+		MonthName:  calendar.MonthName,
+		MonthText:  calendar.MonthText,
+		CurrentDay: uint32(calendar.CurrentDay),
+		Days:       days,
+	}, nil
+}
+
+func (s *server) ExportUserData(ctx context.Context, req *pb.UserDataRequest) (*pb.UserDataResponse, error) {
+	data, err := s.core.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, s.toStatus(err, "export user data error")
+	}
+
+	ratings := make([]*pb.Rating, 0, len(data.Ratings))
+	for _, rating := range data.Ratings {
+		ratings = append(ratings, &pb.Rating{FilmId: rating.IdFilm, Rating: uint32(rating.Rating)})
+	}
+
+	return &pb.UserDataResponse{
+		FavoriteFilms:  data.FavoriteFilms,
+		FavoriteActors: data.FavoriteActors,
+		Ratings:        ratings,
+		NearFilms:      data.NearFilms,
+	}, nil
+}
+
+func (s *server) DeleteUserData(ctx context.Context, req *pb.UserDataRequest) (*pb.DeleteUserDataResponse, error) {
+	err := s.core.DeleteUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, s.toStatus(err, "delete user data error")
+	}
+
+	return &pb.DeleteUserDataResponse{}, nil
+}
+
+func (s *filmsGrpc) ListenAndServeGrpc() error {
+	lis, err := net.Listen("tcp", s.port)
+	if err != nil {
+		s.lg.Error("failed to listen", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	if err := s.grpcServ.Serve(lis); err != nil {
+		s.lg.Error("failed to serve", "err", err.Error())
+		return fmt.Errorf("listen and serve grpc error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package delivery_films_grpc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/usecase"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/go-park-mail-ru/2023_2_Vkladyshi/films/proto"
+)
+
+func TestGetFilmInfo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	expected := &requests.FilmResponse{
+		Film:       models.FilmItem{Id: 3, Title: "t1"},
+		Genres:     []models.GenreItem{{Id: 1, Title: "g1"}},
+		Rating:     7.5,
+		Number:     2,
+		Characters: []models.Character{{IdActor: 4, NameActor: "a1"}},
+	}
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().GetFilmInfo(uint64(1)).Return(nil, fmt.Errorf("core_err")).Times(1)
+	mockCore.EXPECT().GetFilmInfo(uint64(2)).Return(nil, usecase.ErrNotFound).Times(1)
+	mockCore.EXPECT().GetFilmInfo(uint64(3)).Return(expected, nil).Times(1)
+
+	s := &server{core: mockCore, lg: slog.New(slog.NewJSONHandler(os.Stdout, nil))}
+
+	_, err := s.GetFilmInfo(context.Background(), &pb.FilmRequest{FilmId: 1})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("wanted Internal, had %v", err)
+		return
+	}
+
+	_, err = s.GetFilmInfo(context.Background(), &pb.FilmRequest{FilmId: 2})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("wanted NotFound, had %v", err)
+		return
+	}
+
+	film, err := s.GetFilmInfo(context.Background(), &pb.FilmRequest{FilmId: 3})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if film.Film.Title != "t1" || film.Rating != 7.5 || len(film.Genres) != 1 || film.Characters[0].ActorName != "a1" {
+		t.Errorf("results not match, want %v, have %v", expected, film)
+		return
+	}
+}
+
+func TestAddFavoriteFilm(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCore := mocks.NewMockICore(mockCtrl)
+	mockCore.EXPECT().FavoriteFilmsAdd(uint64(1), uint64(2)).Return(usecase.ErrFoundFavorite).Times(1)
+	mockCore.EXPECT().FavoriteFilmsAdd(uint64(1), uint64(3)).Return(nil).Times(1)
+
+	s := &server{core: mockCore, lg: slog.New(slog.NewJSONHandler(os.Stdout, nil))}
+
+	_, err := s.AddFavoriteFilm(context.Background(), &pb.FavoriteRequest{UserId: 1, Id: 2})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("wanted AlreadyExists, had %v", err)
+		return
+	}
+
+	_, err = s.AddFavoriteFilm(context.Background(), &pb.FavoriteRequest{UserId: 1, Id: 3})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+}
@@ -10,6 +10,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	requests "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -81,6 +82,21 @@ func (mr *MockICoreMockRecorder) AddRating(filmId, userId, rating interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRating", reflect.TypeOf((*MockICore)(nil).AddRating), filmId, userId, rating)
 }
 
+// CheckPermission mocks base method.
+func (m *MockICore) CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPermission", ctx, userId, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckPermission indicates an expected call of CheckPermission.
+func (mr *MockICoreMockRecorder) CheckPermission(ctx, userId, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockICore)(nil).CheckPermission), ctx, userId, permission)
+}
+
 // DeleteRating mocks base method.
 func (m *MockICore) DeleteRating(idUser, idFilm uint64) error {
 	m.ctrl.T.Helper()
@@ -95,19 +111,48 @@ func (mr *MockICoreMockRecorder) DeleteRating(idUser, idFilm interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockICore)(nil).DeleteRating), idUser, idFilm)
 }
 
+// DeleteUserData mocks base method.
+func (m *MockICore) DeleteUserData(ctx context.Context, userId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockICoreMockRecorder) DeleteUserData(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockICore)(nil).DeleteUserData), ctx, userId)
+}
+
+// ExportUserData mocks base method.
+func (m *MockICore) ExportUserData(ctx context.Context, userId uint64) (*models.UserData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUserData", ctx, userId)
+	ret0, _ := ret[0].(*models.UserData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportUserData indicates an expected call of ExportUserData.
+func (mr *MockICoreMockRecorder) ExportUserData(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUserData", reflect.TypeOf((*MockICore)(nil).ExportUserData), ctx, userId)
+}
+
 // FavoriteActors mocks base method.
-func (m *MockICore) FavoriteActors(userId, start, end uint64) ([]models.Character, error) {
+func (m *MockICore) FavoriteActors(userId uint64, page pagination.Page) (*requests.ActorsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FavoriteActors", userId, start, end)
-	ret0, _ := ret[0].([]models.Character)
+	ret := m.ctrl.Call(m, "FavoriteActors", userId, page)
+	ret0, _ := ret[0].(*requests.ActorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FavoriteActors indicates an expected call of FavoriteActors.
-func (mr *MockICoreMockRecorder) FavoriteActors(userId, start, end interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) FavoriteActors(userId, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteActors", reflect.TypeOf((*MockICore)(nil).FavoriteActors), userId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteActors", reflect.TypeOf((*MockICore)(nil).FavoriteActors), userId, page)
 }
 
 // FavoriteActorsAdd mocks base method.
@@ -139,18 +184,18 @@ func (mr *MockICoreMockRecorder) FavoriteActorsRemove(userId, filmId interface{}
 }
 
 // FavoriteFilms mocks base method.
-func (m *MockICore) FavoriteFilms(userId, start, end uint64) ([]models.FilmItem, error) {
+func (m *MockICore) FavoriteFilms(userId uint64, sort, order string, page pagination.Page) (*requests.FilmsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FavoriteFilms", userId, start, end)
-	ret0, _ := ret[0].([]models.FilmItem)
+	ret := m.ctrl.Call(m, "FavoriteFilms", userId, sort, order, page)
+	ret0, _ := ret[0].(*requests.FilmsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FavoriteFilms indicates an expected call of FavoriteFilms.
-func (mr *MockICoreMockRecorder) FavoriteFilms(userId, start, end interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) FavoriteFilms(userId, sort, order, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilms", reflect.TypeOf((*MockICore)(nil).FavoriteFilms), userId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FavoriteFilms", reflect.TypeOf((*MockICore)(nil).FavoriteFilms), userId, sort, order, page)
 }
 
 // FavoriteFilmsAdd mocks base method.
@@ -182,10 +227,10 @@ func (mr *MockICoreMockRecorder) FavoriteFilmsRemove(userId, filmId interface{})
 }
 
 // FindActor mocks base method.
-func (m *MockICore) FindActor(name, birthDate string, films, career []string, country string, first, limit uint64) ([]models.Character, error) {
+func (m *MockICore) FindActor(name, birthDate string, films, career []string, country string, first, limit uint64) (*requests.FindActorResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindActor", name, birthDate, films, career, country, first, limit)
-	ret0, _ := ret[0].([]models.Character)
+	ret0, _ := ret[0].(*requests.FindActorResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -197,18 +242,18 @@ func (mr *MockICoreMockRecorder) FindActor(name, birthDate, films, career, count
 }
 
 // FindFilm mocks base method.
-func (m *MockICore) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, first, limit uint64) ([]models.FilmItem, error) {
+func (m *MockICore) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, sort, order string, first, limit uint64) (*requests.FindFilmResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
-	ret0, _ := ret[0].([]models.FilmItem)
+	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit)
+	ret0, _ := ret[0].(*requests.FindFilmResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFilm indicates an expected call of FindFilm.
-func (mr *MockICoreMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockICore)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockICore)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit)
 }
 
 // GetActorInfo mocks base method.
@@ -272,19 +317,18 @@ func (mr *MockICoreMockRecorder) GetFilmInfo(filmId interface{}) *gomock.Call {
 }
 
 // GetFilmsAndGenreTitle mocks base method.
-func (m *MockICore) GetFilmsAndGenreTitle(genreId, start, end uint64) ([]models.FilmItem, string, error) {
+func (m *MockICore) GetFilmsAndGenreTitle(genreId uint64, sort, order string, page pagination.Page) (*requests.FilmsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmsAndGenreTitle", genreId, start, end)
-	ret0, _ := ret[0].([]models.FilmItem)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetFilmsAndGenreTitle", genreId, sort, order, page)
+	ret0, _ := ret[0].(*requests.FilmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // GetFilmsAndGenreTitle indicates an expected call of GetFilmsAndGenreTitle.
-func (mr *MockICoreMockRecorder) GetFilmsAndGenreTitle(genreId, start, end interface{}) *gomock.Call {
+func (mr *MockICoreMockRecorder) GetFilmsAndGenreTitle(genreId, sort, order, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsAndGenreTitle", reflect.TypeOf((*MockICore)(nil).GetFilmsAndGenreTitle), genreId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsAndGenreTitle", reflect.TypeOf((*MockICore)(nil).GetFilmsAndGenreTitle), genreId, sort, order, page)
 }
 
 // GetGenre mocks base method.
@@ -347,6 +391,36 @@ func (mr *MockICoreMockRecorder) GetUserId(ctx, sid interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserId", reflect.TypeOf((*MockICore)(nil).GetUserId), ctx, sid)
 }
 
+// GetUserRating mocks base method.
+func (m *MockICore) GetUserRating(userId, filmId uint64) (*models.RatingItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRating", userId, filmId)
+	ret0, _ := ret[0].(*models.RatingItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRating indicates an expected call of GetUserRating.
+func (mr *MockICoreMockRecorder) GetUserRating(userId, filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRating", reflect.TypeOf((*MockICore)(nil).GetUserRating), userId, filmId)
+}
+
+// Suggest mocks base method.
+func (m *MockICore) Suggest(ctx context.Context, query string) ([]models.SuggestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, query)
+	ret0, _ := ret[0].([]models.SuggestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockICoreMockRecorder) Suggest(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockICore)(nil).Suggest), ctx, query)
+}
+
 // Trends mocks base method.
 func (m *MockICore) Trends() ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
@@ -362,6 +436,20 @@ func (mr *MockICoreMockRecorder) Trends() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockICore)(nil).Trends))
 }
 
+// UpdateRating mocks base method.
+func (m *MockICore) UpdateRating(filmId, userId uint64, rating uint16) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRating", filmId, userId, rating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRating indicates an expected call of UpdateRating.
+func (mr *MockICoreMockRecorder) UpdateRating(filmId, userId, rating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRating", reflect.TypeOf((*MockICore)(nil).UpdateRating), filmId, userId, rating)
+}
+
 // UsersStatistics mocks base method.
 func (m *MockICore) UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error) {
 	m.ctrl.T.Helper()
@@ -376,3 +464,18 @@ func (mr *MockICoreMockRecorder) UsersStatistics(idUser interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsersStatistics", reflect.TypeOf((*MockICore)(nil).UsersStatistics), idUser)
 }
+
+// VerifyAccessToken mocks base method.
+func (m *MockICore) VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAccessToken", ctx, accessToken)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAccessToken indicates an expected call of VerifyAccessToken.
+func (mr *MockICoreMockRecorder) VerifyAccessToken(ctx, accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAccessToken", reflect.TypeOf((*MockICore)(nil).VerifyAccessToken), ctx, accessToken)
+}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -77,6 +78,21 @@ func (mr *MockICrewRepoMockRecorder) CheckActor(userId, actorId interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckActor", reflect.TypeOf((*MockICrewRepo)(nil).CheckActor), userId, actorId)
 }
 
+// CountFavoriteActors mocks base method.
+func (m *MockICrewRepo) CountFavoriteActors(userId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFavoriteActors", userId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFavoriteActors indicates an expected call of CountFavoriteActors.
+func (mr *MockICrewRepoMockRecorder) CountFavoriteActors(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFavoriteActors", reflect.TypeOf((*MockICrewRepo)(nil).CountFavoriteActors), userId)
+}
+
 // FindActor mocks base method.
 func (m *MockICrewRepo) FindActor(name, birthDate string, films, career []string, country string, first, limit uint64) ([]models.Character, error) {
 	m.ctrl.T.Helper()
@@ -92,6 +108,22 @@ func (mr *MockICrewRepoMockRecorder) FindActor(name, birthDate, films, career, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActor", reflect.TypeOf((*MockICrewRepo)(nil).FindActor), name, birthDate, films, career, country, first, limit)
 }
 
+// FindActorFacets mocks base method.
+func (m *MockICrewRepo) FindActorFacets(name, birthDate string, films, career []string, country string) (uint64, *models.ActorFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActorFacets", name, birthDate, films, career, country)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(*models.ActorFacets)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindActorFacets indicates an expected call of FindActorFacets.
+func (mr *MockICrewRepoMockRecorder) FindActorFacets(name, birthDate, films, career, country interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActorFacets", reflect.TypeOf((*MockICrewRepo)(nil).FindActorFacets), name, birthDate, films, career, country)
+}
+
 // GetActor mocks base method.
 func (m *MockICrewRepo) GetActor(actorId uint64) (*models.CrewItem, error) {
 	m.ctrl.T.Helper()
@@ -107,19 +139,35 @@ func (mr *MockICrewRepoMockRecorder) GetActor(actorId interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActor", reflect.TypeOf((*MockICrewRepo)(nil).GetActor), actorId)
 }
 
-// GetFavoriteActors mocks base method.
-func (m *MockICrewRepo) GetFavoriteActors(userId, start, end uint64) ([]models.Character, error) {
+// GetFavoriteActorIds mocks base method.
+func (m *MockICrewRepo) GetFavoriteActorIds(userId uint64) ([]uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavoriteActors", userId, start, end)
-	ret0, _ := ret[0].([]models.Character)
+	ret := m.ctrl.Call(m, "GetFavoriteActorIds", userId)
+	ret0, _ := ret[0].([]uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetFavoriteActorIds indicates an expected call of GetFavoriteActorIds.
+func (mr *MockICrewRepoMockRecorder) GetFavoriteActorIds(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteActorIds", reflect.TypeOf((*MockICrewRepo)(nil).GetFavoriteActorIds), userId)
+}
+
+// GetFavoriteActors mocks base method.
+func (m *MockICrewRepo) GetFavoriteActors(userId uint64, page pagination.Page) ([]models.Character, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteActors", userId, page)
+	ret0, _ := ret[0].([]models.Character)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetFavoriteActors indicates an expected call of GetFavoriteActors.
-func (mr *MockICrewRepoMockRecorder) GetFavoriteActors(userId, start, end interface{}) *gomock.Call {
+func (mr *MockICrewRepoMockRecorder) GetFavoriteActors(userId, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteActors", reflect.TypeOf((*MockICrewRepo)(nil).GetFavoriteActors), userId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteActors", reflect.TypeOf((*MockICrewRepo)(nil).GetFavoriteActors), userId, page)
 }
 
 // GetFilmCharacters mocks base method.
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	pagination "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -63,11 +64,12 @@ func (mr *MockIFilmsRepoMockRecorder) AddFilm(film interface{}) *gomock.Call {
 }
 
 // AddRating mocks base method.
-func (m *MockIFilmsRepo) AddRating(filmId, userId uint64, rating uint16) error {
+func (m *MockIFilmsRepo) AddRating(filmId, userId uint64, rating uint16) (bool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddRating", filmId, userId, rating)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddRating indicates an expected call of AddRating.
@@ -76,6 +78,20 @@ func (mr *MockIFilmsRepoMockRecorder) AddRating(filmId, userId, rating interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRating", reflect.TypeOf((*MockIFilmsRepo)(nil).AddRating), filmId, userId, rating)
 }
 
+// AddView mocks base method.
+func (m *MockIFilmsRepo) AddView(filmId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddView", filmId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddView indicates an expected call of AddView.
+func (mr *MockIFilmsRepoMockRecorder) AddView(filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddView", reflect.TypeOf((*MockIFilmsRepo)(nil).AddView), filmId)
+}
+
 // CheckFilm mocks base method.
 func (m *MockIFilmsRepo) CheckFilm(userId, filmId uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +107,36 @@ func (mr *MockIFilmsRepoMockRecorder) CheckFilm(userId, filmId interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).CheckFilm), userId, filmId)
 }
 
+// CountFavoriteFilms mocks base method.
+func (m *MockIFilmsRepo) CountFavoriteFilms(userId uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFavoriteFilms", userId)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFavoriteFilms indicates an expected call of CountFavoriteFilms.
+func (mr *MockIFilmsRepoMockRecorder) CountFavoriteFilms(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFavoriteFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).CountFavoriteFilms), userId)
+}
+
+// CountFilms mocks base method.
+func (m *MockIFilmsRepo) CountFilms(genre uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFilms", genre)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFilms indicates an expected call of CountFilms.
+func (mr *MockIFilmsRepoMockRecorder) CountFilms(genre interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).CountFilms), genre)
+}
+
 // DeleteRating mocks base method.
 func (m *MockIFilmsRepo) DeleteRating(idUser, idFilm uint64) error {
 	m.ctrl.T.Helper()
@@ -105,34 +151,80 @@ func (mr *MockIFilmsRepoMockRecorder) DeleteRating(idUser, idFilm interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockIFilmsRepo)(nil).DeleteRating), idUser, idFilm)
 }
 
+// DeleteUserData mocks base method.
+func (m *MockIFilmsRepo) DeleteUserData(userId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockIFilmsRepoMockRecorder) DeleteUserData(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockIFilmsRepo)(nil).DeleteUserData), userId)
+}
+
 // FindFilm mocks base method.
-func (m *MockIFilmsRepo) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, first, limit uint64) ([]models.FilmItem, error) {
+func (m *MockIFilmsRepo) FindFilm(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string, sort, order string, first, limit uint64) ([]models.FilmItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	ret := m.ctrl.Call(m, "FindFilm", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit)
 	ret0, _ := ret[0].([]models.FilmItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindFilm indicates an expected call of FindFilm.
-func (mr *MockIFilmsRepoMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit interface{}) *gomock.Call {
+func (mr *MockIFilmsRepoMockRecorder) FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilm", reflect.TypeOf((*MockIFilmsRepo)(nil).FindFilm), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit)
 }
 
-// GetFavoriteFilms mocks base method.
-func (m *MockIFilmsRepo) GetFavoriteFilms(userId, start, end uint64) ([]models.FilmItem, error) {
+// FindFilmFacets mocks base method.
+func (m *MockIFilmsRepo) FindFilmFacets(title, dateFrom, dateTo string, ratingFrom, ratingTo float32, mpaa string, genres []uint32, actors []string) (uint64, *models.FilmFacets, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavoriteFilms", userId, start, end)
-	ret0, _ := ret[0].([]models.FilmItem)
+	ret := m.ctrl.Call(m, "FindFilmFacets", title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(*models.FilmFacets)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindFilmFacets indicates an expected call of FindFilmFacets.
+func (mr *MockIFilmsRepoMockRecorder) FindFilmFacets(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFilmFacets", reflect.TypeOf((*MockIFilmsRepo)(nil).FindFilmFacets), title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+}
+
+// GetFavoriteFilmIds mocks base method.
+func (m *MockIFilmsRepo) GetFavoriteFilmIds(userId uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteFilmIds", userId)
+	ret0, _ := ret[0].([]uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetFavoriteFilmIds indicates an expected call of GetFavoriteFilmIds.
+func (mr *MockIFilmsRepoMockRecorder) GetFavoriteFilmIds(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteFilmIds", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFavoriteFilmIds), userId)
+}
+
+// GetFavoriteFilms mocks base method.
+func (m *MockIFilmsRepo) GetFavoriteFilms(userId uint64, sort, order string, page pagination.Page) ([]models.FilmItem, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoriteFilms", userId, sort, order, page)
+	ret0, _ := ret[0].([]models.FilmItem)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetFavoriteFilms indicates an expected call of GetFavoriteFilms.
-func (mr *MockIFilmsRepoMockRecorder) GetFavoriteFilms(userId, start, end interface{}) *gomock.Call {
+func (mr *MockIFilmsRepoMockRecorder) GetFavoriteFilms(userId, sort, order, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFavoriteFilms), userId, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFavoriteFilms), userId, sort, order, page)
 }
 
 // GetFilm mocks base method.
@@ -165,50 +257,51 @@ func (mr *MockIFilmsRepoMockRecorder) GetFilmId(title interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmId", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmId), title)
 }
 
-// GetFilmRating mocks base method.
-func (m *MockIFilmsRepo) GetFilmRating(filmId uint64) (float64, uint64, error) {
+// GetFilmRatingStats mocks base method.
+func (m *MockIFilmsRepo) GetFilmRatingStats(filmId uint64) (*models.RatingStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmRating", filmId)
-	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(uint64)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "GetFilmRatingStats", filmId)
+	ret0, _ := ret[0].(*models.RatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetFilmRating indicates an expected call of GetFilmRating.
-func (mr *MockIFilmsRepoMockRecorder) GetFilmRating(filmId interface{}) *gomock.Call {
+// GetFilmRatingStats indicates an expected call of GetFilmRatingStats.
+func (mr *MockIFilmsRepoMockRecorder) GetFilmRatingStats(filmId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmRating", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmRating), filmId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmRatingStats", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmRatingStats), filmId)
 }
 
 // GetFilms mocks base method.
-func (m *MockIFilmsRepo) GetFilms(start, end uint64) ([]models.FilmItem, error) {
+func (m *MockIFilmsRepo) GetFilms(sort, order string, page pagination.Page) ([]models.FilmItem, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilms", start, end)
+	ret := m.ctrl.Call(m, "GetFilms", sort, order, page)
 	ret0, _ := ret[0].([]models.FilmItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetFilms indicates an expected call of GetFilms.
-func (mr *MockIFilmsRepoMockRecorder) GetFilms(start, end interface{}) *gomock.Call {
+func (mr *MockIFilmsRepoMockRecorder) GetFilms(sort, order, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilms), start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilms", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilms), sort, order, page)
 }
 
 // GetFilmsByGenre mocks base method.
-func (m *MockIFilmsRepo) GetFilmsByGenre(genre, start, end uint64) ([]models.FilmItem, error) {
+func (m *MockIFilmsRepo) GetFilmsByGenre(genre uint64, sort, order string, page pagination.Page) ([]models.FilmItem, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmsByGenre", genre, start, end)
+	ret := m.ctrl.Call(m, "GetFilmsByGenre", genre, sort, order, page)
 	ret0, _ := ret[0].([]models.FilmItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetFilmsByGenre indicates an expected call of GetFilmsByGenre.
-func (mr *MockIFilmsRepoMockRecorder) GetFilmsByGenre(genre, start, end interface{}) *gomock.Call {
+func (mr *MockIFilmsRepoMockRecorder) GetFilmsByGenre(genre, sort, order, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByGenre", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmsByGenre), genre, start, end)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByGenre", reflect.TypeOf((*MockIFilmsRepo)(nil).GetFilmsByGenre), genre, sort, order, page)
 }
 
 // GetLasts mocks base method.
@@ -226,19 +319,34 @@ func (mr *MockIFilmsRepoMockRecorder) GetLasts(ids interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLasts", reflect.TypeOf((*MockIFilmsRepo)(nil).GetLasts), ids)
 }
 
-// HasUsersRating mocks base method.
-func (m *MockIFilmsRepo) HasUsersRating(userId, filmId uint64) (bool, error) {
+// GetUserRating mocks base method.
+func (m *MockIFilmsRepo) GetUserRating(userId, filmId uint64) (*models.RatingItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasUsersRating", userId, filmId)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetUserRating", userId, filmId)
+	ret0, _ := ret[0].(*models.RatingItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRating indicates an expected call of GetUserRating.
+func (mr *MockIFilmsRepoMockRecorder) GetUserRating(userId, filmId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRating", reflect.TypeOf((*MockIFilmsRepo)(nil).GetUserRating), userId, filmId)
+}
+
+// GetUserRatings mocks base method.
+func (m *MockIFilmsRepo) GetUserRatings(userId uint64) ([]models.RatingItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRatings", userId)
+	ret0, _ := ret[0].([]models.RatingItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasUsersRating indicates an expected call of HasUsersRating.
-func (mr *MockIFilmsRepoMockRecorder) HasUsersRating(userId, filmId interface{}) *gomock.Call {
+// GetUserRatings indicates an expected call of GetUserRatings.
+func (mr *MockIFilmsRepoMockRecorder) GetUserRatings(userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasUsersRating", reflect.TypeOf((*MockIFilmsRepo)(nil).HasUsersRating), userId, filmId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRatings", reflect.TypeOf((*MockIFilmsRepo)(nil).GetUserRatings), userId)
 }
 
 // RemoveFavoriteFilm mocks base method.
@@ -269,3 +377,18 @@ func (mr *MockIFilmsRepoMockRecorder) Trends() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trends", reflect.TypeOf((*MockIFilmsRepo)(nil).Trends))
 }
+
+// UpdateRating mocks base method.
+func (m *MockIFilmsRepo) UpdateRating(filmId, userId uint64, rating uint16) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRating", filmId, userId, rating)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRating indicates an expected call of UpdateRating.
+func (mr *MockIFilmsRepoMockRecorder) UpdateRating(filmId, userId, rating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRating", reflect.TypeOf((*MockIFilmsRepo)(nil).UpdateRating), filmId, userId, rating)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: cache_suggest.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISuggestCache is a mock of ISuggestCache interface.
+type MockISuggestCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockISuggestCacheMockRecorder
+}
+
+// MockISuggestCacheMockRecorder is the mock recorder for MockISuggestCache.
+type MockISuggestCacheMockRecorder struct {
+	mock *MockISuggestCache
+}
+
+// NewMockISuggestCache creates a new mock instance.
+func NewMockISuggestCache(ctrl *gomock.Controller) *MockISuggestCache {
+	mock := &MockISuggestCache{ctrl: ctrl}
+	mock.recorder = &MockISuggestCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISuggestCache) EXPECT() *MockISuggestCacheMockRecorder {
+	return m.recorder
+}
+
+// GetSuggestions mocks base method.
+func (m *MockISuggestCache) GetSuggestions(ctx context.Context, query string) ([]models.SuggestItem, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestions", ctx, query)
+	ret0, _ := ret[0].([]models.SuggestItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSuggestions indicates an expected call of GetSuggestions.
+func (mr *MockISuggestCacheMockRecorder) GetSuggestions(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestions", reflect.TypeOf((*MockISuggestCache)(nil).GetSuggestions), ctx, query)
+}
+
+// SetSuggestions mocks base method.
+func (m *MockISuggestCache) SetSuggestions(ctx context.Context, query string, items []models.SuggestItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSuggestions", ctx, query, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSuggestions indicates an expected call of SetSuggestions.
+func (mr *MockISuggestCacheMockRecorder) SetSuggestions(ctx, query, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSuggestions", reflect.TypeOf((*MockISuggestCache)(nil).SetSuggestions), ctx, query, items)
+}
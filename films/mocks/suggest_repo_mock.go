@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repo_suggest.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockISuggestRepo is a mock of ISuggestRepo interface.
+type MockISuggestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockISuggestRepoMockRecorder
+}
+
+// MockISuggestRepoMockRecorder is the mock recorder for MockISuggestRepo.
+type MockISuggestRepoMockRecorder struct {
+	mock *MockISuggestRepo
+}
+
+// NewMockISuggestRepo creates a new mock instance.
+func NewMockISuggestRepo(ctrl *gomock.Controller) *MockISuggestRepo {
+	mock := &MockISuggestRepo{ctrl: ctrl}
+	mock.recorder = &MockISuggestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISuggestRepo) EXPECT() *MockISuggestRepoMockRecorder {
+	return m.recorder
+}
+
+// Refresh mocks base method.
+func (m *MockISuggestRepo) Refresh() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockISuggestRepoMockRecorder) Refresh() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockISuggestRepo)(nil).Refresh))
+}
+
+// Suggest mocks base method.
+func (m *MockISuggestRepo) Suggest(query string, limit uint64) ([]models.SuggestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", query, limit)
+	ret0, _ := ret[0].([]models.SuggestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockISuggestRepoMockRecorder) Suggest(query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockISuggestRepo)(nil).Suggest), query, limit)
+}
@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: films.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Films_GetFilmInfo_FullMethodName         = "/films.Films/GetFilmInfo"
+	Films_GetFilmsByGenre_FullMethodName     = "/films.Films/GetFilmsByGenre"
+	Films_SearchFilms_FullMethodName         = "/films.Films/SearchFilms"
+	Films_SearchActors_FullMethodName        = "/films.Films/SearchActors"
+	Films_GetActorInfo_FullMethodName        = "/films.Films/GetActorInfo"
+	Films_GetFavoriteFilms_FullMethodName    = "/films.Films/GetFavoriteFilms"
+	Films_AddFavoriteFilm_FullMethodName     = "/films.Films/AddFavoriteFilm"
+	Films_RemoveFavoriteFilm_FullMethodName  = "/films.Films/RemoveFavoriteFilm"
+	Films_GetFavoriteActors_FullMethodName   = "/films.Films/GetFavoriteActors"
+	Films_AddFavoriteActor_FullMethodName    = "/films.Films/AddFavoriteActor"
+	Films_RemoveFavoriteActor_FullMethodName = "/films.Films/RemoveFavoriteActor"
+	Films_AddRating_FullMethodName           = "/films.Films/AddRating"
+	Films_DeleteRating_FullMethodName        = "/films.Films/DeleteRating"
+	Films_GetCalendar_FullMethodName         = "/films.Films/GetCalendar"
+	Films_ExportUserData_FullMethodName      = "/films.Films/ExportUserData"
+	Films_DeleteUserData_FullMethodName      = "/films.Films/DeleteUserData"
+)
+
+// FilmsClient is the client API for Films service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FilmsClient interface {
+	GetFilmInfo(ctx context.Context, in *FilmRequest, opts ...grpc.CallOption) (*FilmInfoResponse, error)
+	GetFilmsByGenre(ctx context.Context, in *FilmsByGenreRequest, opts ...grpc.CallOption) (*FilmsByGenreResponse, error)
+	SearchFilms(ctx context.Context, in *SearchFilmsRequest, opts ...grpc.CallOption) (*FilmsResponse, error)
+	SearchActors(ctx context.Context, in *SearchActorsRequest, opts ...grpc.CallOption) (*ActorsResponse, error)
+	GetActorInfo(ctx context.Context, in *ActorRequest, opts ...grpc.CallOption) (*ActorInfoResponse, error)
+	GetFavoriteFilms(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*FilmsResponse, error)
+	AddFavoriteFilm(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	RemoveFavoriteFilm(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	GetFavoriteActors(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*ActorsResponse, error)
+	AddFavoriteActor(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	RemoveFavoriteActor(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error)
+	AddRating(ctx context.Context, in *AddRatingRequest, opts ...grpc.CallOption) (*AddRatingResponse, error)
+	DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*DeleteRatingResponse, error)
+	GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error)
+	ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error)
+	DeleteUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
+}
+
+type filmsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFilmsClient(cc grpc.ClientConnInterface) FilmsClient {
+	return &filmsClient{cc}
+}
+
+func (c *filmsClient) GetFilmInfo(ctx context.Context, in *FilmRequest, opts ...grpc.CallOption) (*FilmInfoResponse, error) {
+	out := new(FilmInfoResponse)
+	err := c.cc.Invoke(ctx, Films_GetFilmInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetFilmsByGenre(ctx context.Context, in *FilmsByGenreRequest, opts ...grpc.CallOption) (*FilmsByGenreResponse, error) {
+	out := new(FilmsByGenreResponse)
+	err := c.cc.Invoke(ctx, Films_GetFilmsByGenre_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) SearchFilms(ctx context.Context, in *SearchFilmsRequest, opts ...grpc.CallOption) (*FilmsResponse, error) {
+	out := new(FilmsResponse)
+	err := c.cc.Invoke(ctx, Films_SearchFilms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) SearchActors(ctx context.Context, in *SearchActorsRequest, opts ...grpc.CallOption) (*ActorsResponse, error) {
+	out := new(ActorsResponse)
+	err := c.cc.Invoke(ctx, Films_SearchActors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetActorInfo(ctx context.Context, in *ActorRequest, opts ...grpc.CallOption) (*ActorInfoResponse, error) {
+	out := new(ActorInfoResponse)
+	err := c.cc.Invoke(ctx, Films_GetActorInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetFavoriteFilms(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*FilmsResponse, error) {
+	out := new(FilmsResponse)
+	err := c.cc.Invoke(ctx, Films_GetFavoriteFilms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) AddFavoriteFilm(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, Films_AddFavoriteFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) RemoveFavoriteFilm(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, Films_RemoveFavoriteFilm_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetFavoriteActors(ctx context.Context, in *FavoritesRequest, opts ...grpc.CallOption) (*ActorsResponse, error) {
+	out := new(ActorsResponse)
+	err := c.cc.Invoke(ctx, Films_GetFavoriteActors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) AddFavoriteActor(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, Films_AddFavoriteActor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) RemoveFavoriteActor(ctx context.Context, in *FavoriteRequest, opts ...grpc.CallOption) (*FavoriteResponse, error) {
+	out := new(FavoriteResponse)
+	err := c.cc.Invoke(ctx, Films_RemoveFavoriteActor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) AddRating(ctx context.Context, in *AddRatingRequest, opts ...grpc.CallOption) (*AddRatingResponse, error) {
+	out := new(AddRatingResponse)
+	err := c.cc.Invoke(ctx, Films_AddRating_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) DeleteRating(ctx context.Context, in *DeleteRatingRequest, opts ...grpc.CallOption) (*DeleteRatingResponse, error) {
+	out := new(DeleteRatingResponse)
+	err := c.cc.Invoke(ctx, Films_DeleteRating_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) GetCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*CalendarResponse, error) {
+	out := new(CalendarResponse)
+	err := c.cc.Invoke(ctx, Films_GetCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) ExportUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*UserDataResponse, error) {
+	out := new(UserDataResponse)
+	err := c.cc.Invoke(ctx, Films_ExportUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filmsClient) DeleteUserData(ctx context.Context, in *UserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, Films_DeleteUserData_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FilmsServer is the server API for Films service.
+// All implementations must embed UnimplementedFilmsServer
+// for forward compatibility
+type FilmsServer interface {
+	GetFilmInfo(context.Context, *FilmRequest) (*FilmInfoResponse, error)
+	GetFilmsByGenre(context.Context, *FilmsByGenreRequest) (*FilmsByGenreResponse, error)
+	SearchFilms(context.Context, *SearchFilmsRequest) (*FilmsResponse, error)
+	SearchActors(context.Context, *SearchActorsRequest) (*ActorsResponse, error)
+	GetActorInfo(context.Context, *ActorRequest) (*ActorInfoResponse, error)
+	GetFavoriteFilms(context.Context, *FavoritesRequest) (*FilmsResponse, error)
+	AddFavoriteFilm(context.Context, *FavoriteRequest) (*FavoriteResponse, error)
+	RemoveFavoriteFilm(context.Context, *FavoriteRequest) (*FavoriteResponse, error)
+	GetFavoriteActors(context.Context, *FavoritesRequest) (*ActorsResponse, error)
+	AddFavoriteActor(context.Context, *FavoriteRequest) (*FavoriteResponse, error)
+	RemoveFavoriteActor(context.Context, *FavoriteRequest) (*FavoriteResponse, error)
+	AddRating(context.Context, *AddRatingRequest) (*AddRatingResponse, error)
+	DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error)
+	GetCalendar(context.Context, *CalendarRequest) (*CalendarResponse, error)
+	ExportUserData(context.Context, *UserDataRequest) (*UserDataResponse, error)
+	DeleteUserData(context.Context, *UserDataRequest) (*DeleteUserDataResponse, error)
+	mustEmbedUnimplementedFilmsServer()
+}
+
+// UnimplementedFilmsServer must be embedded to have forward compatible implementations.
+type UnimplementedFilmsServer struct {
+}
+
+func (UnimplementedFilmsServer) GetFilmInfo(context.Context, *FilmRequest) (*FilmInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilmInfo not implemented")
+}
+func (UnimplementedFilmsServer) GetFilmsByGenre(context.Context, *FilmsByGenreRequest) (*FilmsByGenreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFilmsByGenre not implemented")
+}
+func (UnimplementedFilmsServer) SearchFilms(context.Context, *SearchFilmsRequest) (*FilmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFilms not implemented")
+}
+func (UnimplementedFilmsServer) SearchActors(context.Context, *SearchActorsRequest) (*ActorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchActors not implemented")
+}
+func (UnimplementedFilmsServer) GetActorInfo(context.Context, *ActorRequest) (*ActorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActorInfo not implemented")
+}
+func (UnimplementedFilmsServer) GetFavoriteFilms(context.Context, *FavoritesRequest) (*FilmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteFilms not implemented")
+}
+func (UnimplementedFilmsServer) AddFavoriteFilm(context.Context, *FavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteFilm not implemented")
+}
+func (UnimplementedFilmsServer) RemoveFavoriteFilm(context.Context, *FavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteFilm not implemented")
+}
+func (UnimplementedFilmsServer) GetFavoriteActors(context.Context, *FavoritesRequest) (*ActorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavoriteActors not implemented")
+}
+func (UnimplementedFilmsServer) AddFavoriteActor(context.Context, *FavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteActor not implemented")
+}
+func (UnimplementedFilmsServer) RemoveFavoriteActor(context.Context, *FavoriteRequest) (*FavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteActor not implemented")
+}
+func (UnimplementedFilmsServer) AddRating(context.Context, *AddRatingRequest) (*AddRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRating not implemented")
+}
+func (UnimplementedFilmsServer) DeleteRating(context.Context, *DeleteRatingRequest) (*DeleteRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRating not implemented")
+}
+func (UnimplementedFilmsServer) GetCalendar(context.Context, *CalendarRequest) (*CalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedFilmsServer) ExportUserData(context.Context, *UserDataRequest) (*UserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedFilmsServer) DeleteUserData(context.Context, *UserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedFilmsServer) mustEmbedUnimplementedFilmsServer() {}
+
+// UnsafeFilmsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FilmsServer will
+// result in compilation errors.
+type UnsafeFilmsServer interface {
+	mustEmbedUnimplementedFilmsServer()
+}
+
+func RegisterFilmsServer(s grpc.ServiceRegistrar, srv FilmsServer) {
+	s.RegisterService(&Films_ServiceDesc, srv)
+}
+
+func _Films_GetFilmInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetFilmInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetFilmInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetFilmInfo(ctx, req.(*FilmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetFilmsByGenre_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilmsByGenreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetFilmsByGenre(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetFilmsByGenre_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetFilmsByGenre(ctx, req.(*FilmsByGenreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_SearchFilms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFilmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).SearchFilms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_SearchFilms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).SearchFilms(ctx, req.(*SearchFilmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_SearchActors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchActorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).SearchActors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_SearchActors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).SearchActors(ctx, req.(*SearchActorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetActorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetActorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetActorInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetActorInfo(ctx, req.(*ActorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetFavoriteFilms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetFavoriteFilms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetFavoriteFilms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetFavoriteFilms(ctx, req.(*FavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_AddFavoriteFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).AddFavoriteFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_AddFavoriteFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).AddFavoriteFilm(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_RemoveFavoriteFilm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).RemoveFavoriteFilm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_RemoveFavoriteFilm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).RemoveFavoriteFilm(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetFavoriteActors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetFavoriteActors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetFavoriteActors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetFavoriteActors(ctx, req.(*FavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_AddFavoriteActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).AddFavoriteActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_AddFavoriteActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).AddFavoriteActor(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_RemoveFavoriteActor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).RemoveFavoriteActor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_RemoveFavoriteActor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).RemoveFavoriteActor(ctx, req.(*FavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_AddRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).AddRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_AddRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).AddRating(ctx, req.(*AddRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_DeleteRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).DeleteRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_DeleteRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).DeleteRating(ctx, req.(*DeleteRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).GetCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_GetCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).GetCalendar(ctx, req.(*CalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).ExportUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Films_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilmsServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Films_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilmsServer).DeleteUserData(ctx, req.(*UserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Films_ServiceDesc is the grpc.ServiceDesc for Films service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Films_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "films.Films",
+	HandlerType: (*FilmsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFilmInfo",
+			Handler:    _Films_GetFilmInfo_Handler,
+		},
+		{
+			MethodName: "GetFilmsByGenre",
+			Handler:    _Films_GetFilmsByGenre_Handler,
+		},
+		{
+			MethodName: "SearchFilms",
+			Handler:    _Films_SearchFilms_Handler,
+		},
+		{
+			MethodName: "SearchActors",
+			Handler:    _Films_SearchActors_Handler,
+		},
+		{
+			MethodName: "GetActorInfo",
+			Handler:    _Films_GetActorInfo_Handler,
+		},
+		{
+			MethodName: "GetFavoriteFilms",
+			Handler:    _Films_GetFavoriteFilms_Handler,
+		},
+		{
+			MethodName: "AddFavoriteFilm",
+			Handler:    _Films_AddFavoriteFilm_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteFilm",
+			Handler:    _Films_RemoveFavoriteFilm_Handler,
+		},
+		{
+			MethodName: "GetFavoriteActors",
+			Handler:    _Films_GetFavoriteActors_Handler,
+		},
+		{
+			MethodName: "AddFavoriteActor",
+			Handler:    _Films_AddFavoriteActor_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteActor",
+			Handler:    _Films_RemoveFavoriteActor_Handler,
+		},
+		{
+			MethodName: "AddRating",
+			Handler:    _Films_AddRating_Handler,
+		},
+		{
+			MethodName: "DeleteRating",
+			Handler:    _Films_DeleteRating_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _Films_GetCalendar_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _Films_ExportUserData_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _Films_DeleteUserData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "films.proto",
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/search"
 	"github.com/lib/pq"
 
 	_ "github.com/jackc/pgx/stdlib"
@@ -24,11 +26,14 @@ type ICrewRepo interface {
 	GetFilmCharacters(filmId uint64) ([]models.Character, error)
 	GetActor(actorId uint64) (*models.CrewItem, error)
 	FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error)
-	GetFavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error)
+	FindActorFacets(name string, birthDate string, films []string, career []string, country string) (uint64, *models.ActorFacets, error)
+	GetFavoriteActors(userId uint64, page pagination.Page) ([]models.Character, string, error)
+	CountFavoriteActors(userId uint64) (uint64, error)
 	CheckActor(userId uint64, actorId uint64) (bool, error)
 	AddFavoriteActor(userId uint64, actorId uint64) error
 	RemoveFavoriteActor(userId uint64, actorId uint64) error
 	AddFilm(actors []uint64, filmId uint64) error
+	GetFavoriteActorIds(userId uint64) ([]uint64, error)
 }
 
 type RepoPostgre struct {
@@ -159,22 +164,34 @@ func (repo *RepoPostgre) GetActor(actorId uint64) (*models.CrewItem, error) {
 	return actor, nil
 }
 
-func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error) {
-	actors := []models.Character{}
+// writeActorFilter writes the joins and the conditions of an actor search
+// to s and returns their params.
+func writeActorFilter(s *strings.Builder, name string, birthDate string, films []string, career []string, country string) []interface{} {
 	var hasWhere bool
 	paramNum := 1
 	var params []interface{}
-	var s strings.Builder
-	s.WriteString("SELECT DISTINCT crew.id, crew.name, crew.photo FROM crew " +
+	s.WriteString(" FROM crew " +
 		"JOIN person_in_film ON crew.id = person_in_film.id_person " +
 		"JOIN film ON person_in_film.id_film = film.id " +
 		"JOIN profession ON person_in_film.id_profession = profession.id ")
 	if name != "" {
 		s.WriteString("WHERE ")
 		hasWhere = true
-		s.WriteString("name LIKE '%' || $1 || '%'")
-		paramNum++
-		params = append(params, name)
+		// The name is looked up in the same prefix index as the suggestions.
+		// The people added since it was refreshed are not in it yet, they
+		// have the larger ids and match the name as a substring. A name
+		// without words matches no one, as in the suggestions.
+		prefix := search.PrefixQuery(name)
+		if prefix != "" {
+			s.WriteString("(crew.id IN (SELECT id_item FROM search_suggestions " +
+				"WHERE kind = 'person' AND search_key @@ to_tsquery('simple', $1)) " +
+				"OR (crew.id > (SELECT COALESCE(MAX(id_item), 0) FROM search_suggestions WHERE kind = 'person') " +
+				"AND crew.name LIKE '%' || $2 || '%')) ")
+			paramNum += 2
+			params = append(params, prefix, name)
+		} else {
+			s.WriteString("FALSE ")
+		}
 	}
 	if birthDate != "" {
 		if !hasWhere {
@@ -187,7 +204,7 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 		paramNum++
 		params = append(params, birthDate)
 	}
-	if films[0] != "" {
+	if len(films) > 0 && films[0] != "" {
 		if !hasWhere {
 			s.WriteString("WHERE ")
 			hasWhere = true
@@ -200,7 +217,7 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 		paramNum++
 		params = append(params, pq.Array(films))
 	}
-	if career[0] != "" {
+	if len(career) > 0 && career[0] != "" {
 		if !hasWhere {
 			s.WriteString("WHERE ")
 			hasWhere = true
@@ -219,10 +236,19 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 		} else {
 			s.WriteString("AND ")
 		}
-		s.WriteString("country = $" + strconv.Itoa(paramNum))
+		s.WriteString("crew.country = $" + strconv.Itoa(paramNum) + " ")
 		params = append(params, country)
 	}
 
+	return params
+}
+
+func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error) {
+	actors := []models.Character{}
+	var s strings.Builder
+	s.WriteString("SELECT DISTINCT crew.id, crew.name, crew.photo")
+	params := writeActorFilter(&s, name, birthDate, films, career, country)
+	paramNum := len(params) + 1
 	s.WriteString("LIMIT $" + strconv.Itoa(paramNum) + " OFFSET $" + strconv.Itoa(paramNum+1))
 	params = append(params, limit, first)
 
@@ -244,29 +270,105 @@ func (repo *RepoPostgre) FindActor(name string, birthDate string, films []string
 	return actors, nil
 }
 
-func (repo *RepoPostgre) GetFavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error) {
+// FindActorFacets counts the people found by the filters, see
+// writeActorFilter, in total and by country and profession.
+func (repo *RepoPostgre) FindActorFacets(name string, birthDate string, films []string, career []string, country string) (uint64, *models.ActorFacets, error) {
+	var s strings.Builder
+	s.WriteString("WITH matched AS (SELECT DISTINCT crew.id")
+	params := writeActorFilter(&s, name, birthDate, films, career, country)
+	s.WriteString(") " +
+		"SELECT 'total', '', COUNT(*) FROM matched " +
+		"UNION ALL SELECT 'country', COALESCE(crew.country, ''), COUNT(*) FROM matched " +
+		"JOIN crew ON crew.id = matched.id GROUP BY 2 " +
+		"UNION ALL SELECT 'profession', profession.title, COUNT(DISTINCT matched.id) FROM matched " +
+		"JOIN person_in_film ON person_in_film.id_person = matched.id " +
+		"JOIN profession ON profession.id = person_in_film.id_profession GROUP BY 2 " +
+		"ORDER BY 1, 3 DESC, 2")
+
+	rows, err := repo.db.Query(s.String(), params...)
+	if err != nil {
+		return 0, nil, fmt.Errorf("find actor facets err: %w", err)
+	}
+	defer rows.Close()
+
+	var total uint64
+	facets := &models.ActorFacets{
+		Countries:   []models.FacetBucket{},
+		Professions: []models.FacetBucket{},
+	}
+	for rows.Next() {
+		var facet string
+		bucket := models.FacetBucket{}
+		err := rows.Scan(&facet, &bucket.Value, &bucket.Count)
+		if err != nil {
+			return 0, nil, fmt.Errorf("find actor facets scan err: %w", err)
+		}
+		switch facet {
+		case "total":
+			total = bucket.Count
+		case "country":
+			facets.Countries = append(facets.Countries, bucket)
+		case "profession":
+			facets.Professions = append(facets.Professions, bucket)
+		}
+	}
+
+	return total, facets, nil
+}
+
+// favoritesOrder is the order of the favorite actors, the last added first.
+var favoritesOrder = pagination.Order{
+	Columns: []pagination.Column{{Expr: "crew.id", Type: "BIGINT"}},
+	Desc:    true,
+}
+
+func (repo *RepoPostgre) GetFavoriteActors(userId uint64, page pagination.Page) ([]models.Character, string, error) {
 	actors := []models.Character{}
 
-	rows, err := repo.db.Query(
-		"SELECT crew.name, crew.id, crew.photo FROM crew "+
-			"JOIN users_favorite_actor ON crew.id = users_favorite_actor.id_actor "+
-			"WHERE id_user = $1 "+
-			"OFFSET $2 LIMIT $3", userId, start, end)
+	var s strings.Builder
+	s.WriteString("SELECT crew.name, crew.id, crew.photo, " + favoritesOrder.Key() + " FROM crew " +
+		"JOIN users_favorite_actor ON crew.id = users_favorite_actor.id_actor " +
+		"WHERE id_user = $1 ")
+	params, err := page.Write(&s, favoritesOrder, true, 2)
+	if err != nil {
+		return nil, "", fmt.Errorf("get favorite actors err: %w", err)
+	}
+
+	rows, err := repo.db.Query(s.String(), append([]interface{}{userId}, params...)...)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("get favorite actors err: %w", err)
+		return nil, "", fmt.Errorf("get favorite actors err: %w", err)
 	}
 	defer rows.Close()
 
+	var next string
+	var lastKey []string
 	for rows.Next() {
 		post := models.Character{}
-		err := rows.Scan(&post.NameActor, &post.IdActor, &post.ActorPhoto)
+		key, keyDest := favoritesOrder.KeyDest()
+		err := rows.Scan(append([]interface{}{&post.NameActor, &post.IdActor, &post.ActorPhoto}, keyDest...)...)
 		if err != nil {
-			return nil, fmt.Errorf("get favorite actors scan err: %w", err)
+			return nil, "", fmt.Errorf("get favorite actors scan err: %w", err)
+		}
+		if page.Full(len(actors)) {
+			next = pagination.Encode(lastKey)
+			break
 		}
 		actors = append(actors, post)
+		lastKey = key
 	}
 
-	return actors, nil
+	return actors, next, nil
+}
+
+func (repo *RepoPostgre) CountFavoriteActors(userId uint64) (uint64, error) {
+	var count uint64
+	err := repo.db.QueryRow(
+		"SELECT COUNT(*) FROM users_favorite_actor WHERE id_user = $1", userId).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count favorite actors err: %w", err)
+	}
+
+	return count, nil
 }
 
 func (repo *RepoPostgre) CheckActor(userId uint64, actorId uint64) (bool, error) {
@@ -324,3 +426,24 @@ func (repo *RepoPostgre) AddFilm(actors []uint64, filmId uint64) error {
 	}
 	return nil
 }
+
+func (repo *RepoPostgre) GetFavoriteActorIds(userId uint64) ([]uint64, error) {
+	ids := []uint64{}
+
+	rows, err := repo.db.Query("SELECT id_actor FROM users_favorite_actor WHERE id_user = $1", userId)
+	if err != nil {
+		return nil, fmt.Errorf("get favorite actor ids err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("get favorite actor ids scan err: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
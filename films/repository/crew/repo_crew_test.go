@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 )
 
 func TestGetFilmDirectors(t *testing.T) {
@@ -319,27 +320,28 @@ func TestGetFavoriteActors(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Name", "Id", "Photo"})
+	rows := sqlmock.NewRows([]string{"Name", "Id", "Photo", "Id"}).
+		AddRow("t2", 2, "url2", "2").
+		AddRow("t1", 1, "url1", "1")
 
 	expect := []models.Character{
-		{IdActor: 1, NameActor: "t1", ActorPhoto: "url1"},
+		{IdActor: 2, NameActor: "t2", ActorPhoto: "url2"},
 	}
-
-	for _, item := range expect {
-		rows = rows.AddRow(item.NameActor, item.IdActor, item.ActorPhoto)
-	}
-	selectRow := "SELECT crew.name, crew.id, crew.photo FROM crew JOIN users_favorite_actor ON crew.id = users_favorite_actor.id_actor WHERE id_user = $1 OFFSET $2 LIMIT $3"
+	selectRow := "SELECT crew.name, crew.id, crew.photo, crew.id::TEXT FROM crew " +
+		"JOIN users_favorite_actor ON crew.id = users_favorite_actor.id_actor WHERE id_user = $1 " +
+		"AND (crew.id) < ($2::BIGINT) ORDER BY crew.id DESC LIMIT $3 OFFSET $4"
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1, 2).
+		WithArgs(1, "3", 2, 0).
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	films, err := repo.GetFavoriteActors(1, 1, 2)
+	page := pagination.Page{After: []string{"3"}, Limit: 1}
+	films, next, err := repo.GetFavoriteActors(1, page)
 	if err != nil {
 		t.Errorf("unexpected error: %s", err)
 	}
@@ -353,13 +355,17 @@ func TestGetFavoriteActors(t *testing.T) {
 		t.Errorf("results not match, want %v, have %v", expect, films)
 		return
 	}
+	if want := pagination.Encode([]string{"2"}); next != want {
+		t.Errorf("results not match, want %v, have %v", want, next)
+		return
+	}
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1, 2).
+		WithArgs(1, "3", 2, 0).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, err = repo.GetFavoriteActors(1, 1, 2)
+	_, _, err = repo.GetFavoriteActors(1, page)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -495,3 +501,120 @@ func TestAddFilm(t *testing.T) {
 		return
 	}
 }
+
+func TestFindActor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Id", "Name", "Photo"}).
+		AddRow(1, "Brad Pitt", "p1")
+
+	expect := []models.Character{
+		{IdActor: 1, NameActor: "Brad Pitt", ActorPhoto: "p1"},
+	}
+
+	selectRow := "SELECT DISTINCT crew.id, crew.name, crew.photo FROM crew " +
+		"JOIN person_in_film ON crew.id = person_in_film.id_person " +
+		"JOIN film ON person_in_film.id_film = film.id " +
+		"JOIN profession ON person_in_film.id_profession = profession.id " +
+		"WHERE (crew.id IN (SELECT id_item FROM search_suggestions " +
+		"WHERE kind = 'person' AND search_key @@ to_tsquery('simple', $1)) " +
+		"OR (crew.id > (SELECT COALESCE(MAX(id_item), 0) FROM search_suggestions WHERE kind = 'person') " +
+		"AND crew.name LIKE '%' || $2 || '%')) " +
+		"LIMIT $3 OFFSET $4"
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs("pitt:*", "pitt", uint64(10), uint64(0)).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	actors, err := repo.FindActor("pitt", "", []string{""}, []string{""}, "", 0, 10)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(actors, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, actors)
+		return
+	}
+
+	selectRow = "SELECT DISTINCT crew.id, crew.name, crew.photo FROM crew " +
+		"JOIN person_in_film ON crew.id = person_in_film.id_person " +
+		"JOIN film ON person_in_film.id_film = film.id " +
+		"JOIN profession ON person_in_film.id_profession = profession.id " +
+		"WHERE FALSE " +
+		"LIMIT $1 OFFSET $2"
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(uint64(10), uint64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"Id", "Name", "Photo"}))
+
+	actors, err = repo.FindActor("-", "", []string{""}, []string{""}, "", 0, 10)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if len(actors) != 0 {
+		t.Errorf("wanted no actors, had %v", actors)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestFindActorFacets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Facet", "Value", "Count"}).
+		AddRow("country", "США", 2).
+		AddRow("profession", "актёр", 2).
+		AddRow("profession", "режиссёр", 1).
+		AddRow("total", "", 2)
+
+	expect := &models.ActorFacets{
+		Countries:   []models.FacetBucket{{Value: "США", Count: 2}},
+		Professions: []models.FacetBucket{{Value: "актёр", Count: 2}, {Value: "режиссёр", Count: 1}},
+	}
+
+	selectRow := "WITH matched AS (SELECT DISTINCT crew.id FROM crew " +
+		"JOIN person_in_film ON crew.id = person_in_film.id_person " +
+		"JOIN film ON person_in_film.id_film = film.id " +
+		"JOIN profession ON person_in_film.id_profession = profession.id " +
+		"WHERE crew.country = $1 ) " +
+		"SELECT 'total', '', COUNT(*) FROM matched"
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs("США").
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	total, facets, err := repo.FindActorFacets("", "", nil, nil, "США")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if total != 2 {
+		t.Errorf("results not match, want %v, have %v", 2, total)
+		return
+	}
+	if !reflect.DeepEqual(facets, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, facets)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -11,6 +11,9 @@ import (
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/ratingstats"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/search"
 	"github.com/lib/pq"
 
 	_ "github.com/jackc/pgx/stdlib"
@@ -18,24 +21,34 @@ import (
 
 //go:generate mockgen -source=repo_film.go -destination=../../mocks/film_repo_mock.go -package=mocks
 type IFilmsRepo interface {
-	GetFilmsByGenre(genre uint64, start uint64, end uint64) ([]models.FilmItem, error)
-	GetFilms(start uint64, end uint64) ([]models.FilmItem, error)
+	GetFilmsByGenre(genre uint64, sort string, order string, page pagination.Page) ([]models.FilmItem, string, error)
+	GetFilms(sort string, order string, page pagination.Page) ([]models.FilmItem, string, error)
+	CountFilms(genre uint64) (uint64, error)
 	GetFilm(filmId uint64) (*models.FilmItem, error)
-	GetFilmRating(filmId uint64) (float64, uint64, error)
+	GetFilmRatingStats(filmId uint64) (*models.RatingStats, error)
 	FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-		mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
+		mpaa string, genres []uint32, actors []string, sort string, order string, first uint64, limit uint64,
 	) ([]models.FilmItem, error)
-	GetFavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error)
+	FindFilmFacets(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+		mpaa string, genres []uint32, actors []string,
+	) (uint64, *models.FilmFacets, error)
+	GetFavoriteFilms(userId uint64, sort string, order string, page pagination.Page) ([]models.FilmItem, string, error)
+	CountFavoriteFilms(userId uint64) (uint64, error)
 	AddFavoriteFilm(userId uint64, filmId uint64) error
 	RemoveFavoriteFilm(userId uint64, filmId uint64) error
 	CheckFilm(userId uint64, filmId uint64) (bool, error)
-	AddRating(filmId uint64, userId uint64, rating uint16) error
-	HasUsersRating(userId uint64, filmId uint64) (bool, error)
+	AddRating(filmId uint64, userId uint64, rating uint16) (bool, error)
+	UpdateRating(filmId uint64, userId uint64, rating uint16) (bool, error)
+	GetUserRating(userId uint64, filmId uint64) (*models.RatingItem, error)
 	AddFilm(film models.FilmItem) error
 	GetFilmId(title string) (uint64, error)
 	DeleteRating(idUser uint64, idFilm uint64) error
+	AddView(filmId uint64) error
 	Trends() ([]models.FilmItem, error)
 	GetLasts(ids []uint64) ([]models.FilmItem, error)
+	GetFavoriteFilmIds(userId uint64) ([]uint64, error)
+	GetUserRatings(userId uint64) ([]models.RatingItem, error)
+	DeleteUserData(userId uint64) error
 }
 
 type RepoPostgre struct {
@@ -74,56 +87,96 @@ func (repo *RepoPostgre) pingDb(timer uint32, lg *slog.Logger) {
 	}
 }
 
-func (repo *RepoPostgre) GetFilmsByGenre(genre uint64, start uint64, end uint64) ([]models.FilmItem, error) {
-	films := make([]models.FilmItem, 0, end-start)
+func (repo *RepoPostgre) GetFilmsByGenre(genre uint64, sort string, order string, page pagination.Page) ([]models.FilmItem, string, error) {
+	filmsOrder, err := filmOrder(sort, order)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilmsByGenre err: %w", err)
+	}
 
-	rows, err := repo.db.Query(
-		"SELECT film.id, film.title, poster FROM film "+
-			"JOIN films_genre ON film.id = films_genre.id_film "+
-			"WHERE id_genre = $1 "+
-			"ORDER BY release_date DESC "+
-			"OFFSET $2 LIMIT $3",
-		genre, start, end)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("GetFilmsByGenre err: %w", err)
+	var s strings.Builder
+	s.WriteString("SELECT film.id, film.title, poster, " + filmsOrder.Key() + " FROM film " +
+		"JOIN films_genre ON film.id = films_genre.id_film " + statsJoins +
+		"WHERE id_genre = $1 ")
+	params, err := page.Write(&s, filmsOrder, true, 2)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilmsByGenre err: %w", err)
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		post := models.FilmItem{}
-		err := rows.Scan(&post.Id, &post.Title, &post.Poster)
-		if err != nil {
-			return nil, fmt.Errorf("GetFilmsByGenre scan err: %w", err)
-		}
-		films = append(films, post)
+	films, next, err := repo.getFilmsPage(s.String(), filmsOrder, page, append([]interface{}{genre}, params...))
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilmsByGenre err: %w", err)
 	}
 
-	return films, nil
+	return films, next, nil
+}
+
+func (repo *RepoPostgre) GetFilms(sort string, order string, page pagination.Page) ([]models.FilmItem, string, error) {
+	filmsOrder, err := filmOrder(sort, order)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+	}
+
+	var s strings.Builder
+	s.WriteString("SELECT film.id, film.title, poster, " + filmsOrder.Key() + " FROM film " + statsJoins)
+	params, err := page.Write(&s, filmsOrder, false, 1)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+	}
+
+	films, next, err := repo.getFilmsPage(s.String(), filmsOrder, page, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+	}
+
+	return films, next, nil
 }
 
-func (repo *RepoPostgre) GetFilms(start uint64, end uint64) ([]models.FilmItem, error) {
-	films := make([]models.FilmItem, 0, end-start)
+// getFilmsPage selects the id, the title, the poster and the sort key of
+// the films of the page and returns them with the cursor of the next page.
+func (repo *RepoPostgre) getFilmsPage(query string, order pagination.Order, page pagination.Page, params []interface{}) ([]models.FilmItem, string, error) {
+	films := []models.FilmItem{}
 
-	rows, err := repo.db.Query(
-		"SELECT film.id, film.title, poster FROM film "+
-			"ORDER BY release_date DESC "+
-			"OFFSET $1 LIMIT $2",
-		start, end)
+	rows, err := repo.db.Query(query, params...)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("GetFilms err: %w", err)
+		return nil, "", fmt.Errorf("query err: %w", err)
 	}
 	defer rows.Close()
 
+	var next string
+	var lastKey []string
 	for rows.Next() {
 		post := models.FilmItem{}
-		err := rows.Scan(&post.Id, &post.Title, &post.Poster)
+		key, keyDest := order.KeyDest()
+		err := rows.Scan(append([]interface{}{&post.Id, &post.Title, &post.Poster}, keyDest...)...)
 		if err != nil {
-			return nil, fmt.Errorf("GetFilms scan err: %w", err)
+			return nil, "", fmt.Errorf("scan err: %w", err)
+		}
+		if page.Full(len(films)) {
+			next = pagination.Encode(lastKey)
+			break
 		}
 		films = append(films, post)
+		lastKey = key
 	}
 
-	return films, nil
+	return films, next, nil
+}
+
+// CountFilms counts the films of the genre, of all genres if it is 0.
+func (repo *RepoPostgre) CountFilms(genre uint64) (uint64, error) {
+	var count uint64
+	var err error
+	if genre == 0 {
+		err = repo.db.QueryRow("SELECT COUNT(*) FROM film").Scan(&count)
+	} else {
+		err = repo.db.QueryRow(
+			"SELECT COUNT(*) FROM films_genre WHERE id_genre = $1", genre).Scan(&count)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("CountFilms err: %w", err)
+	}
+
+	return count, nil
 }
 
 func (repo *RepoPostgre) GetFilm(filmId uint64) (*models.FilmItem, error) {
@@ -143,43 +196,50 @@ func (repo *RepoPostgre) GetFilm(filmId uint64) (*models.FilmItem, error) {
 	return film, nil
 }
 
-func (repo *RepoPostgre) GetFilmRating(filmId uint64) (float64, uint64, error) {
-	var rating sql.NullFloat64
-	var number sql.NullInt64
+func (repo *RepoPostgre) GetFilmRatingStats(filmId uint64) (*models.RatingStats, error) {
+	stats := &models.RatingStats{Histogram: make([]uint64, ratingstats.MaxRating)}
+
+	var histogram []int64
 	err := repo.db.QueryRow(
-		"SELECT AVG(rating), COUNT(rating) FROM users_comment "+
-			"WHERE id_film = $1", filmId).Scan(&rating, &number)
+		"SELECT rating_count, rating_sum, histogram FROM film_rating_stats "+
+			"WHERE id_film = $1", filmId).Scan(&stats.Count, &stats.Sum, pq.Array(&histogram))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return 0, 0, nil
+			return stats, nil
 		}
-		return 0, 0, fmt.Errorf("GetFilmRating err: %w", err)
+		return nil, fmt.Errorf("GetFilmRatingStats err: %w", err)
 	}
 
-	return rating.Float64, uint64(number.Int64), nil
-}
+	for i := 0; i < len(histogram) && i < len(stats.Histogram); i++ {
+		stats.Histogram[i] = uint64(histogram[i])
+	}
 
-func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-	mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
-) ([]models.FilmItem, error) {
+	return stats, nil
+}
 
-	films := []models.FilmItem{}
+// writeFilmFilter writes the joins and the conditions of a film search to
+// s and returns their params. The title is matched against the title and
+// info of the films with websearch_to_tsquery and as a prefix of the
+// words, or as a similar title; see writeFoundFilms for which of them are
+// found. With a title the query has the search.query column to match the
+// films by.
+func writeFilmFilter(s *strings.Builder, title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string,
+) []interface{} {
 	var hasWhere bool
 	paramNum := 1
 	var params []interface{}
-	var s strings.Builder
-	s.WriteString(
-		"SELECT DISTINCT film.title, film.id, film.poster, AVG(users_comment.rating) FROM film " +
-			"JOIN films_genre ON film.id = films_genre.id_film " +
-			"LEFT JOIN users_comment ON film.id = users_comment.id_film " +
-			"JOIN person_in_film ON film.id = person_in_film.id_film " +
-			"JOIN crew ON person_in_film.id_person = crew.id ")
-	if title != "" {
-		s.WriteString("WHERE ")
+	s.WriteString(" FROM film " +
+		"JOIN films_genre ON film.id = films_genre.id_film " +
+		statsJoins +
+		"JOIN person_in_film ON film.id = person_in_film.id_film " +
+		"JOIN crew ON person_in_film.id_person = crew.id ")
+	if strings.TrimSpace(title) != "" {
+		s.WriteString(searchJoin +
+			"WHERE (film.search_vector @@ search.query OR film.title % $1) ")
 		hasWhere = true
-		s.WriteString("fts @@ to_tsquery($" + strconv.Itoa(paramNum) + ") ")
-		paramNum++
-		params = append(params, title)
+		paramNum += 2
+		params = append(params, title, search.PrefixQuery(title))
 	}
 	if dateFrom != "" {
 		if !hasWhere {
@@ -226,9 +286,10 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 		paramNum++
 		params = append(params, pq.Array(genres))
 	}
-	if actors[0] != "" {
+	if len(actors) > 0 && actors[0] != "" {
 		if !hasWhere {
 			s.WriteString("WHERE ")
+			hasWhere = true
 		} else {
 			s.WriteString("AND ")
 		}
@@ -237,14 +298,87 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 		paramNum++
 		params = append(params, pq.Array(actors))
 	}
+	if !hasWhere {
+		s.WriteString("WHERE ")
+	} else {
+		s.WriteString("AND ")
+	}
 	s.WriteString(
-		"GROUP BY film.title, film.id " +
-			"HAVING (AVG(users_comment.rating) >= $" + strconv.Itoa(paramNum) + " AND AVG(users_comment.rating) <= $" + strconv.Itoa(paramNum+1) + ") " +
-			"OR AVG(users_comment.rating) IS NULL " +
-			"ORDER BY film.title " +
-			"LIMIT $" + strconv.Itoa(paramNum+2) + " OFFSET $" + strconv.Itoa(paramNum+3))
+		"(film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0) " +
+			"BETWEEN $" + strconv.Itoa(paramNum) + " AND $" + strconv.Itoa(paramNum+1) + " " +
+			"OR COALESCE(film_rating_stats.rating_count, 0) = 0) ")
+	params = append(params, ratingFrom, ratingTo)
+
+	return params
+}
+
+// writeFoundFilms writes a WITH clause to s that defines found, the ids of
+// the films found by the filters, see writeFilmFilter, and returns its
+// params. With a title the films matching it as a text search are found;
+// only if none of the filtered films does, the ones with a similar title
+// are found instead.
+func writeFoundFilms(s *strings.Builder, title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string,
+) []interface{} {
+	if strings.TrimSpace(title) == "" {
+		s.WriteString("WITH found AS (SELECT DISTINCT film.id")
+		params := writeFilmFilter(s, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+		s.WriteString(") ")
+		return params
+	}
+
+	s.WriteString("WITH candidates AS (SELECT DISTINCT film.id, film.search_vector @@ search.query AS matched")
+	params := writeFilmFilter(s, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+	s.WriteString("), found AS (SELECT id FROM candidates " +
+		"WHERE matched OR NOT EXISTS (SELECT 1 FROM candidates WHERE matched)) ")
+
+	return params
+}
+
+// FindFilm searches the films by the filters, see writeFoundFilms, in the
+// sort order. With a title the films get a snippet of the info with the
+// matched words highlighted, and the relevance sort orders them by the
+// relevance to it, without one it falls back to the title sort.
+func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string, sort string, order string, first uint64, limit uint64,
+) ([]models.FilmItem, error) {
 
-	params = append(params, ratingFrom, ratingTo, limit, first)
+	hasTitle := strings.TrimSpace(title) != ""
+	if sort == SortRelevance && !hasTitle {
+		sort = SortTitle
+	}
+	var filmsOrder pagination.Order
+	if sort != SortRelevance {
+		var err error
+		filmsOrder, err = filmOrder(sort, order)
+		if err != nil {
+			return nil, fmt.Errorf("find film err: %w", err)
+		}
+	}
+
+	films := []models.FilmItem{}
+	var s strings.Builder
+	params := writeFoundFilms(&s, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+	paramNum := len(params) + 1
+	s.WriteString("SELECT film.title, film.id, film.poster, " +
+		"film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0)")
+	if hasTitle {
+		s.WriteString(", ts_rank_cd(film.search_vector, search.query) + similarity(film.title, $1) AS rank, " +
+			"ts_headline(" + searchConfig + ", " + escapedInfo + ", search.query, " + headlineOptions + ")")
+	}
+	s.WriteString(" FROM found JOIN film ON film.id = found.id " + statsJoins)
+	if hasTitle {
+		s.WriteString(searchJoin)
+	}
+	s.WriteString("ORDER BY ")
+	if sort == SortRelevance {
+		s.WriteString("rank DESC, film.title")
+	} else {
+		s.WriteString(filmsOrder.By())
+	}
+	s.WriteString(" LIMIT $" + strconv.Itoa(paramNum) + " OFFSET $" + strconv.Itoa(paramNum+1))
+
+	params = append(params, limit, first)
 	rows, err := repo.db.Query(s.String(), params...)
 
 	if err != nil {
@@ -255,7 +389,13 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 	for rows.Next() {
 		post := models.FilmItem{}
 		ratingPost := sql.NullFloat64{}
-		err := rows.Scan(&post.Title, &post.Id, &post.Poster, &ratingPost)
+		dest := []interface{}{&post.Title, &post.Id, &post.Poster, &ratingPost}
+		if hasTitle {
+			// The rank is selected only to order by it.
+			var rank float64
+			dest = append(dest, &rank, &post.Snippet)
+		}
+		err := rows.Scan(dest...)
 		if err != nil {
 			return nil, fmt.Errorf("find film scan err: %w", err)
 		}
@@ -269,29 +409,101 @@ func (repo *RepoPostgre) FindFilm(title string, dateFrom string, dateTo string,
 	return films, nil
 }
 
-func (repo *RepoPostgre) GetFavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error) {
-	films := []models.FilmItem{}
+// FindFilmFacets counts the films found by the filters, see
+// writeFoundFilms, in total and by genre, mpaa, country, release decade
+// and rating band.
+func (repo *RepoPostgre) FindFilmFacets(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
+	mpaa string, genres []uint32, actors []string,
+) (uint64, *models.FilmFacets, error) {
+	var s strings.Builder
+	params := writeFoundFilms(&s, title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+	s.WriteString("SELECT 'total', 0, '', COUNT(*) FROM found " +
+		"UNION ALL SELECT 'genre', genre.id, genre.title, COUNT(*) FROM found " +
+		"JOIN films_genre ON films_genre.id_film = found.id JOIN genre ON genre.id = films_genre.id_genre " +
+		"GROUP BY genre.id, genre.title " +
+		"UNION ALL SELECT 'mpaa', 0, COALESCE(film.mpaa, ''), COUNT(*) FROM found " +
+		"JOIN film ON film.id = found.id GROUP BY 3 " +
+		"UNION ALL SELECT 'country', 0, COALESCE(film.country, ''), COUNT(*) FROM found " +
+		"JOIN film ON film.id = found.id GROUP BY 3 " +
+		"UNION ALL SELECT 'decade', 0, COALESCE((EXTRACT(DECADE FROM film.release_date)::INT * 10)::TEXT, ''), COUNT(*) " +
+		"FROM found JOIN film ON film.id = found.id GROUP BY 3 " +
+		"UNION ALL SELECT 'rating', 0, " +
+		"COALESCE(FLOOR(film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0))::INT::TEXT, 'none'), " +
+		"COUNT(*) FROM found LEFT JOIN film_rating_stats ON film_rating_stats.id_film = found.id GROUP BY 3 " +
+		"ORDER BY 1, 4 DESC, 3")
 
-	rows, err := repo.db.Query(
-		"SELECT film.title, film.id, film.poster FROM film "+
-			"JOIN users_favorite_film ON film.id = users_favorite_film.id_film "+
-			"WHERE id_user = $1 "+
-			"OFFSET $2 LIMIT $3", userId, start, end)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("get favorite films err: %w", err)
+	rows, err := repo.db.Query(s.String(), params...)
+	if err != nil {
+		return 0, nil, fmt.Errorf("find film facets err: %w", err)
 	}
 	defer rows.Close()
 
+	var total uint64
+	facets := &models.FilmFacets{
+		Genres:    []models.FacetBucket{},
+		Mpaa:      []models.FacetBucket{},
+		Countries: []models.FacetBucket{},
+		Decades:   []models.FacetBucket{},
+		Ratings:   []models.FacetBucket{},
+	}
 	for rows.Next() {
-		post := models.FilmItem{}
-		err := rows.Scan(&post.Title, &post.Id, &post.Poster)
+		var facet string
+		bucket := models.FacetBucket{}
+		err := rows.Scan(&facet, &bucket.Id, &bucket.Value, &bucket.Count)
 		if err != nil {
-			return nil, fmt.Errorf("get favorite films scan err: %w", err)
+			return 0, nil, fmt.Errorf("find film facets scan err: %w", err)
+		}
+		switch facet {
+		case "total":
+			total = bucket.Count
+		case "genre":
+			facets.Genres = append(facets.Genres, bucket)
+		case "mpaa":
+			facets.Mpaa = append(facets.Mpaa, bucket)
+		case "country":
+			facets.Countries = append(facets.Countries, bucket)
+		case "decade":
+			facets.Decades = append(facets.Decades, bucket)
+		case "rating":
+			facets.Ratings = append(facets.Ratings, bucket)
 		}
-		films = append(films, post)
 	}
 
-	return films, nil
+	return total, facets, nil
+}
+
+func (repo *RepoPostgre) GetFavoriteFilms(userId uint64, sort string, order string, page pagination.Page) ([]models.FilmItem, string, error) {
+	favoritesOrder, err := favoriteFilmOrder(sort, order)
+	if err != nil {
+		return nil, "", fmt.Errorf("get favorite films err: %w", err)
+	}
+
+	var s strings.Builder
+	s.WriteString("SELECT film.id, film.title, film.poster, " + favoritesOrder.Key() + " FROM film " +
+		"JOIN users_favorite_film ON film.id = users_favorite_film.id_film " + statsJoins +
+		"WHERE id_user = $1 ")
+	params, err := page.Write(&s, favoritesOrder, true, 2)
+	if err != nil {
+		return nil, "", fmt.Errorf("get favorite films err: %w", err)
+	}
+
+	films, next, err := repo.getFilmsPage(s.String(), favoritesOrder, page, append([]interface{}{userId}, params...))
+	if err != nil {
+		return nil, "", fmt.Errorf("get favorite films err: %w", err)
+	}
+
+	return films, next, nil
+}
+
+func (repo *RepoPostgre) CountFavoriteFilms(userId uint64) (uint64, error) {
+	var count uint64
+	err := repo.db.QueryRow(
+		"SELECT COUNT(*) FROM users_favorite_film WHERE id_user = $1", userId).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count favorite films err: %w", err)
+	}
+
+	return count, nil
 }
 
 func (repo *RepoPostgre) AddFavoriteFilm(userId uint64, filmId uint64) error {
@@ -329,33 +541,128 @@ func (repo *RepoPostgre) CheckFilm(userId uint64, filmId uint64) (bool, error) {
 	return true, nil
 }
 
-func (repo *RepoPostgre) AddRating(filmId uint64, userId uint64, rating uint16) error {
-	_, err := repo.db.Exec(
-		"INSERT INTO users_comment(id_film, rating, id_user) "+
-			"VALUES($1, $2, $3)", filmId, rating, userId)
+// ratingAttempts bounds the retries of AddRating when another request
+// rates the same film for the user at the same time.
+const ratingAttempts = 3
+
+// errRatingRace means the upsert replaced a rating the transaction did not
+// see, so the stats cannot be updated from it.
+var errRatingRace = errors.New("concurrent rating")
+
+// AddRating rates the film with a single upsert. If the user has already
+// rated it, the rating is replaced, the previous one is kept in the history
+// and true is returned.
+func (repo *RepoPostgre) AddRating(filmId uint64, userId uint64, rating uint16) (bool, error) {
+	var err error
+	for attempt := 0; attempt < ratingAttempts; attempt++ {
+		var replaced bool
+		replaced, err = repo.addRating(filmId, userId, rating)
+		if !errors.Is(err, errRatingRace) {
+			return replaced, err
+		}
+	}
+
+	return false, fmt.Errorf("add rating err: %w", err)
+}
+
+func (repo *RepoPostgre) addRating(filmId uint64, userId uint64, rating uint16) (bool, error) {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("add rating err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	var previous uint16
+	found := true
+	err = tx.QueryRow("SELECT rating FROM ratings "+
+		"WHERE id_user = $1 AND id_film = $2 FOR UPDATE", userId, filmId).Scan(&previous)
 	if err != nil {
-		return fmt.Errorf("AddComment: %w", err)
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("add rating err: %w", err)
+		}
+		found = false
 	}
 
-	return nil
+	var replaced bool
+	err = tx.QueryRow(
+		"INSERT INTO ratings(id_film, rating, id_user) VALUES($1, $2, $3) "+
+			"ON CONFLICT (id_user, id_film) DO UPDATE SET rating = EXCLUDED.rating, edited_at = CURRENT_TIMESTAMP "+
+			"RETURNING xmax <> 0", filmId, rating, userId).Scan(&replaced)
+	if err != nil {
+		return false, fmt.Errorf("add rating err: %w", err)
+	}
+
+	switch {
+	case replaced && !found:
+		return false, errRatingRace
+	case replaced:
+		err = ratingstats.Replaced(tx, filmId, userId, previous, rating)
+	default:
+		err = ratingstats.Add(tx, filmId, rating)
+	}
+	if err != nil {
+		return false, fmt.Errorf("add rating err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("add rating err: %w", err)
+	}
+
+	return replaced, nil
 }
 
-func (repo *RepoPostgre) HasUsersRating(userId uint64, filmId uint64) (bool, error) {
-	var id uint64
-	err := repo.db.QueryRow(
-		"SELECT id_user FROM users_comment "+
-			"WHERE id_user = $1 AND id_film = $2", userId, filmId).Scan(&id)
+// UpdateRating replaces the rating of the user to the film and keeps the
+// previous one in the history. It returns false if there was no rating.
+func (repo *RepoPostgre) UpdateRating(filmId uint64, userId uint64, rating uint16) (bool, error) {
+	tx, err := repo.db.Begin()
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("update rating err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = ratingstats.Update(tx, filmId, userId, rating)
+	if err != nil {
+		if errors.Is(err, ratingstats.ErrNoRating) {
 			return false, nil
 		}
+		return false, fmt.Errorf("update rating err: %w", err)
+	}
 
-		return false, fmt.Errorf("has users rating: %w", err)
+	err = tx.Commit()
+	if err != nil {
+		return false, fmt.Errorf("update rating err: %w", err)
 	}
 
 	return true, nil
 }
 
+// GetUserRating returns the rating of the user to the film, or nil if the
+// user has not rated it.
+func (repo *RepoPostgre) GetUserRating(userId uint64, filmId uint64) (*models.RatingItem, error) {
+	rating := &models.RatingItem{IdUser: userId, IdFilm: filmId}
+	var editedAt sql.NullTime
+	err := repo.db.QueryRow(
+		"SELECT rating, edited_at FROM ratings "+
+			"WHERE id_user = $1 AND id_film = $2", userId, filmId).Scan(&rating.Rating, &editedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("get user rating err: %w", err)
+	}
+	if editedAt.Valid {
+		rating.EditedAt = editedAt.Time.Format(time.RFC3339)
+	}
+
+	return rating, nil
+}
+
 func (repo *RepoPostgre) AddFilm(film models.FilmItem) error {
 	_, err := repo.db.Exec("INSERT INTO film(title, info, poster, release_date, country, mpaa) "+
 		"VALUES($1, $2, $3, $4, $5, $6)",
@@ -377,8 +684,34 @@ func (repo *RepoPostgre) GetFilmId(title string) (uint64, error) {
 	return id, nil
 }
 
+// AddView counts one more user who has seen the film.
+func (repo *RepoPostgre) AddView(filmId uint64) error {
+	_, err := repo.db.Exec(
+		"INSERT INTO film_views(id_film, views) VALUES ($1, 1) "+
+			"ON CONFLICT (id_film) DO UPDATE SET views = film_views.views + 1", filmId)
+	if err != nil {
+		return fmt.Errorf("add view err: %w", err)
+	}
+
+	return nil
+}
+
 func (repo *RepoPostgre) DeleteRating(idUser uint64, idFilm uint64) error {
-	_, err := repo.db.Exec("DELETE FROM users_comment WHERE id_user = $1 AND id_film = $2", idUser, idFilm)
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return fmt.Errorf("delete rating err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = ratingstats.Delete(tx, "DELETE FROM ratings WHERE id_user = $1 AND id_film = $2 "+
+		"RETURNING id_film, rating", idUser, idFilm)
+	if err != nil {
+		return fmt.Errorf("delete rating err: %w", err)
+	}
+
+	err = tx.Commit()
 	if err != nil {
 		return fmt.Errorf("delete rating err: %w", err)
 	}
@@ -389,10 +722,10 @@ func (repo *RepoPostgre) Trends() ([]models.FilmItem, error) {
 	trends := []models.FilmItem{}
 
 	rows, err := repo.db.Query("SELECT film.id, film.title, film.poster FROM film " +
-		"JOIN users_comment ON film.id = users_comment.id_film " +
-		"WHERE users_comment.date > (CURRENT_TIMESTAMP - interval'48 hours') " +
+		"JOIN ratings ON film.id = ratings.id_film " +
+		"WHERE ratings.date > (CURRENT_TIMESTAMP - interval'48 hours') " +
 		"GROUP BY film.title, film.id, film.poster " +
-		"ORDER BY COUNT(users_comment.id_film) DESC " +
+		"ORDER BY COUNT(ratings.id_film) DESC " +
 		"LIMIT 5")
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, fmt.Errorf("trends err: %w", err)
@@ -434,3 +767,80 @@ func (repo *RepoPostgre) GetLasts(ids []uint64) ([]models.FilmItem, error) {
 
 	return films, nil
 }
+
+func (repo *RepoPostgre) GetFavoriteFilmIds(userId uint64) ([]uint64, error) {
+	ids := []uint64{}
+
+	rows, err := repo.db.Query("SELECT id_film FROM users_favorite_film WHERE id_user = $1", userId)
+	if err != nil {
+		return nil, fmt.Errorf("get favorite film ids err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		err := rows.Scan(&id)
+		if err != nil {
+			return nil, fmt.Errorf("get favorite film ids scan err: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (repo *RepoPostgre) GetUserRatings(userId uint64) ([]models.RatingItem, error) {
+	ratings := []models.RatingItem{}
+
+	rows, err := repo.db.Query("SELECT id_film, rating FROM ratings WHERE id_user = $1", userId)
+	if err != nil {
+		return nil, fmt.Errorf("get user ratings err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		post := models.RatingItem{IdUser: userId}
+		err := rows.Scan(&post.IdFilm, &post.Rating)
+		if err != nil {
+			return nil, fmt.Errorf("get user ratings scan err: %w", err)
+		}
+		ratings = append(ratings, post)
+	}
+
+	return ratings, nil
+}
+
+// DeleteUserData deletes everything the films database keeps about the
+// user: favorite films and actors, ratings and their history.
+func (repo *RepoPostgre) DeleteUserData(userId uint64) error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, query := range []string{
+		"DELETE FROM users_favorite_film WHERE id_user = $1",
+		"DELETE FROM users_favorite_actor WHERE id_user = $1",
+		"DELETE FROM ratings_history WHERE id_user = $1",
+	} {
+		_, err = tx.Exec(query, userId)
+		if err != nil {
+			return fmt.Errorf("delete user data err: %w", err)
+		}
+	}
+
+	err = ratingstats.Delete(tx, "DELETE FROM ratings WHERE id_user = $1 RETURNING id_film, rating", userId)
+	if err != nil {
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package film
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 )
 
 func TestGetFilmsByGenre(t *testing.T) {
@@ -17,26 +20,31 @@ func TestGetFilmsByGenre(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster"})
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster", "ReleaseDate", "Id"}).
+		AddRow(2, "t2", "url2", "2023-01-01", "2").
+		AddRow(1, "t1", "url1", "2022-01-01", "1")
 
 	expect := []models.FilmItem{
-		{Id: 1, Title: "t1", Poster: "url1"},
-	}
-
-	for _, item := range expect {
-		rows = rows.AddRow(item.Id, item.Title, item.Poster)
+		{Id: 2, Title: "t2", Poster: "url2"},
 	}
+	selectRow := "SELECT film.id, film.title, poster, film.release_date::TEXT, film.id::TEXT FROM film " +
+		"JOIN films_genre ON film.id = films_genre.id_film " +
+		"LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"WHERE id_genre = $1 " +
+		"AND (film.release_date, film.id) < ($2::DATE, $3::BIGINT) " +
+		"ORDER BY film.release_date DESC, film.id DESC LIMIT $4 OFFSET $5"
 
 	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT film.id, film.title, poster FROM film  JOIN films_genre ON film.id = films_genre.id_film WHERE id_genre = $1 ORDER BY release_date DESC OFFSET $2 LIMIT $3")).
-		WithArgs(1, 1, 2).
+		regexp.QuoteMeta(selectRow)).
+		WithArgs(1, "2024-01-01", "3", 2, 0).
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	films, err := repo.GetFilmsByGenre(1, 1, 2)
+	page := pagination.Page{After: []string{"2024-01-01", "3"}, Limit: 1}
+	films, next, err := repo.GetFilmsByGenre(1, SortReleaseDate, "", page)
 	if err != nil {
 		t.Errorf("GetFilmsByGenre error: %s", err)
 	}
@@ -50,13 +58,17 @@ func TestGetFilmsByGenre(t *testing.T) {
 		t.Errorf("results not match, want %v, have %v", expect, films)
 		return
 	}
+	if want := pagination.Encode([]string{"2023-01-01", "2"}); next != want {
+		t.Errorf("results not match, want %v, have %v", want, next)
+		return
+	}
 
 	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT film.id, film.title, poster FROM film  JOIN films_genre ON film.id = films_genre.id_film WHERE id_genre = $1 ORDER BY release_date DESC OFFSET $2 LIMIT $3")).
-		WithArgs(1, 1, 2).
+		regexp.QuoteMeta(selectRow)).
+		WithArgs(1, "2024-01-01", "3", 2, 0).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, err = repo.GetFilmsByGenre(1, 1, 2)
+	_, _, err = repo.GetFilmsByGenre(1, SortReleaseDate, "", page)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -65,6 +77,11 @@ func TestGetFilmsByGenre(t *testing.T) {
 		t.Errorf("expected error, got nil")
 		return
 	}
+
+	_, _, err = repo.GetFilmsByGenre(1, SortReleaseDate, "", pagination.Page{After: []string{"3"}, Limit: 1})
+	if !errors.Is(err, pagination.ErrBadCursor) {
+		t.Errorf("results not match, want %v, have %v", pagination.ErrBadCursor, err)
+	}
 }
 
 func TestGetFilms(t *testing.T) {
@@ -74,23 +91,26 @@ func TestGetFilms(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster"})
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster", "ReleaseDate", "Id"})
 
 	expect := []models.FilmItem{
 		{Id: 1, Title: "t1", Poster: "url1"},
 	}
 
 	for _, item := range expect {
-		rows = rows.AddRow(item.Id, item.Title, item.Poster)
+		rows = rows.AddRow(item.Id, item.Title, item.Poster, "2023-01-01", "1")
 	}
+	selectRow := "SELECT film.id, film.title, poster, film.release_date::TEXT, film.id::TEXT FROM film " +
+		"LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"ORDER BY film.release_date DESC, film.id DESC LIMIT $1 OFFSET $2"
 
-	mock.ExpectQuery("SELECT film.id, film.title, poster FROM film").WithArgs(1, 2).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).WithArgs(3, 2).WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	films, err := repo.GetFilms(1, 2)
+	films, next, err := repo.GetFilms(SortReleaseDate, OrderDesc, pagination.Page{Offset: 2, Limit: 2})
 	if err != nil {
 		t.Errorf("GetFilms error: %s", err)
 	}
@@ -104,21 +124,73 @@ func TestGetFilms(t *testing.T) {
 		t.Errorf("results not match, want %v, have %v", expect, films)
 		return
 	}
+	if next != "" {
+		t.Errorf("results not match, want %v, have %v", "", next)
+		return
+	}
 
 	mock.
-		ExpectQuery("SELECT film.id, film.title, poster FROM film").
-		WithArgs(1, 2).
+		ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(3, 2).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, err = repo.GetFilms(1, 2)
+	_, _, err = repo.GetFilms(SortReleaseDate, OrderDesc, pagination.Page{Offset: 2, Limit: 2})
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
 	}
 	if err == nil {
 		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestCountFilms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM film")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(10))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM films_genre WHERE id_genre = $1")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM films_genre WHERE id_genre = $1")).
+		WithArgs(2).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	count, err := repo.CountFilms(0)
+	if err != nil {
+		t.Errorf("CountFilms error: %s", err)
+	}
+	if count != 10 {
+		t.Errorf("results not match, want %v, have %v", 10, count)
+		return
+	}
+
+	count, err = repo.CountFilms(1)
+	if err != nil {
+		t.Errorf("CountFilms error: %s", err)
+	}
+	if count != 4 {
+		t.Errorf("results not match, want %v, have %v", 4, count)
 		return
 	}
+
+	_, err = repo.CountFilms(2)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
 }
 
 func TestGetFilm(t *testing.T) {
@@ -178,66 +250,62 @@ func TestGetFilm(t *testing.T) {
 	}
 }
 
-func TestGetFilmRating(t *testing.T) {
+func TestGetFilmRatingStats(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Average", "Amount"})
-
-	expectRating := 4.2
-	expectAmount := uint64(3)
+	query := regexp.QuoteMeta("SELECT rating_count, rating_sum, histogram FROM film_rating_stats WHERE id_film = $1")
 
-	rows = rows.AddRow(expectRating, expectAmount)
-
-	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT AVG(rating), COUNT(rating) FROM users_comment WHERE id_film")).
+	mock.ExpectQuery(query).
 		WithArgs(1).
-		WillReturnRows(rows)
+		WillReturnRows(sqlmock.NewRows([]string{"rating_count", "rating_sum", "histogram"}).
+			AddRow(3, 25, "{0,0,0,0,0,0,0,1,1,1}"))
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	rating, number, err := repo.GetFilmRating(1)
+	stats, err := repo.GetFilmRatingStats(1)
 	if err != nil {
-		t.Errorf("GetFilm error: %s", err)
+		t.Errorf("unexpected error: %s", err)
+		return
 	}
-
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
+	expect := &models.RatingStats{Count: 3, Sum: 25, Histogram: []uint64{0, 0, 0, 0, 0, 0, 0, 1, 1, 1}}
+	if !reflect.DeepEqual(stats, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, stats)
 		return
 	}
 
-	if rating != expectRating {
-		t.Errorf("results not match, want %v, have %v", expectRating, rating)
+	mock.ExpectQuery(query).
+		WithArgs(2).
+		WillReturnError(sql.ErrNoRows)
+
+	stats, err = repo.GetFilmRatingStats(2)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
 		return
 	}
-	if number != expectAmount {
-		t.Errorf("results not match, want %v, have %v", expectAmount, number)
+	if stats.Count != 0 || stats.Average() != 0 || len(stats.Histogram) != 10 {
+		t.Errorf("wanted empty stats, have %v", stats)
+		return
 	}
 
-	mock.ExpectQuery(
-		regexp.QuoteMeta("SELECT AVG(rating), COUNT(rating) FROM users_comment WHERE id_film")).
+	mock.ExpectQuery(query).
 		WithArgs(1).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	rating, number, err = repo.GetFilmRating(1)
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
-		return
-	}
+	_, err = repo.GetFilmRatingStats(1)
 	if err == nil {
 		t.Errorf("expected error, got nil")
 		return
 	}
-	if rating != 0 {
-		t.Errorf("expected rating 0, got %f", rating)
-	}
-	if number != 0 {
-		t.Errorf("expected number 0, got %d", number)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
 	}
 }
 
@@ -259,7 +327,8 @@ func TestFindFilm(t *testing.T) {
 		rows = rows.AddRow(item.Title, item.Id, item.Poster, expectRating[0])
 	}
 
-	selectStr := "SELECT DISTINCT film.title, film.id, film.poster, AVG(users_comment.rating) FROM film JOIN films_genre ON film.id = films_genre.id_film LEFT JOIN users_comment ON film.id = users_comment.id_film JOIN person_in_film ON film.id = person_in_film.id_film JOIN crew ON person_in_film.id_person = crew.id GROUP BY film.title, film.id HAVING (AVG(users_comment.rating) >= $1 AND AVG(users_comment.rating) <= $2) OR AVG(users_comment.rating) IS NULL ORDER BY film.title LIMIT $3 OFFSET $4"
+	selectStr := "WITH found AS (SELECT DISTINCT film.id FROM film JOIN films_genre ON film.id = films_genre.id_film LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film JOIN person_in_film ON film.id = person_in_film.id_film JOIN crew ON person_in_film.id_person = crew.id WHERE (film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0) BETWEEN $1 AND $2 OR COALESCE(film_rating_stats.rating_count, 0) = 0) ) " +
+		"SELECT film.title, film.id, film.poster, film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0) FROM found JOIN film ON film.id = found.id LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film ORDER BY film.title ASC, film.id ASC LIMIT $3 OFFSET $4"
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectStr)).
 		WithArgs(float32(0), float32(10), uint64(1), uint64(0)).
@@ -269,7 +338,7 @@ func TestFindFilm(t *testing.T) {
 		db: db,
 	}
 
-	film, err := repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{}, []string{""}, 0, 1)
+	film, err := repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{}, []string{""}, SortTitle, "", 0, 1)
 	if err != nil {
 		t.Errorf("GetFilm error: %s", err)
 	}
@@ -288,7 +357,7 @@ func TestFindFilm(t *testing.T) {
 		WithArgs(float32(0), float32(10), uint64(0), uint64(0)).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	film, err = repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{0}, []string{""}, 0, 0)
+	film, err = repo.FindFilm("", "", "", float32(0), float32(10), "", []uint32{0}, []string{""}, SortTitle, "", 0, 0)
 	if err == mock.ExpectationsWereMet() {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -303,94 +372,162 @@ func TestFindFilm(t *testing.T) {
 	}
 }
 
-func TestGetFavoriteFilms(t *testing.T) {
+func TestFindFilmSearch(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Title", "Id", "Poster"})
+	rows := sqlmock.NewRows([]string{"Title", "Id", "Poster", "Rating", "Rank", "Snippet"}).
+		AddRow("Star Wars", 1, "url1", 8, 0.9, "<b>Star</b> <b>Wars</b>")
 
 	expect := []models.FilmItem{
-		{Id: 1, Title: "t1", Poster: "url1"},
+		{Id: 1, Title: "Star Wars", Poster: "url1", Rating: 8, Snippet: "<b>Star</b> <b>Wars</b>"},
+	}
+
+	selectStr := "WITH candidates AS (SELECT DISTINCT film.id, film.search_vector @@ search.query AS matched " +
+		"FROM film JOIN films_genre ON film.id = films_genre.id_film LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film " +
+		"LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"JOIN person_in_film ON film.id = person_in_film.id_film JOIN crew ON person_in_film.id_person = crew.id " +
+		"CROSS JOIN (SELECT websearch_to_tsquery('russian', $1) || to_tsquery('russian', $2) AS query) AS search " +
+		"WHERE (film.search_vector @@ search.query OR film.title % $1) " +
+		"AND mpaa = $3 " +
+		"AND (film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0) BETWEEN $4 AND $5 OR COALESCE(film_rating_stats.rating_count, 0) = 0) ), " +
+		"found AS (SELECT id FROM candidates WHERE matched OR NOT EXISTS (SELECT 1 FROM candidates WHERE matched)) " +
+		"SELECT film.title, film.id, film.poster, film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0), " +
+		"ts_rank_cd(film.search_vector, search.query) + similarity(film.title, $1) AS rank, " +
+		"ts_headline('russian', replace(replace(replace(replace(replace(film.info, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '\"', '&quot;'), '''', '&#39;'), " +
+		"search.query, 'StartSel=<b>, StopSel=</b>, MaxFragments=1, MaxWords=30, MinWords=10') " +
+		"FROM found JOIN film ON film.id = found.id LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film " +
+		"LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"CROSS JOIN (SELECT websearch_to_tsquery('russian', $1) || to_tsquery('russian', $2) AS query) AS search " +
+		"ORDER BY rank DESC, film.title LIMIT $6 OFFSET $7"
+	mock.ExpectQuery(regexp.QuoteMeta(selectStr)).
+		WithArgs("star wa", "star:* & wa:*", "PG-13", float32(0), float32(10), uint64(5), uint64(0)).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
 	}
 
-	for _, item := range expect {
-		rows = rows.AddRow(item.Title, item.Id, item.Poster)
+	films, err := repo.FindFilm("star wa", "", "", float32(0), float32(10), "PG-13", []uint32{}, []string{""}, SortRelevance, "", 0, 5)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+		return
 	}
-	selectRow := "SELECT film.title, film.id, film.poster FROM film JOIN users_favorite_film ON film.id = users_favorite_film.id_film WHERE id_user = $1 OFFSET $2 LIMIT $3"
 
-	mock.ExpectQuery(
-		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1, 2).
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestFindFilmFacets(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Facet", "Id", "Value", "Count"}).
+		AddRow("country", 0, "США", 2).
+		AddRow("decade", 0, "1990", 3).
+		AddRow("genre", 1, "драма", 3).
+		AddRow("mpaa", 0, "R", 3).
+		AddRow("rating", 0, "8", 2).
+		AddRow("rating", 0, "none", 1).
+		AddRow("total", 0, "", 3)
+
+	expectTotal := uint64(3)
+	expect := &models.FilmFacets{
+		Genres:    []models.FacetBucket{{Id: 1, Value: "драма", Count: 3}},
+		Mpaa:      []models.FacetBucket{{Value: "R", Count: 3}},
+		Countries: []models.FacetBucket{{Value: "США", Count: 2}},
+		Decades:   []models.FacetBucket{{Value: "1990", Count: 3}},
+		Ratings:   []models.FacetBucket{{Value: "8", Count: 2}, {Value: "none", Count: 1}},
+	}
+
+	selectStr := "WITH found AS (SELECT DISTINCT film.id FROM film JOIN films_genre ON film.id = films_genre.id_film " +
+		"LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"JOIN person_in_film ON film.id = person_in_film.id_film JOIN crew ON person_in_film.id_person = crew.id " +
+		"WHERE mpaa = $1 " +
+		"AND (film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0) BETWEEN $2 AND $3 OR COALESCE(film_rating_stats.rating_count, 0) = 0) ) " +
+		"SELECT 'total', 0, '', COUNT(*) FROM found UNION ALL SELECT 'genre', genre.id, genre.title, COUNT(*) FROM found"
+	mock.ExpectQuery(regexp.QuoteMeta(selectStr)).
+		WithArgs("R", float32(0), float32(10)).
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	films, err := repo.GetFavoriteFilms(1, 1, 2)
+	total, facets, err := repo.FindFilmFacets("", "", "", float32(0), float32(10), "R", nil, nil)
 	if err != nil {
-		t.Errorf("GetFilmsByGenre error: %s", err)
+		t.Errorf("unexpected error: %s", err)
+		return
 	}
-
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
+	if total != expectTotal {
+		t.Errorf("results not match, want %v, have %v", expectTotal, total)
 		return
 	}
-
-	if !reflect.DeepEqual(films, expect) {
-		t.Errorf("results not match, want %v, have %v", expect, films)
+	if !reflect.DeepEqual(facets, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, facets)
 		return
 	}
 
-	mock.ExpectQuery(
-		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1, 2).
+	mock.ExpectQuery(regexp.QuoteMeta(selectStr)).
+		WithArgs("R", float32(0), float32(10)).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	_, err = repo.GetFavoriteFilms(1, 1, 2)
-	if err := mock.ExpectationsWereMet(); err != nil {
-		t.Errorf("there were unfulfilled expectations: %s", err)
-		return
-	}
+	_, _, err = repo.FindFilmFacets("", "", "", float32(0), float32(10), "R", nil, nil)
 	if err == nil {
 		t.Errorf("expected error, got nil")
 		return
 	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
 }
 
-func TestCheckFilm(t *testing.T) {
+func TestGetFavoriteFilms(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"Id"})
+	rows := sqlmock.NewRows([]string{"Id", "Title", "Poster", "AddedAt", "Id"})
 
 	expect := []models.FilmItem{
-		{Id: 1},
+		{Id: 1, Title: "t1", Poster: "url1"},
 	}
 
 	for _, item := range expect {
-		rows = rows.AddRow(item.Id)
+		rows = rows.AddRow(item.Id, item.Title, item.Poster, "2023-11-01 00:00:00", "1")
 	}
-	selectRow := "SELECT id_film FROM users_favorite_film WHERE id_film = $1 AND id_user = $2"
+	selectRow := "SELECT film.id, film.title, film.poster, users_favorite_film.added_at::TEXT, film.id::TEXT FROM film " +
+		"JOIN users_favorite_film ON film.id = users_favorite_film.id_film " +
+		"LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film LEFT JOIN film_views ON film.id = film_views.id_film " +
+		"WHERE id_user = $1 " +
+		"ORDER BY users_favorite_film.added_at DESC, film.id DESC LIMIT $2 OFFSET $3"
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1).
+		WithArgs(1, 3, 1).
 		WillReturnRows(rows)
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	found, err := repo.CheckFilm(1, 1)
+	films, next, err := repo.GetFavoriteFilms(1, SortRecentlyAdded, "", pagination.Page{Offset: 1, Limit: 2})
 	if err != nil {
-		t.Errorf("GetFilmsByGenre error: %s", err)
+		t.Errorf("GetFavoriteFilms error: %s", err)
 	}
 
 	if err := mock.ExpectationsWereMet(); err != nil {
@@ -398,17 +535,21 @@ func TestCheckFilm(t *testing.T) {
 		return
 	}
 
-	if !found {
-		t.Errorf("expected found")
+	if !reflect.DeepEqual(films, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, films)
+		return
+	}
+	if next != "" {
+		t.Errorf("results not match, want %v, have %v", "", next)
 		return
 	}
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1).
+		WithArgs(1, 3, 1).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	found, err = repo.CheckFilm(1, 1)
+	_, _, err = repo.GetFavoriteFilms(1, SortRecentlyAdded, "", pagination.Page{Offset: 1, Limit: 2})
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -417,13 +558,9 @@ func TestCheckFilm(t *testing.T) {
 		t.Errorf("expected error, got nil")
 		return
 	}
-	if found {
-		t.Errorf("expected not found")
-		return
-	}
 }
 
-func TestHasUsersRating(t *testing.T) {
+func TestCheckFilm(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("cant create mock: %s", err)
@@ -439,7 +576,7 @@ func TestHasUsersRating(t *testing.T) {
 	for _, item := range expect {
 		rows = rows.AddRow(item.Id)
 	}
-	selectRow := "SELECT id_user FROM users_comment WHERE id_user = $1 AND id_film = $2"
+	selectRow := "SELECT id_film FROM users_favorite_film WHERE id_film = $1 AND id_user = $2"
 
 	mock.ExpectQuery(
 		regexp.QuoteMeta(selectRow)).
@@ -450,7 +587,7 @@ func TestHasUsersRating(t *testing.T) {
 		db: db,
 	}
 
-	found, err := repo.HasUsersRating(1, 1)
+	found, err := repo.CheckFilm(1, 1)
 	if err != nil {
 		t.Errorf("GetFilmsByGenre error: %s", err)
 	}
@@ -470,7 +607,7 @@ func TestHasUsersRating(t *testing.T) {
 		WithArgs(1, 1).
 		WillReturnError(fmt.Errorf("db_error"))
 
-	found, err = repo.HasUsersRating(1, 1)
+	found, err = repo.CheckFilm(1, 1)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -576,31 +713,85 @@ func TestAddRating(t *testing.T) {
 	}
 	defer db.Close()
 
-	selectRow := "INSERT INTO users_comment(id_film, rating, id_user) VALUES($1, $2, $3)"
-
-	mock.ExpectExec(
-		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 5, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	selectRow := "SELECT rating FROM ratings WHERE id_user = $1 AND id_film = $2 FOR UPDATE"
+	upsert := "INSERT INTO ratings(id_film, rating, id_user) VALUES($1, $2, $3) " +
+		"ON CONFLICT (id_user, id_film) DO UPDATE SET rating = EXCLUDED.rating, edited_at = CURRENT_TIMESTAMP " +
+		"RETURNING xmax <> 0"
 
 	repo := &RepoPostgre{
 		db: db,
 	}
 
-	err = repo.AddRating(1, 1, 5)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta(upsert)).
+		WithArgs(1, 5, 1).WillReturnRows(sqlmock.NewRows([]string{"updated"}).AddRow(false))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(1, 1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	replaced, err := repo.AddRating(1, 1, 5)
 	if err != nil {
 		t.Errorf("unexpected err: %s", err)
 	}
+	if replaced {
+		t.Errorf("expected a new rating")
+		return
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
 
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"rating"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta(upsert)).
+		WithArgs(1, 5, 1).WillReturnRows(sqlmock.NewRows([]string{"updated"}).AddRow(true))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO ratings_history(id_user, id_film, rating) VALUES ($1, $2, $3)")).
+		WithArgs(1, 1, 4).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(1, -1, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(1, 1, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	replaced, err = repo.AddRating(1, 1, 5)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+	}
+	if !replaced {
+		t.Errorf("expected the rating to be replaced")
+		return
+	}
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
 	}
 
-	mock.ExpectExec(
-		regexp.QuoteMeta(selectRow)).
-		WithArgs(1, 1, 5).WillReturnError(fmt.Errorf("repo err"))
+	// The first rating of another request won the race: the upsert
+	// updated a row the select did not see, so the rating is retried.
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 1).WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(regexp.QuoteMeta(upsert)).
+		WithArgs(1, 5, 1).WillReturnRows(sqlmock.NewRows([]string{"updated"}).AddRow(true))
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"rating"}).AddRow(4))
+	mock.ExpectQuery(regexp.QuoteMeta(upsert)).
+		WithArgs(1, 5, 1).WillReturnError(fmt.Errorf("repo err"))
+	mock.ExpectRollback()
 
-	err = repo.AddRating(1, 5, 1)
+	_, err = repo.AddRating(1, 1, 5)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 		return
@@ -611,6 +802,114 @@ func TestAddRating(t *testing.T) {
 	}
 }
 
+func TestDeleteRating(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM ratings WHERE id_user = $1 AND id_film = $2 RETURNING id_film, rating")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id_film", "rating"}).AddRow(2, 7))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(2, -1, 7).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.DeleteRating(1, 2)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM ratings WHERE id_user = $1 AND id_film = $2 RETURNING id_film, rating")).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id_film", "rating"}))
+	mock.ExpectCommit()
+
+	err = repo.DeleteRating(1, 3)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
+func TestUpdateRating(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	selectRow := "SELECT rating FROM ratings WHERE id_user = $1 AND id_film = $2 FOR UPDATE"
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE ratings SET rating = $3, edited_at = CURRENT_TIMESTAMP")).
+		WithArgs(1, 2, 9).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO ratings_history(id_user, id_film, rating) VALUES ($1, $2, $3)")).
+		WithArgs(1, 2, 4).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(2, -1, 4).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING")).
+		WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER")).
+		WithArgs(2, 1, 9).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	found, err := repo.UpdateRating(2, 1, 9)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+		return
+	}
+	if !found {
+		t.Errorf("wanted rating to be found")
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"rating"}))
+	mock.ExpectRollback()
+
+	found, err = repo.UpdateRating(3, 1, 9)
+	if err != nil {
+		t.Errorf("unexpected err: %s", err)
+		return
+	}
+	if found {
+		t.Errorf("wanted rating not to be found")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+		return
+	}
+}
+
 func TestAddFilm(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -2,6 +2,7 @@ package film
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"strconv"
 	"sync"
@@ -15,8 +16,8 @@ import (
 var mutex sync.RWMutex
 
 type FilmRedisRepo struct {
-	filmRedisClient    *redis.Client
-	Connection         bool
+	filmRedisClient *redis.Client
+	Connection      bool
 }
 
 func (redisRepo *FilmRedisRepo) CheckRedisNearFilmConnection(NearFilmCfg configs.DbRedisCfg) {
@@ -46,8 +47,8 @@ func GetFilmRedisRepo(NearFilmCfg configs.DbRedisCfg, lg *slog.Logger) (*FilmRed
 	}
 
 	FilmRedisRepo := FilmRedisRepo{
-		filmRedisClient:    redisClient,
-		Connection:         true,
+		filmRedisClient: redisClient,
+		Connection:      true,
 	}
 
 	go FilmRedisRepo.CheckRedisNearFilmConnection(NearFilmCfg)
@@ -82,7 +83,7 @@ func (redisRepo *FilmRedisRepo) CheckActiveNearFilm(ctx context.Context, uid str
 
 	exists, err := redisRepo.filmRedisClient.HExists(ctx, "nearfilms:"+uid, fid).Result()
 	if err != nil {
-		lg.Error("HExists request could not be completed ", err)
+		lg.Error("HExists request could not be completed", "err", err.Error())
 		return false, err
 	}
 
@@ -97,7 +98,7 @@ func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg
 
 	result, err := redisRepo.filmRedisClient.HGetAll(ctx, "nearfilms:"+uid).Result()
 	if err != nil {
-		lg.Error("HGetAll request could not be completed:", err)
+		lg.Error("HGetAll request could not be completed", "err", err.Error())
 		return nil, err
 	}
 
@@ -105,13 +106,13 @@ func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg
 	for idFilmStr := range result {
 		idFilm, err := strconv.ParseUint(idFilmStr, 10, 64)
 		if err != nil {
-			lg.Error("Error parsing IdFilm:", err)
+			lg.Error("Error parsing IdFilm", "err", err.Error())
 			continue
 		}
 
 		idUser, err := strconv.ParseUint(uid, 10, 64)
 		if err != nil {
-			lg.Error("Error parsing IdUser:", err)
+			lg.Error("Error parsing IdUser", "err", err.Error())
 			continue
 		}
 
@@ -125,11 +126,10 @@ func (redisRepo *FilmRedisRepo) GetNearFilms(ctx context.Context, uid string, lg
 	return nearFilms, nil
 }
 
-
 func (redisRepo *FilmRedisRepo) DeleteNearFilm(ctx context.Context, uid string, fid string, lg *slog.Logger) (bool, error) {
 	deletedCount, err := redisRepo.filmRedisClient.HDel(ctx, "nearfilms:"+uid, fid).Result()
 	if err != nil {
-		lg.Error("HDEL request could not be completed:", err)
+		lg.Error("HDEL request could not be completed", "err", err.Error())
 		return false, err
 	}
 
@@ -139,3 +139,19 @@ func (redisRepo *FilmRedisRepo) DeleteNearFilm(ctx context.Context, uid string,
 
 	return true, nil
 }
+
+// DeleteNearFilms forgets all the films the user has seen.
+func (redisRepo *FilmRedisRepo) DeleteNearFilms(ctx context.Context, uid string, lg *slog.Logger) error {
+	if !redisRepo.Connection {
+		lg.Error("Redis NearFilm connection lost")
+		return fmt.Errorf("delete near films err: connection lost")
+	}
+
+	_, err := redisRepo.filmRedisClient.Del(ctx, "nearfilms:"+uid).Result()
+	if err != nil {
+		lg.Error("DEL request could not be completed", "err", err.Error())
+		return fmt.Errorf("delete near films err: %w", err)
+	}
+
+	return nil
+}
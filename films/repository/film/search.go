@@ -0,0 +1,18 @@
+package film
+
+// searchConfig is the text search configuration of film.search_vector.
+const searchConfig = "'russian'"
+
+// headlineOptions mark the matched words in the snippet of the film info.
+const headlineOptions = "'StartSel=<b>, StopSel=</b>, MaxFragments=1, MaxWords=30, MinWords=10'"
+
+// escapedInfo is the film info with the HTML special characters escaped.
+// The snippet is built from it, so that the marks of headlineOptions are
+// the only markup in it.
+const escapedInfo = "replace(replace(replace(replace(replace(film.info, " +
+	"'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '\"', '&quot;'), '''', '&#39;')"
+
+// searchJoin adds search.query, the title of a search as a text search
+// query and as a prefix of the words, given as $1 and $2.
+const searchJoin = "CROSS JOIN (SELECT websearch_to_tsquery(" + searchConfig + ", $1) || " +
+	"to_tsquery(" + searchConfig + ", $2) AS query) AS search "
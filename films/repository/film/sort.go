@@ -0,0 +1,109 @@
+package film
+
+import (
+	"fmt"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
+)
+
+// Sorts of the film lists. The relevance sort is of the search only.
+const (
+	SortPopularity    = "popularity"
+	SortRating        = "rating"
+	SortReleaseDate   = "release_date"
+	SortTitle         = "title"
+	SortRecentlyAdded = "recently_added"
+	SortRelevance     = "relevance"
+)
+
+// Directions of the sorts, an empty one is the default of the sort.
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// statsJoins join the stats the popularity and the rating sorts need.
+const statsJoins = "LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film " +
+	"LEFT JOIN film_views ON film.id = film_views.id_film "
+
+// sortKeys are the sort keys of the film lists, the films with the same key
+// are ordered by the id. Popularity is the number of the ratings and the
+// views of a film, unrated films have the rating 0. Recently added films are
+// the ones with the largest ids.
+var sortKeys = map[string][]pagination.Column{
+	SortPopularity: {
+		{Expr: "(COALESCE(film_rating_stats.rating_count, 0) + COALESCE(film_views.views, 0))", Type: "BIGINT"},
+		{Expr: "film.id", Type: "BIGINT"},
+	},
+	SortRating: {
+		{Expr: "COALESCE(film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0), 0)", Type: "DOUBLE PRECISION"},
+		{Expr: "film.id", Type: "BIGINT"},
+	},
+	SortReleaseDate: {
+		{Expr: "film.release_date", Type: "DATE"},
+		{Expr: "film.id", Type: "BIGINT"},
+	},
+	SortTitle: {
+		{Expr: "film.title", Type: "TEXT"},
+		{Expr: "film.id", Type: "BIGINT"},
+	},
+	SortRecentlyAdded: {
+		{Expr: "film.id", Type: "BIGINT"},
+	},
+}
+
+// favoriteAddedKey is the sort key of the recently added favorites, the
+// films the user has added to the favorites last.
+var favoriteAddedKey = []pagination.Column{
+	{Expr: "users_favorite_film.added_at", Type: "TIMESTAMP"},
+	{Expr: "film.id", Type: "BIGINT"},
+}
+
+// IsSort tells if the sort is one of the film lists.
+func IsSort(sort string) bool {
+	_, found := sortKeys[sort]
+	return found
+}
+
+// IsSearchSort tells if the sort is one of the found films.
+func IsSearchSort(sort string) bool {
+	return sort == SortRelevance || IsSort(sort)
+}
+
+func IsOrder(order string) bool {
+	return order == "" || order == OrderAsc || order == OrderDesc
+}
+
+// filmOrder is the order of a film list by the sort in the direction. The
+// titles go in the alphabetical order by default, the rest from the largest.
+func filmOrder(sort string, order string) (pagination.Order, error) {
+	columns, found := sortKeys[sort]
+	if !found {
+		return pagination.Order{}, fmt.Errorf("unknown sort %q", sort)
+	}
+	if !IsOrder(order) {
+		return pagination.Order{}, fmt.Errorf("unknown order %q", order)
+	}
+	if order == "" {
+		order = OrderDesc
+		if sort == SortTitle {
+			order = OrderAsc
+		}
+	}
+
+	return pagination.Order{Columns: columns, Desc: order == OrderDesc}, nil
+}
+
+// favoriteFilmOrder is the order of the favorite films by the sort in the
+// direction, the recently added ones go by when they were added.
+func favoriteFilmOrder(sort string, order string) (pagination.Order, error) {
+	filmsOrder, err := filmOrder(sort, order)
+	if err != nil {
+		return pagination.Order{}, err
+	}
+	if sort == SortRecentlyAdded {
+		filmsOrder.Columns = favoriteAddedKey
+	}
+
+	return filmsOrder, nil
+}
@@ -0,0 +1,43 @@
+package film
+
+import "testing"
+
+func TestFilmOrder(t *testing.T) {
+	testCases := map[string]struct {
+		sort  string
+		order string
+		by    string
+	}{
+		"Popularity": {
+			sort: SortPopularity,
+			by:   "(COALESCE(film_rating_stats.rating_count, 0) + COALESCE(film_views.views, 0)) DESC, film.id DESC",
+		},
+		"Rating asc": {
+			sort:  SortRating,
+			order: OrderAsc,
+			by:    "COALESCE(film_rating_stats.rating_sum::FLOAT / NULLIF(film_rating_stats.rating_count, 0), 0) ASC, film.id ASC",
+		},
+		"Title":        {sort: SortTitle, by: "film.title ASC, film.id ASC"},
+		"Title desc":   {sort: SortTitle, order: OrderDesc, by: "film.title DESC, film.id DESC"},
+		"Release date": {sort: SortReleaseDate, by: "film.release_date DESC, film.id DESC"},
+		"Recent":       {sort: SortRecentlyAdded, by: "film.id DESC"},
+	}
+
+	for name, curr := range testCases {
+		order, err := filmOrder(curr.sort, curr.order)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if order.By() != curr.by {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.by, order.By())
+		}
+	}
+
+	if _, err := filmOrder(SortRelevance, ""); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+	if _, err := filmOrder(SortTitle, "up"); err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
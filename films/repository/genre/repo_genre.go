@@ -126,7 +126,7 @@ func (repo *RepoPostgre) UsersStatistics(idUser uint64) ([]requests.UsersStatist
 	response := []requests.UsersStatisticsResponse{}
 
 	rows, err := repo.db.Query("SELECT genre.id, AVG(rating), COUNT(rating) FROM film "+
-		"JOIN users_comment ON film.id = users_comment.id_film "+
+		"JOIN ratings ON film.id = ratings.id_film "+
 		"JOIN films_genre ON film.id = films_genre.id_film "+
 		"JOIN genre ON genre.id = films_genre.id_genre "+
 		"WHERE id_user = $1 "+
@@ -0,0 +1,79 @@
+package suggest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-redis/redis/v8"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=cache_suggest.go -destination=../../mocks/suggest_cache_mock.go -package=mocks
+
+// ISuggestCache keeps the suggestions of the recent queries, so that the
+// search box does not hit the database on every key press.
+type ISuggestCache interface {
+	GetSuggestions(ctx context.Context, query string) ([]models.SuggestItem, bool, error)
+	SetSuggestions(ctx context.Context, query string, items []models.SuggestItem) error
+}
+
+type SuggestRedisCache struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// GetSuggestCache connects to redis. The cached suggestions live as long
+// as the suggestions in the database, until the next refresh.
+func GetSuggestCache(cfg configs.DbRedisCfg, ttl time.Duration) (*SuggestRedisCache, error) {
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     cfg.Host,
+		Password: cfg.Password,
+		DB:       cfg.DbNumber,
+	})
+
+	_, err := redisClient.Ping(context.Background()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get suggest cache: %w", err)
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultRefresh
+	}
+
+	return &SuggestRedisCache{client: redisClient, ttl: ttl}, nil
+}
+
+func (cache *SuggestRedisCache) GetSuggestions(ctx context.Context, query string) ([]models.SuggestItem, bool, error) {
+	data, err := cache.client.Get(ctx, "suggest:"+query).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("get suggestions err: %w", err)
+	}
+
+	var items models.SuggestItems
+	if err := easyjson.Unmarshal(data, &items); err != nil {
+		return nil, false, fmt.Errorf("get suggestions err: %w", err)
+	}
+
+	return items, true, nil
+}
+
+func (cache *SuggestRedisCache) SetSuggestions(ctx context.Context, query string, items []models.SuggestItem) error {
+	data, err := easyjson.Marshal(models.SuggestItems(items))
+	if err != nil {
+		return fmt.Errorf("set suggestions err: %w", err)
+	}
+
+	err = cache.client.Set(ctx, "suggest:"+query, data, cache.ttl).Err()
+	if err != nil {
+		return fmt.Errorf("set suggestions err: %w", err)
+	}
+
+	return nil
+}
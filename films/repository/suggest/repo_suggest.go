@@ -0,0 +1,165 @@
+package suggest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/search"
+
+	_ "github.com/jackc/pgx/stdlib"
+)
+
+//go:generate mockgen -source=repo_suggest.go -destination=../../mocks/suggest_repo_mock.go -package=mocks
+
+const (
+	KindFilm   = "film"
+	KindPerson = "person"
+)
+
+// DefaultRefresh is how often the suggestions are refreshed if the config
+// does not say.
+const DefaultRefresh = 10 * time.Minute
+
+type ISuggestRepo interface {
+	Suggest(query string, limit uint64) ([]models.SuggestItem, error)
+	Refresh() error
+}
+
+type RepoPostgre struct {
+	db *sql.DB
+}
+
+// GetSuggestRepo connects to the database and refreshes the suggestions
+// in the background until ctx is done.
+func GetSuggestRepo(ctx context.Context, config *configs.DbDsnCfg, lg *slog.Logger) (*RepoPostgre, error) {
+	dsn := fmt.Sprintf("user=%s dbname=%s password= %s host=%s port=%d sslmode=%s",
+		config.User, config.DbName, config.Password, config.Host, config.Port, config.Sslmode)
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		lg.Error("sql open error", "err", err.Error())
+		return nil, fmt.Errorf("get suggest repo: %w", err)
+	}
+	err = db.Ping()
+	if err != nil {
+		lg.Error("sql ping error", "err", err.Error())
+		return nil, fmt.Errorf("get suggest repo: %w", err)
+	}
+	db.SetMaxOpenConns(config.MaxOpenConns)
+
+	postgreDb := RepoPostgre{db: db}
+
+	refresh := config.SuggestRefresh
+	if refresh <= 0 {
+		refresh = DefaultRefresh
+	}
+	go postgreDb.refreshSuggestions(ctx, refresh, lg)
+
+	return &postgreDb, nil
+}
+
+func (repo *RepoPostgre) refreshSuggestions(ctx context.Context, period time.Duration, lg *slog.Logger) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		err := repo.Refresh()
+		if err != nil {
+			lg.Error("refresh suggestions error", "err", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Suggest returns the most popular films and people with the words of
+// the query as the prefixes of the words of their titles.
+func (repo *RepoPostgre) Suggest(query string, limit uint64) ([]models.SuggestItem, error) {
+	items := []models.SuggestItem{}
+
+	prefix := search.PrefixQuery(query)
+	if prefix == "" {
+		return items, nil
+	}
+
+	rows, err := repo.db.Query(
+		"SELECT kind, id_item, title, thumbnail FROM search_suggestions "+
+			"WHERE search_key @@ to_tsquery('simple', $1) "+
+			"ORDER BY popularity DESC, title "+
+			"LIMIT $2", prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("suggest err: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := models.SuggestItem{}
+		err := rows.Scan(&item.Kind, &item.Id, &item.Title, &item.Thumbnail)
+		if err != nil {
+			return nil, fmt.Errorf("suggest scan err: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Refresh rebuilds the suggestions from the films and the crew. The
+// popularity of a film is the number of its ratings and favorites, of a
+// person the number of their films and favorites.
+func (repo *RepoPostgre) Refresh() error {
+	tx, err := repo.db.Begin()
+	if err != nil {
+		return fmt.Errorf("refresh suggestions err: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec(
+		"INSERT INTO search_suggestions(kind, id_item, title, thumbnail, popularity) "+
+			"SELECT $1, film.id, film.title, COALESCE(film.poster, ''), "+
+			"COALESCE(film_rating_stats.rating_count, 0) + "+
+			"(SELECT COUNT(*) FROM users_favorite_film WHERE users_favorite_film.id_film = film.id) "+
+			"FROM film LEFT JOIN film_rating_stats ON film.id = film_rating_stats.id_film "+
+			"ON CONFLICT (kind, id_item) DO UPDATE "+
+			"SET title = EXCLUDED.title, thumbnail = EXCLUDED.thumbnail, popularity = EXCLUDED.popularity", KindFilm)
+	if err != nil {
+		return fmt.Errorf("refresh film suggestions err: %w", err)
+	}
+
+	_, err = tx.Exec(
+		"INSERT INTO search_suggestions(kind, id_item, title, thumbnail, popularity) "+
+			"SELECT $1, crew.id, crew.name, COALESCE(crew.photo, ''), "+
+			"(SELECT COUNT(DISTINCT person_in_film.id_film) FROM person_in_film WHERE person_in_film.id_person = crew.id) + "+
+			"(SELECT COUNT(*) FROM users_favorite_actor WHERE users_favorite_actor.id_actor = crew.id) "+
+			"FROM crew "+
+			"ON CONFLICT (kind, id_item) DO UPDATE "+
+			"SET title = EXCLUDED.title, thumbnail = EXCLUDED.thumbnail, popularity = EXCLUDED.popularity", KindPerson)
+	if err != nil {
+		return fmt.Errorf("refresh person suggestions err: %w", err)
+	}
+
+	_, err = tx.Exec(
+		"DELETE FROM search_suggestions WHERE "+
+			"(kind = $1 AND id_item NOT IN (SELECT id FROM film)) OR "+
+			"(kind = $2 AND id_item NOT IN (SELECT id FROM crew))", KindFilm, KindPerson)
+	if err != nil {
+		return fmt.Errorf("refresh suggestions delete err: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("refresh suggestions err: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package suggest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+)
+
+func TestSuggest(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"Kind", "Id", "Title", "Thumbnail"}).
+		AddRow(KindFilm, 1, "Star Wars", "poster1").
+		AddRow(KindPerson, 2, "Stanley Kubrick", "photo2")
+
+	expect := []models.SuggestItem{
+		{Kind: KindFilm, Id: 1, Title: "Star Wars", Thumbnail: "poster1"},
+		{Kind: KindPerson, Id: 2, Title: "Stanley Kubrick", Thumbnail: "photo2"},
+	}
+
+	selectRow := "SELECT kind, id_item, title, thumbnail FROM search_suggestions " +
+		"WHERE search_key @@ to_tsquery('simple', $1) ORDER BY popularity DESC, title LIMIT $2"
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs("sta:*", uint64(10)).
+		WillReturnRows(rows)
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	items, err := repo.Suggest("sta", 10)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(items, expect) {
+		t.Errorf("results not match, want %v, have %v", expect, items)
+		return
+	}
+
+	items, err = repo.Suggest("?!", 10)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if len(items) != 0 {
+		t.Errorf("results not match, want empty, have %v", items)
+		return
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(selectRow)).
+		WithArgs("sta:*", uint64(10)).
+		WillReturnError(fmt.Errorf("db_error"))
+
+	_, err = repo.Suggest("sta", 10)
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO search_suggestions(kind, id_item, title, thumbnail, popularity) SELECT $1, film.id")).
+		WithArgs(KindFilm).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO search_suggestions(kind, id_item, title, thumbnail, popularity) SELECT $1, crew.id")).
+		WithArgs(KindPerson).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM search_suggestions")).
+		WithArgs(KindFilm, KindPerson).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	err = repo.Refresh()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO search_suggestions")).
+		WithArgs(KindFilm).
+		WillReturnError(fmt.Errorf("db_error"))
+	mock.ExpectRollback()
+
+	err = repo.Refresh()
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRefreshSuggestionsStops(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("cant create mock: %s", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin().WillReturnError(fmt.Errorf("db_error"))
+
+	repo := &RepoPostgre{
+		db: db,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		repo.refreshSuggestions(ctx, time.Hour, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("refresh did not stop after the context was done")
+		return
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
+	"strings"
 	"time"
 
 	auth "github.com/go-park-mail-ru/2023_2_Vkladyshi/authorization/proto"
@@ -15,37 +16,47 @@ import (
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/genre"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/profession"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/suggest"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcclient"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrFoundFavorite = errors.New("found favorite")
+	ErrUnknownSort   = errors.New("unknown sort")
 )
 
 //go:generate mockgen -source=core.go -destination=../mocks/core_mock.go -package=mocks
 
 type ICore interface {
-	GetFilmsAndGenreTitle(genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error)
+	GetFilmsAndGenreTitle(genreId uint64, sort string, order string, page pagination.Page) (*requests.FilmsResponse, error)
 	GetFilmInfo(filmId uint64) (*requests.FilmResponse, error)
 	GetActorInfo(actorId uint64) (*requests.ActorResponse, error)
 	GetActorsCareer(actorId uint64) ([]models.ProfessionItem, error)
 	GetGenre(genreId uint64) (string, error)
 	FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-		mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
-	) ([]models.FilmItem, error)
-	FavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error)
+		mpaa string, genres []uint32, actors []string, sort string, order string, first uint64, limit uint64,
+	) (*requests.FindFilmResponse, error)
+	FavoriteFilms(userId uint64, sort string, order string, page pagination.Page) (*requests.FilmsResponse, error)
 	FavoriteFilmsAdd(userId uint64, filmId uint64) error
 	FavoriteFilmsRemove(userId uint64, filmId uint64) error
 	GetCalendar() (*requests.CalendarResponse, error)
 	GetUserId(ctx context.Context, sid string) (uint64, error)
-	FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error)
+	VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error)
+	CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error)
+	FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) (*requests.FindActorResponse, error)
+	Suggest(ctx context.Context, query string) ([]models.SuggestItem, error)
 	AddRating(filmId uint64, userId uint64, rating uint16) (bool, error)
+	UpdateRating(filmId uint64, userId uint64, rating uint16) error
+	GetUserRating(userId uint64, filmId uint64) (*models.RatingItem, error)
 	AddFilm(film models.FilmItem, genres []uint64, actors []uint64) error
-	FavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error)
+	FavoriteActors(userId uint64, page pagination.Page) (*requests.ActorsResponse, error)
 	FavoriteActorsAdd(userId uint64, filmId uint64) error
 	FavoriteActorsRemove(userId uint64, filmId uint64) error
 	DeleteRating(idUser uint64, idFilm uint64) error
@@ -54,21 +65,26 @@ type ICore interface {
 	UsersStatistics(idUser uint64) ([]requests.UsersStatisticsResponse, error)
 	Trends() ([]models.FilmItem, error)
 	GetLastSeen([]models.NearFilm) ([]models.FilmItem, error)
+	ExportUserData(ctx context.Context, userId uint64) (*models.UserData, error)
+	DeleteUserData(ctx context.Context, userId uint64) error
 }
 
 type Core struct {
-	lg         *slog.Logger
-	films      film.IFilmsRepo
-	genres     genre.IGenreRepo
-	crew       crew.ICrewRepo
-	profession profession.IProfessionRepo
-	calendar   calendar.ICalendarRepo
-	client     auth.AuthorizationClient
-	nearFilms  *film.FilmRedisRepo
+	lg           *slog.Logger
+	films        film.IFilmsRepo
+	genres       genre.IGenreRepo
+	crew         crew.ICrewRepo
+	profession   profession.IProfessionRepo
+	calendar     calendar.ICalendarRepo
+	suggestions  suggest.ISuggestRepo
+	suggestCache suggest.ISuggestCache
+	client       auth.AuthorizationClient
+	nearFilms    *film.FilmRedisRepo
+	keys         *token.RemoteKeySet
 }
 
-func GetClient(port string) (auth.AuthorizationClient, error) {
-	conn, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func GetClient(addr string, cfg configs.GrpcClientCfg, lg *slog.Logger) (auth.AuthorizationClient, error) {
+	conn, err := grpcclient.Dial(addr, cfg, lg)
 	if err != nil {
 		return nil, fmt.Errorf("grpc connect err: %w", err)
 	}
@@ -77,49 +93,87 @@ func GetClient(port string) (auth.AuthorizationClient, error) {
 	return client, nil
 }
 
+// keysRefresh is how often the signing keys of the authorization service
+// are refetched when no token with an unknown key forces it earlier.
+const keysRefresh = 10 * time.Minute
+
+func signingKeys(client auth.AuthorizationClient) token.FetchKeys {
+	return func(ctx context.Context) ([]token.Key, error) {
+		response, err := client.GetSigningKeys(ctx, &auth.SigningKeysRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]token.Key, 0, len(response.Keys))
+		for _, key := range response.Keys {
+			if key.Alg != token.Algorithm {
+				continue
+			}
+			keys = append(keys, token.Key{Kid: key.Kid, Public: key.PublicKey})
+		}
+
+		return keys, nil
+	}
+}
+
 func GetCore(cfg_sql *configs.DbDsnCfg, lg *slog.Logger,
 	films film.IFilmsRepo, genres genre.IGenreRepo, actors crew.ICrewRepo, professions profession.IProfessionRepo, calendar calendar.ICalendarRepo,
-	nearFilms *film.FilmRedisRepo) *Core {
-	client, err := GetClient(cfg_sql.GrpcPort)
+	suggestions suggest.ISuggestRepo, suggestCache suggest.ISuggestCache, nearFilms *film.FilmRedisRepo) *Core {
+	client, err := GetClient(cfg_sql.GrpcPort, cfg_sql.GrpcClient, lg)
 	if err != nil {
 		lg.Error("get client error", "err", err.Error())
 		return nil
 	}
 
 	core := Core{
-		lg:         lg.With("module", "core"),
-		films:      films,
-		genres:     genres,
-		crew:       actors,
-		profession: professions,
-		calendar:   calendar,
-		client:     client,
-		nearFilms:  nearFilms,
+		lg:           lg.With("module", "core"),
+		films:        films,
+		genres:       genres,
+		crew:         actors,
+		profession:   professions,
+		calendar:     calendar,
+		suggestions:  suggestions,
+		suggestCache: suggestCache,
+		client:       client,
+		nearFilms:    nearFilms,
+		keys:         token.NewRemoteKeySet(signingKeys(client), keysRefresh),
 	}
 	return &core
 }
 
-func (core *Core) GetFilmsAndGenreTitle(genreId uint64, start uint64, end uint64) ([]models.FilmItem, string, error) {
+func (core *Core) GetFilmsAndGenreTitle(genreId uint64, sort string, order string, page pagination.Page) (*requests.FilmsResponse, error) {
 	var films []models.FilmItem
+	var next string
 	var err error
 
 	if genreId == 0 {
-		films, err = core.films.GetFilms(start, end)
+		films, next, err = core.films.GetFilms(sort, order, page)
 	} else {
-		films, err = core.films.GetFilmsByGenre(genreId, start, end)
+		films, next, err = core.films.GetFilmsByGenre(genreId, sort, order, page)
 	}
 	if err != nil {
 		core.lg.Error("failed to get films from db", "err", err.Error())
-		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+		return nil, fmt.Errorf("GetFilms err: %w", err)
+	}
+
+	total, err := core.films.CountFilms(genreId)
+	if err != nil {
+		core.lg.Error("failed to count films", "err", err.Error())
+		return nil, fmt.Errorf("GetFilms err: %w", err)
 	}
 
 	genre, err := core.genres.GetGenreById(genreId)
 	if err != nil {
 		core.lg.Error("failed to get genre by id", "err", err.Error())
-		return nil, "", fmt.Errorf("GetFilms err: %w", err)
+		return nil, fmt.Errorf("GetFilms err: %w", err)
 	}
 
-	return films, genre, nil
+	return &requests.FilmsResponse{
+		CollectionName: genre,
+		Total:          total,
+		NextCursor:     next,
+		Films:          films,
+	}, nil
 }
 
 func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
@@ -138,7 +192,7 @@ func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
 		return nil, fmt.Errorf("get film genres err: %w", err)
 	}
 
-	rating, number, err := core.films.GetFilmRating(filmId)
+	stats, err := core.films.GetFilmRatingStats(filmId)
 	if err != nil {
 		core.lg.Error("get film rating error", "err", err.Error())
 		return nil, fmt.Errorf("get film rating err: %w", err)
@@ -163,13 +217,14 @@ func (core *Core) GetFilmInfo(filmId uint64) (*requests.FilmResponse, error) {
 	}
 
 	result := requests.FilmResponse{
-		Film:       *film,
-		Genres:     genres,
-		Rating:     rating,
-		Number:     number,
-		Directors:  directors,
-		Scenarists: scenarists,
-		Characters: characters,
+		Film:         *film,
+		Genres:       genres,
+		Rating:       stats.Average(),
+		Number:       stats.Count,
+		Distribution: stats.Histogram,
+		Directors:    directors,
+		Scenarists:   scenarists,
+		Characters:   characters,
 	}
 
 	return &result, nil
@@ -222,11 +277,16 @@ func (core *Core) GetGenre(genreId uint64) (string, error) {
 	return genre, nil
 }
 
+// FindFilm returns a page of the films found by the filters, with the
+// number of all of them and the facets.
 func (core *Core) FindFilm(title string, dateFrom string, dateTo string, ratingFrom float32, ratingTo float32,
-	mpaa string, genres []uint32, actors []string, first uint64, limit uint64,
-) ([]models.FilmItem, error) {
+	mpaa string, genres []uint32, actors []string, sort string, order string, first uint64, limit uint64,
+) (*requests.FindFilmResponse, error) {
+	if !film.IsSearchSort(sort) || !film.IsOrder(order) {
+		return nil, ErrUnknownSort
+	}
 
-	films, err := core.films.FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, first, limit)
+	films, err := core.films.FindFilm(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors, sort, order, first, limit)
 	if err != nil {
 		core.lg.Error("find film error", "err", err.Error())
 		return nil, fmt.Errorf("find film err: %w", err)
@@ -236,17 +296,29 @@ func (core *Core) FindFilm(title string, dateFrom string, dateTo string, ratingF
 		return nil, ErrNotFound
 	}
 
-	return films, nil
+	total, facets, err := core.films.FindFilmFacets(title, dateFrom, dateTo, ratingFrom, ratingTo, mpaa, genres, actors)
+	if err != nil {
+		core.lg.Error("find film facets error", "err", err.Error())
+		return nil, fmt.Errorf("find film err: %w", err)
+	}
+
+	return &requests.FindFilmResponse{Films: films, Total: total, Facets: *facets}, nil
 }
 
-func (core *Core) FavoriteFilms(userId uint64, start uint64, end uint64) ([]models.FilmItem, error) {
-	films, err := core.films.GetFavoriteFilms(userId, start, end)
+func (core *Core) FavoriteFilms(userId uint64, sort string, order string, page pagination.Page) (*requests.FilmsResponse, error) {
+	films, next, err := core.films.GetFavoriteFilms(userId, sort, order, page)
 	if err != nil {
 		core.lg.Error("favorite films error", "err", err.Error())
 		return nil, fmt.Errorf("favorite films err: %w", err)
 	}
 
-	return films, nil
+	total, err := core.films.CountFavoriteFilms(userId)
+	if err != nil {
+		core.lg.Error("favorite films count error", "err", err.Error())
+		return nil, fmt.Errorf("favorite films err: %w", err)
+	}
+
+	return &requests.FilmsResponse{Total: total, NextCursor: next, Films: films}, nil
 }
 
 func (core *Core) FavoriteFilmsAdd(userId uint64, filmId uint64) error {
@@ -299,6 +371,9 @@ func (core *Core) GetUserId(ctx context.Context, sid string) (uint64, error) {
 	request := auth.FindIdRequest{Sid: sid}
 
 	response, err := core.client.GetId(ctx, &request)
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unauthenticated {
+		return 0, ErrNotFound
+	}
 	if err != nil {
 		core.lg.Error("get user id error", "err", err.Error())
 		return 0, fmt.Errorf("get user id err: %w", err)
@@ -306,7 +381,9 @@ func (core *Core) GetUserId(ctx context.Context, sid string) (uint64, error) {
 	return uint64(response.Value), nil
 }
 
-func (core *Core) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) ([]models.Character, error) {
+// FindActor returns a page of the people found by the filters, with the
+// number of all of them and the facets.
+func (core *Core) FindActor(name string, birthDate string, films []string, career []string, country string, first, limit uint64) (*requests.FindActorResponse, error) {
 	actors, err := core.crew.FindActor(name, birthDate, films, career, country, first, limit)
 	if err != nil {
 		core.lg.Error("find actor error", "err", err.Error())
@@ -316,26 +393,89 @@ func (core *Core) FindActor(name string, birthDate string, films []string, caree
 		return nil, ErrNotFound
 	}
 
-	return actors, nil
+	total, facets, err := core.crew.FindActorFacets(name, birthDate, films, career, country)
+	if err != nil {
+		core.lg.Error("find actor facets error", "err", err.Error())
+		return nil, fmt.Errorf("find actor err: %w", err)
+	}
+
+	return &requests.FindActorResponse{Actors: actors, Total: total, Facets: *facets}, nil
 }
 
-func (core *Core) AddRating(filmId uint64, userId uint64, rating uint16) (bool, error) {
-	found, err := core.films.HasUsersRating(userId, filmId)
+// suggestLimit is how many films and people are suggested for a query.
+const suggestLimit = 10
+
+// Suggest returns the most popular films and people for the search box.
+// The suggestions are cached by the normalized query, a broken cache only
+// makes them slower.
+func (core *Core) Suggest(ctx context.Context, query string) ([]models.SuggestItem, error) {
+	query = strings.ToLower(strings.Join(strings.Fields(query), " "))
+	if query == "" {
+		return []models.SuggestItem{}, nil
+	}
+
+	items, found, err := core.suggestCache.GetSuggestions(ctx, query)
 	if err != nil {
-		core.lg.Error("find users rating error", "err", err.Error())
-		return false, fmt.Errorf("find users rating error: %w", err)
+		core.lg.Error("get cached suggestions error", "err", err.Error())
 	}
 	if found {
-		return found, nil
+		return items, nil
+	}
+
+	items, err = core.suggestions.Suggest(query, suggestLimit)
+	if err != nil {
+		core.lg.Error("suggest error", "err", err.Error())
+		return nil, fmt.Errorf("suggest err: %w", err)
+	}
+
+	err = core.suggestCache.SetSuggestions(ctx, query, items)
+	if err != nil {
+		core.lg.Error("cache suggestions error", "err", err.Error())
 	}
 
-	err = core.films.AddRating(filmId, userId, rating)
+	return items, nil
+}
+
+// AddRating rates the film. If the user has already rated it, the rating
+// is replaced and true is returned.
+func (core *Core) AddRating(filmId uint64, userId uint64, rating uint16) (bool, error) {
+	replaced, err := core.films.AddRating(filmId, userId, rating)
 	if err != nil {
 		core.lg.Error("add rating error", "err", err.Error())
 		return false, fmt.Errorf("add rating err: %w", err)
 	}
 
-	return false, nil
+	return replaced, nil
+}
+
+// UpdateRating replaces the rating of the user to the film. It returns
+// ErrNotFound if the user has not rated the film.
+func (core *Core) UpdateRating(filmId uint64, userId uint64, rating uint16) error {
+	found, err := core.films.UpdateRating(filmId, userId, rating)
+	if err != nil {
+		core.lg.Error("update rating error", "err", err.Error())
+		return fmt.Errorf("update rating err: %w", err)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetUserRating returns the rating of the user to the film. It returns
+// ErrNotFound if the user has not rated the film.
+func (core *Core) GetUserRating(userId uint64, filmId uint64) (*models.RatingItem, error) {
+	rating, err := core.films.GetUserRating(userId, filmId)
+	if err != nil {
+		core.lg.Error("get user rating error", "err", err.Error())
+		return nil, fmt.Errorf("get user rating err: %w", err)
+	}
+	if rating == nil {
+		return nil, ErrNotFound
+	}
+
+	return rating, nil
 }
 
 func (core *Core) AddFilm(film models.FilmItem, genres []uint64, actors []uint64) error {
@@ -366,14 +506,20 @@ func (core *Core) AddFilm(film models.FilmItem, genres []uint64, actors []uint64
 	return nil
 }
 
-func (core *Core) FavoriteActors(userId uint64, start uint64, end uint64) ([]models.Character, error) {
-	actors, err := core.crew.GetFavoriteActors(userId, start, end)
+func (core *Core) FavoriteActors(userId uint64, page pagination.Page) (*requests.ActorsResponse, error) {
+	actors, next, err := core.crew.GetFavoriteActors(userId, page)
 	if err != nil {
 		core.lg.Error("favorite actors error", "err", err.Error())
 		return nil, fmt.Errorf("favorite actors err: %w", err)
 	}
 
-	return actors, nil
+	total, err := core.crew.CountFavoriteActors(userId)
+	if err != nil {
+		core.lg.Error("favorite actors count error", "err", err.Error())
+		return nil, fmt.Errorf("favorite actors err: %w", err)
+	}
+
+	return &requests.ActorsResponse{Actors: actors, Total: total, NextCursor: next}, nil
 }
 
 func (core *Core) FavoriteActorsAdd(userId uint64, actorId uint64) error {
@@ -424,12 +570,28 @@ func (c *Core) GetNearFilms(ctx context.Context, userId uint64, lg *slog.Logger)
 	return nearFilms, nil
 }
 
+// AddNearFilm records that the user has seen the film. The first time it
+// counts a view of the film for the popularity sort.
 func (c *Core) AddNearFilm(ctx context.Context, active models.NearFilm, lg *slog.Logger) (bool, error) {
+	seen, err := c.nearFilms.CheckActiveNearFilm(ctx, strconv.FormatUint(active.IdUser, 10), strconv.FormatUint(active.IdFilm, 10), lg)
+	if err != nil {
+		lg.Error("Failed to check near film", "error", err.Error())
+		return false, err
+	}
+
 	added, err := c.nearFilms.AddNearFilm(ctx, active, lg)
 	if err != nil {
 		lg.Error("Failed to add near film", "error", err.Error())
 		return false, err
 	}
+
+	if added && !seen {
+		err = c.films.AddView(active.IdFilm)
+		if err != nil {
+			lg.Error("Failed to add film view", "error", err.Error())
+			return false, fmt.Errorf("add near film err: %w", err)
+		}
+	}
 	return added, nil
 }
 
@@ -471,3 +633,78 @@ func (core *Core) GetLastSeen(filmsIds []models.NearFilm) ([]models.FilmItem, er
 
 	return films, nil
 }
+
+// VerifyAccessToken checks an access token locally, without a call to the
+// authorization service, and returns the id of the user it was issued to.
+func (core *Core) VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error) {
+	claims, err := core.keys.Verify(ctx, accessToken)
+	if err != nil {
+		return 0, fmt.Errorf("verify access token err: %w", err)
+	}
+
+	return claims.UserId, nil
+}
+
+func (core *Core) CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error) {
+	response, err := core.client.CheckPermission(ctx, &auth.PermissionRequest{UserId: int64(userId), Permission: permission})
+	if err != nil {
+		core.lg.Error("check permission error", "err", err.Error())
+		return false, fmt.Errorf("check permission err: %w", err)
+	}
+
+	return response.Allowed, nil
+}
+
+func (core *Core) ExportUserData(ctx context.Context, userId uint64) (*models.UserData, error) {
+	favoriteFilms, err := core.films.GetFavoriteFilmIds(userId)
+	if err != nil {
+		core.lg.Error("export user data error", "err", err.Error())
+		return nil, fmt.Errorf("export user data err: %w", err)
+	}
+
+	favoriteActors, err := core.crew.GetFavoriteActorIds(userId)
+	if err != nil {
+		core.lg.Error("export user data error", "err", err.Error())
+		return nil, fmt.Errorf("export user data err: %w", err)
+	}
+
+	ratings, err := core.films.GetUserRatings(userId)
+	if err != nil {
+		core.lg.Error("export user data error", "err", err.Error())
+		return nil, fmt.Errorf("export user data err: %w", err)
+	}
+
+	nearFilms, err := core.nearFilms.GetNearFilms(ctx, strconv.FormatUint(userId, 10), core.lg)
+	if err != nil {
+		core.lg.Error("export user data error", "err", err.Error())
+		return nil, fmt.Errorf("export user data err: %w", err)
+	}
+
+	data := &models.UserData{
+		FavoriteFilms:  favoriteFilms,
+		FavoriteActors: favoriteActors,
+		Ratings:        ratings,
+		NearFilms:      make([]uint64, 0, len(nearFilms)),
+	}
+	for _, nearFilm := range nearFilms {
+		data.NearFilms = append(data.NearFilms, nearFilm.IdFilm)
+	}
+
+	return data, nil
+}
+
+// DeleteUserData deletes everything the films service keeps about the user.
+func (core *Core) DeleteUserData(ctx context.Context, userId uint64) error {
+	err := core.films.DeleteUserData(userId)
+	if err != nil {
+		core.lg.Error("delete user data error", "err", err.Error())
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+
+	err = core.nearFilms.DeleteNearFilms(ctx, strconv.FormatUint(userId, 10), core.lg)
+	if err != nil {
+		return fmt.Errorf("delete user data err: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -10,7 +11,9 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/mocks"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/films/repository/film"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/models"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/pagination"
 	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
 	"github.com/golang/mock/gomock"
 )
@@ -128,18 +131,21 @@ func TestFindFilm(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	expectedFilm := models.FilmItem{Title: "t"}
-	expected := []models.FilmItem{expectedFilm}
+	films := []models.FilmItem{expectedFilm}
+	facets := &models.FilmFacets{Mpaa: []models.FacetBucket{{Value: "PG", Count: 3}}}
+	expected := &requests.FindFilmResponse{Films: films, Total: 3, Facets: *facets}
 
 	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().FindFilm(string("t"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(0), uint64(1)).Return(expected, nil)
-	mockObj.EXPECT().FindFilm(string("t0"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(0), uint64(0)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
-	mockObj.EXPECT().FindFilm(string("t10"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, uint64(1), uint64(1)).Return([]models.FilmItem{}, nil)
+	firstCall := mockObj.EXPECT().FindFilm(string("t"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, film.SortTitle, "", uint64(0), uint64(1)).Return(films, nil)
+	mockObj.EXPECT().FindFilmFacets(string("t"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil).Return(uint64(3), facets, nil)
+	mockObj.EXPECT().FindFilm(string("t0"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, film.SortTitle, "", uint64(0), uint64(0)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
+	mockObj.EXPECT().FindFilm(string("t10"), string("df"), string("dt"), float32(0), float32(10), string(""), nil, nil, film.SortTitle, "", uint64(1), uint64(1)).Return([]models.FilmItem{}, nil)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, lg: logger}
 
-	result, err := core.FindFilm("t", "df", "dt", 0, 10, "", nil, nil, 0, 1)
+	result, err := core.FindFilm("t", "df", "dt", 0, 10, "", nil, nil, film.SortTitle, "", 0, 1)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
@@ -149,7 +155,7 @@ func TestFindFilm(t *testing.T) {
 		return
 	}
 
-	result, err = core.FindFilm("t0", "df", "dt", 0, 10, "", nil, nil, 0, 0)
+	result, err = core.FindFilm("t0", "df", "dt", 0, 10, "", nil, nil, film.SortTitle, "", 0, 0)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -159,7 +165,7 @@ func TestFindFilm(t *testing.T) {
 		return
 	}
 
-	result, err = core.FindFilm("t10", "df", "dt", 0, 10, "", nil, nil, 1, 1)
+	result, err = core.FindFilm("t10", "df", "dt", 0, 10, "", nil, nil, film.SortTitle, "", 1, 1)
 	if !errors.Is(err, ErrNotFound) {
 		t.Errorf("expected not found")
 		return
@@ -168,6 +174,18 @@ func TestFindFilm(t *testing.T) {
 		t.Errorf("unexpected result")
 		return
 	}
+
+	_, err = core.FindFilm("t", "df", "dt", 0, 10, "", nil, nil, "year", "", 0, 1)
+	if !errors.Is(err, ErrUnknownSort) {
+		t.Errorf("expected unknown sort")
+		return
+	}
+
+	_, err = core.FindFilm("t", "df", "dt", 0, 10, "", nil, nil, film.SortTitle, "up", 0, 1)
+	if !errors.Is(err, ErrUnknownSort) {
+		t.Errorf("expected unknown sort")
+		return
+	}
 }
 
 func TestFindActor(t *testing.T) {
@@ -175,10 +193,13 @@ func TestFindActor(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	expectedFilm := models.Character{NameActor: "t"}
-	expected := []models.Character{expectedFilm}
+	actors := []models.Character{expectedFilm}
+	facets := &models.ActorFacets{Professions: []models.FacetBucket{{Value: "актёр", Count: 2}}}
+	expected := &requests.FindActorResponse{Actors: actors, Total: 2, Facets: *facets}
 
 	mockObj := mocks.NewMockICrewRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().FindActor(string("t"), string("bd"), nil, nil, string(""), uint64(0), uint64(1)).Return(expected, nil)
+	firstCall := mockObj.EXPECT().FindActor(string("t"), string("bd"), nil, nil, string(""), uint64(0), uint64(1)).Return(actors, nil)
+	mockObj.EXPECT().FindActorFacets(string("t"), string("bd"), nil, nil, string("")).Return(uint64(2), facets, nil)
 	mockObj.EXPECT().FindActor(string("t"), string("bd"), nil, nil, string(""), uint64(0), uint64(0)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
 	mockObj.EXPECT().FindActor(string("t"), string("bd"), nil, nil, string(""), uint64(1), uint64(1)).Return([]models.Character{}, nil)
 
@@ -225,11 +246,20 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 	expectedFilms := []models.FilmItem{expectedFilm}
 
 	expectedGenre := "g1"
+	page := pagination.Page{Offset: 1, Limit: 1}
+	expected := &requests.FilmsResponse{
+		CollectionName: expectedGenre,
+		Total:          5,
+		NextCursor:     "next",
+		Films:          expectedFilms,
+	}
 
 	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
-	mockObj.EXPECT().GetFilms(uint64(1), uint64(1)).Return(expectedFilms, nil)
-	mockObj.EXPECT().GetFilms(uint64(1), uint64(0)).Return(nil, fmt.Errorf("repo_error"))
-	mockObj.EXPECT().GetFilmsByGenre(uint64(10), uint64(1), uint64(1)).Return(expectedFilms, nil)
+	mockObj.EXPECT().GetFilms(film.SortReleaseDate, "", page).Return(expectedFilms, "next", nil)
+	mockObj.EXPECT().GetFilms(film.SortReleaseDate, "", pagination.Page{Offset: 1}).Return(nil, "", fmt.Errorf("repo_error"))
+	mockObj.EXPECT().GetFilmsByGenre(uint64(10), film.SortReleaseDate, "", page).Return(expectedFilms, "", nil)
+	mockObj.EXPECT().CountFilms(uint64(0)).Return(uint64(5), nil)
+	mockObj.EXPECT().CountFilms(uint64(10)).Return(uint64(1), nil)
 
 	mockGenres := mocks.NewMockIGenreRepo(mockCtrl)
 	mockGenres.EXPECT().GetGenreById(uint64(0)).Return(expectedGenre, nil)
@@ -239,21 +269,17 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, genres: mockGenres, lg: logger}
 
-	films, genre, err := core.GetFilmsAndGenreTitle(0, 1, 1)
+	films, err := core.GetFilmsAndGenreTitle(0, film.SortReleaseDate, "", page)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
 	}
-	if !reflect.DeepEqual(expectedFilms, films) {
-		t.Errorf("wanted %v, had %v", expectedFilms, films)
-		return
-	}
-	if genre != expectedGenre {
-		t.Errorf("wanted %v, had %v", expectedGenre, genre)
+	if !reflect.DeepEqual(expected, films) {
+		t.Errorf("wanted %v, had %v", expected, films)
 		return
 	}
 
-	films, genre, err = core.GetFilmsAndGenreTitle(0, 1, 0)
+	films, err = core.GetFilmsAndGenreTitle(0, film.SortReleaseDate, "", pagination.Page{Offset: 1})
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -262,12 +288,8 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 		t.Errorf("unexpected result")
 		return
 	}
-	if genre != "" {
-		t.Errorf("unexpected result")
-		return
-	}
 
-	films, genre, err = core.GetFilmsAndGenreTitle(10, 1, 1)
+	films, err = core.GetFilmsAndGenreTitle(10, film.SortReleaseDate, "", page)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -276,10 +298,6 @@ func TestGetFilmsAndGenreTitle(t *testing.T) {
 		t.Errorf("unexpected result")
 		return
 	}
-	if genre != "" {
-		t.Errorf("unexpected result")
-		return
-	}
 }
 
 func TestGetActorInfo(t *testing.T) {
@@ -360,16 +378,16 @@ func TestGetFilmInfo(t *testing.T) {
 
 	charItem := models.Character{NameActor: "an"}
 	expectedCharacters := []models.Character{charItem}
-	expectedRating := 9.8
-	expectedNumber := uint64(100)
+	expectedStats := &models.RatingStats{Count: 4, Sum: 30, Histogram: []uint64{0, 0, 0, 0, 0, 0, 0, 2, 0, 2}}
 	expectedResult := &requests.FilmResponse{
-		Film:       *expectedFilm,
-		Genres:     expectedGenres,
-		Directors:  expectedCrew,
-		Scenarists: expectedCrew,
-		Characters: expectedCharacters,
-		Rating:     expectedRating,
-		Number:     expectedNumber}
+		Film:         *expectedFilm,
+		Genres:       expectedGenres,
+		Directors:    expectedCrew,
+		Scenarists:   expectedCrew,
+		Characters:   expectedCharacters,
+		Rating:       7.5,
+		Number:       4,
+		Distribution: expectedStats.Histogram}
 
 	mockFilm := mocks.NewMockIFilmsRepo(mockCtrl)
 	notFound := mockFilm.EXPECT().GetFilm(uint64(1)).Return(&models.FilmItem{}, nil).Times(1)
@@ -380,8 +398,8 @@ func TestGetFilmInfo(t *testing.T) {
 	withErr = mockGenres.EXPECT().GetFilmGenres(uint64(1)).Return(nil, fmt.Errorf("repo_error")).Times(1)
 	mockGenres.EXPECT().GetFilmGenres(uint64(1)).Return(expectedGenres, nil).AnyTimes().After(withErr)
 
-	withErr = mockFilm.EXPECT().GetFilmRating(uint64(1)).Return(float64(0), uint64(0), fmt.Errorf("repo_error")).Times(1)
-	mockFilm.EXPECT().GetFilmRating(uint64(1)).Return(expectedRating, expectedNumber, nil).AnyTimes().After(withErr)
+	withErr = mockFilm.EXPECT().GetFilmRatingStats(uint64(1)).Return(nil, fmt.Errorf("repo_error")).Times(1)
+	mockFilm.EXPECT().GetFilmRatingStats(uint64(1)).Return(expectedStats, nil).AnyTimes().After(withErr)
 
 	mockCrew := mocks.NewMockICrewRepo(mockCtrl)
 	withErr = mockCrew.EXPECT().GetFilmDirectors(uint64(1)).Return(nil, fmt.Errorf("repo_error")).Times(1)
@@ -438,24 +456,27 @@ func TestFavoriteFilms(t *testing.T) {
 	expected := []models.FilmItem{expectedFilm}
 
 	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().GetFavoriteFilms(uint64(1), uint64(1), uint64(1)).Return(expected, nil)
-	mockObj.EXPECT().GetFavoriteFilms(uint64(1), uint64(1), uint64(1)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
+	page := pagination.Page{Offset: 1, Limit: 1}
+	firstCall := mockObj.EXPECT().GetFavoriteFilms(uint64(1), film.SortRating, film.OrderAsc, page).Return(expected, "next", nil)
+	mockObj.EXPECT().GetFavoriteFilms(uint64(1), film.SortRating, film.OrderAsc, page).After(firstCall).Return(nil, "", fmt.Errorf("repo_error"))
+	mockObj.EXPECT().CountFavoriteFilms(uint64(1)).Return(uint64(2), nil)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{films: mockObj, lg: logger}
 
-	result, err := core.FavoriteFilms(1, 1, 1)
+	result, err := core.FavoriteFilms(1, film.SortRating, film.OrderAsc, page)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
 	}
-	if !reflect.DeepEqual(expected, result) {
-		t.Errorf("wanted %v, had %v", expected, result)
+	expectedResponse := &requests.FilmsResponse{Total: 2, NextCursor: "next", Films: expected}
+	if !reflect.DeepEqual(expectedResponse, result) {
+		t.Errorf("wanted %v, had %v", expectedResponse, result)
 		return
 	}
 
-	result, err = core.FavoriteFilms(1, 1, 1)
+	result, err = core.FavoriteFilms(1, film.SortRating, film.OrderAsc, page)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -533,12 +554,9 @@ func TestAddRating(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	mockObj := mocks.NewMockIFilmsRepo(mockCtrl)
-	mockObj.EXPECT().HasUsersRating(uint64(10), uint64(1)).Return(true, nil).Times(1).Times(1)
-	mockObj.EXPECT().HasUsersRating(uint64(0), uint64(0)).Return(false, fmt.Errorf("repo_error")).Times(1)
-	mockObj.EXPECT().HasUsersRating(uint64(1), uint64(1)).Return(false, nil).Times(2)
-
-	mockObj.EXPECT().AddRating(uint64(1), uint64(1), uint16(0)).Return(fmt.Errorf("repo_error")).Times(1)
-	mockObj.EXPECT().AddRating(uint64(1), uint64(1), uint16(5)).Return(nil).Times(1)
+	mockObj.EXPECT().AddRating(uint64(1), uint64(10), uint16(7)).Return(true, nil).Times(1)
+	mockObj.EXPECT().AddRating(uint64(1), uint64(1), uint16(0)).Return(false, fmt.Errorf("repo_error")).Times(1)
+	mockObj.EXPECT().AddRating(uint64(1), uint64(1), uint16(5)).Return(false, nil).Times(1)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
@@ -551,15 +569,10 @@ func TestAddRating(t *testing.T) {
 		result bool
 		hasErr bool
 	}{
-		"has user err": {
-			filmId: 0,
-			userId: 0,
-			hasErr: true,
-			result: false,
-		},
-		"has user found": {
+		"replaced": {
 			filmId: 1,
 			userId: 10,
+			rating: 7,
 			hasErr: false,
 			result: true,
 		},
@@ -675,24 +688,27 @@ func TestFavoriteActors(t *testing.T) {
 	expected := []models.Character{expectedFilm}
 
 	mockObj := mocks.NewMockICrewRepo(mockCtrl)
-	firstCall := mockObj.EXPECT().GetFavoriteActors(uint64(1), uint64(1), uint64(1)).Return(expected, nil)
-	mockObj.EXPECT().GetFavoriteActors(uint64(1), uint64(1), uint64(1)).After(firstCall).Return(nil, fmt.Errorf("repo_error"))
+	page := pagination.Page{Offset: 1, Limit: 1}
+	firstCall := mockObj.EXPECT().GetFavoriteActors(uint64(1), page).Return(expected, "next", nil)
+	mockObj.EXPECT().GetFavoriteActors(uint64(1), page).After(firstCall).Return(nil, "", fmt.Errorf("repo_error"))
+	mockObj.EXPECT().CountFavoriteActors(uint64(1)).Return(uint64(2), nil)
 
 	var buff bytes.Buffer
 	logger := slog.New(slog.NewJSONHandler(&buff, nil))
 	core := Core{crew: mockObj, lg: logger}
 
-	result, err := core.FavoriteActors(1, 1, 1)
+	result, err := core.FavoriteActors(1, page)
 	if err != nil {
 		t.Errorf("unexpected error %s", err)
 		return
 	}
-	if !reflect.DeepEqual(expected, result) {
-		t.Errorf("wanted %v, had %v", expected, result)
+	expectedResponse := &requests.ActorsResponse{Actors: expected, Total: 2, NextCursor: "next"}
+	if !reflect.DeepEqual(expectedResponse, result) {
+		t.Errorf("wanted %v, had %v", expectedResponse, result)
 		return
 	}
 
-	result, err = core.FavoriteActors(1, 1, 1)
+	result, err = core.FavoriteActors(1, page)
 	if err == nil {
 		t.Errorf("wanted error")
 		return
@@ -764,3 +780,56 @@ func TestFavoriteActorsAdd(t *testing.T) {
 		return
 	}
 }
+
+func TestSuggest(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	cached := []models.SuggestItem{{Kind: "film", Id: 1, Title: "Star Wars"}}
+	fresh := []models.SuggestItem{{Kind: "person", Id: 2, Title: "Stanley Kubrick"}}
+
+	mockRepo := mocks.NewMockISuggestRepo(mockCtrl)
+	mockCache := mocks.NewMockISuggestCache(mockCtrl)
+	mockCache.EXPECT().GetSuggestions(gomock.Any(), "star wa").Return(cached, true, nil)
+	mockCache.EXPECT().GetSuggestions(gomock.Any(), "stan").Return(nil, false, fmt.Errorf("redis_error"))
+	mockRepo.EXPECT().Suggest("stan", uint64(suggestLimit)).Return(fresh, nil)
+	mockCache.EXPECT().SetSuggestions(gomock.Any(), "stan", fresh).Return(nil)
+	mockCache.EXPECT().GetSuggestions(gomock.Any(), "x").Return(nil, false, nil)
+	mockRepo.EXPECT().Suggest("x", uint64(suggestLimit)).Return(nil, fmt.Errorf("repo_error"))
+
+	var buff bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buff, nil))
+	core := Core{suggestions: mockRepo, suggestCache: mockCache, lg: logger}
+
+	result, err := core.Suggest(context.Background(), "  Star   WA ")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual(cached, result) {
+		t.Errorf("wanted %v, had %v", cached, result)
+		return
+	}
+
+	result, err = core.Suggest(context.Background(), "stan")
+	if err != nil {
+		t.Errorf("unexpected error %s", err)
+		return
+	}
+	if !reflect.DeepEqual(fresh, result) {
+		t.Errorf("wanted %v, had %v", fresh, result)
+		return
+	}
+
+	_, err = core.Suggest(context.Background(), "x")
+	if err == nil {
+		t.Errorf("wanted error")
+		return
+	}
+
+	result, err = core.Suggest(context.Background(), "   ")
+	if err != nil || len(result) != 0 {
+		t.Errorf("wanted no suggestions, had %v, %v", result, err)
+		return
+	}
+}
@@ -0,0 +1 @@
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS mfa_required_role;
+
+ALTER TABLE profile
+    DROP COLUMN IF EXISTS totp_recovery_codes,
+    DROP COLUMN IF EXISTS totp_enabled,
+    DROP COLUMN IF EXISTS totp_secret;
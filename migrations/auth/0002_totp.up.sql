@@ -0,0 +1,8 @@
+ALTER TABLE profile
+    ADD COLUMN IF NOT EXISTS totp_secret TEXT,
+    ADD COLUMN IF NOT EXISTS totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS totp_recovery_codes TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS mfa_required_role (
+    role TEXT PRIMARY KEY
+);
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS role (
+    name TEXT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS permission (
+    name TEXT PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS role_permission (
+    role TEXT NOT NULL REFERENCES role(name) ON DELETE CASCADE,
+    permission TEXT NOT NULL REFERENCES permission(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+INSERT INTO role(name) VALUES ('user'), ('moderator'), ('admin'), ('super')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO permission(name) VALUES
+    ('films.add'),
+    ('comments.moderate'),
+    ('users.read'),
+    ('users.change_role'),
+    ('mfa.manage_roles')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permission(role, permission) VALUES
+    ('moderator', 'comments.moderate'),
+    ('admin', 'films.add'),
+    ('admin', 'comments.moderate'),
+    ('admin', 'users.read'),
+    ('super', 'films.add'),
+    ('super', 'comments.moderate'),
+    ('super', 'users.read'),
+    ('super', 'users.change_role'),
+    ('super', 'mfa.manage_roles')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS external_identity (
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    profile_id INTEGER NOT NULL REFERENCES profile(id) ON DELETE CASCADE,
+    email TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS external_identity_profile_id_idx ON external_identity(profile_id);
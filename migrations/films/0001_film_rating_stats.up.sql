@@ -0,0 +1,27 @@
+-- users_comment is written by both the films and the comments service,
+-- each keeps the stats in step in the same transaction.
+CREATE TABLE IF NOT EXISTS film_rating_stats (
+    id_film INTEGER PRIMARY KEY,
+    rating_count INTEGER NOT NULL DEFAULT 0,
+    rating_sum INTEGER NOT NULL DEFAULT 0,
+    -- histogram[i] is the number of ratings equal to i.
+    histogram INTEGER[] NOT NULL DEFAULT '{0,0,0,0,0,0,0,0,0,0}'
+);
+
+INSERT INTO film_rating_stats(id_film, rating_count, rating_sum, histogram)
+SELECT id_film, COUNT(*), SUM(rating), ARRAY[
+    COUNT(*) FILTER (WHERE rating = 1),
+    COUNT(*) FILTER (WHERE rating = 2),
+    COUNT(*) FILTER (WHERE rating = 3),
+    COUNT(*) FILTER (WHERE rating = 4),
+    COUNT(*) FILTER (WHERE rating = 5),
+    COUNT(*) FILTER (WHERE rating = 6),
+    COUNT(*) FILTER (WHERE rating = 7),
+    COUNT(*) FILTER (WHERE rating = 8),
+    COUNT(*) FILTER (WHERE rating = 9),
+    COUNT(*) FILTER (WHERE rating = 10)
+]::INTEGER[]
+FROM users_comment
+WHERE rating BETWEEN 1 AND 10
+GROUP BY id_film
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS users_comment_history;
+
+ALTER TABLE users_comment DROP COLUMN IF EXISTS edited_at;
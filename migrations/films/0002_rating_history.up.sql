@@ -0,0 +1,14 @@
+-- edited_at is set when the rating or the comment is changed after it was
+-- added, the values it replaced are kept in users_comment_history.
+ALTER TABLE users_comment ADD COLUMN IF NOT EXISTS edited_at TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS users_comment_history (
+    id SERIAL PRIMARY KEY,
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    rating INTEGER,
+    comment TEXT,
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS users_comment_history_user_film_idx ON users_comment_history(id_user, id_film);
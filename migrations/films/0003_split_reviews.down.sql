@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS users_comment (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    rating INTEGER,
+    comment TEXT,
+    date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP
+);
+
+INSERT INTO users_comment(id_user, id_film, rating, comment, date, edited_at)
+SELECT COALESCE(ratings.id_user, reviews.id_user), COALESCE(ratings.id_film, reviews.id_film),
+    ratings.rating, reviews.body, LEAST(ratings.date, reviews.date), GREATEST(ratings.edited_at, reviews.edited_at)
+FROM ratings
+FULL JOIN reviews ON ratings.id_user = reviews.id_user AND ratings.id_film = reviews.id_film;
+
+ALTER TABLE ratings_history ADD COLUMN comment TEXT;
+ALTER INDEX IF EXISTS ratings_history_user_film_idx RENAME TO users_comment_history_user_film_idx;
+ALTER TABLE ratings_history RENAME TO users_comment_history;
+
+INSERT INTO users_comment_history(id_user, id_film, comment, changed_at)
+SELECT id_user, id_film, body, changed_at
+FROM reviews_history;
+
+DROP TABLE IF EXISTS reviews_history;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS ratings;
@@ -0,0 +1,84 @@
+-- A rating and a review of the same film are separate: ratings belong to
+-- the films service and reviews to the comments service, and a user keeps
+-- at most one of each per film.
+CREATE TABLE IF NOT EXISTS ratings (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 10),
+    date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP,
+    PRIMARY KEY (id_user, id_film)
+);
+
+CREATE INDEX IF NOT EXISTS ratings_film_idx ON ratings(id_film);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    spoiler BOOLEAN NOT NULL DEFAULT FALSE,
+    date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMP,
+    PRIMARY KEY (id_user, id_film)
+);
+
+CREATE INDEX IF NOT EXISTS reviews_film_idx ON reviews(id_film);
+
+-- Only the latest row of a user to a film is kept.
+INSERT INTO ratings(id_user, id_film, rating, date, edited_at)
+SELECT DISTINCT ON (id_user, id_film) id_user, id_film, rating, COALESCE(date, CURRENT_TIMESTAMP), edited_at
+FROM users_comment
+WHERE rating BETWEEN 1 AND 10
+ORDER BY id_user, id_film, date DESC NULLS LAST
+ON CONFLICT DO NOTHING;
+
+INSERT INTO reviews(id_user, id_film, body, date, edited_at)
+SELECT DISTINCT ON (id_user, id_film) id_user, id_film, comment, COALESCE(date, CURRENT_TIMESTAMP), edited_at
+FROM users_comment
+WHERE comment IS NOT NULL AND comment <> ''
+ORDER BY id_user, id_film, date DESC NULLS LAST
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS reviews_history (
+    id SERIAL PRIMARY KEY,
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    spoiler BOOLEAN NOT NULL DEFAULT FALSE,
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS reviews_history_user_film_idx ON reviews_history(id_user, id_film);
+
+INSERT INTO reviews_history(id_user, id_film, body, changed_at)
+SELECT id_user, id_film, comment, changed_at
+FROM users_comment_history
+WHERE comment IS NOT NULL AND comment <> '';
+
+ALTER TABLE users_comment_history RENAME TO ratings_history;
+ALTER INDEX IF EXISTS users_comment_history_user_film_idx RENAME TO ratings_history_user_film_idx;
+DELETE FROM ratings_history WHERE rating IS NULL;
+ALTER TABLE ratings_history DROP COLUMN comment;
+
+-- The rows dropped as duplicates were counted in the stats.
+TRUNCATE film_rating_stats;
+
+INSERT INTO film_rating_stats(id_film, rating_count, rating_sum, histogram)
+SELECT id_film, COUNT(*), SUM(rating), ARRAY[
+    COUNT(*) FILTER (WHERE rating = 1),
+    COUNT(*) FILTER (WHERE rating = 2),
+    COUNT(*) FILTER (WHERE rating = 3),
+    COUNT(*) FILTER (WHERE rating = 4),
+    COUNT(*) FILTER (WHERE rating = 5),
+    COUNT(*) FILTER (WHERE rating = 6),
+    COUNT(*) FILTER (WHERE rating = 7),
+    COUNT(*) FILTER (WHERE rating = 8),
+    COUNT(*) FILTER (WHERE rating = 9),
+    COUNT(*) FILTER (WHERE rating = 10)
+]::INTEGER[]
+FROM ratings
+GROUP BY id_film;
+
+DROP TABLE users_comment;
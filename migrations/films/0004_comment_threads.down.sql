@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS comment_reactions;
+DROP TABLE IF EXISTS comment_replies;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS reply_count;
+ALTER TABLE reviews DROP COLUMN IF EXISTS dislikes;
+ALTER TABLE reviews DROP COLUMN IF EXISTS likes;
+ALTER TABLE reviews DROP COLUMN IF EXISTS id;
@@ -0,0 +1,28 @@
+-- Reviews are the top level comments of a film. Replies and reactions
+-- point at them by id, and the counters on reviews are kept in step by
+-- the comments service in the same transaction.
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS id BIGSERIAL UNIQUE;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS likes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS dislikes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS reply_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS comment_replies (
+    id BIGSERIAL PRIMARY KEY,
+    parent_id BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    id_user INTEGER NOT NULL,
+    body TEXT NOT NULL,
+    date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS comment_replies_parent_idx ON comment_replies(parent_id, date);
+CREATE INDEX IF NOT EXISTS comment_replies_user_idx ON comment_replies(id_user);
+
+-- value is 1 for a like and -1 for a dislike.
+CREATE TABLE IF NOT EXISTS comment_reactions (
+    id_comment BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    id_user INTEGER NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    PRIMARY KEY (id_comment, id_user)
+);
+
+CREATE INDEX IF NOT EXISTS comment_reactions_user_idx ON comment_reactions(id_user);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS moderation_log;
+DROP TABLE IF EXISTS comment_reports;
+
+ALTER TABLE reviews DROP COLUMN IF EXISTS hidden;
@@ -0,0 +1,30 @@
+-- Hidden reviews stay in the database but are not shown. Reports wait in
+-- the queue until a moderator acts on the review, and every action is
+-- kept in moderation_log. The log keeps plain ids, so that it outlives
+-- the deleted reviews and users.
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS comment_reports (
+    id_comment BIGINT NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    id_user INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (id_comment, id_user)
+);
+
+CREATE INDEX IF NOT EXISTS comment_reports_open_idx ON comment_reports(id_comment) WHERE NOT resolved;
+CREATE INDEX IF NOT EXISTS comment_reports_user_idx ON comment_reports(id_user);
+
+CREATE TABLE IF NOT EXISTS moderation_log (
+    id BIGSERIAL PRIMARY KEY,
+    id_moderator INTEGER NOT NULL,
+    id_comment BIGINT NOT NULL,
+    id_user INTEGER NOT NULL,
+    id_film INTEGER NOT NULL,
+    action TEXT NOT NULL CHECK (action IN ('hide', 'restore', 'delete')),
+    reason TEXT NOT NULL,
+    date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS moderation_log_comment_idx ON moderation_log(id_comment);
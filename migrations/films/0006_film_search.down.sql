@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS film_title_trgm_idx;
+DROP INDEX IF EXISTS film_search_vector_idx;
+
+ALTER TABLE film DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,11 @@
+-- Film search matches the weighted title and info against a websearch
+-- query and falls back to trigram similarity of the title for typos.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE film ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', COALESCE(title, '')), 'A') ||
+    setweight(to_tsvector('russian', COALESCE(info, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS film_search_vector_idx ON film USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS film_title_trgm_idx ON film USING GIN (title gin_trgm_ops);
@@ -0,0 +1,15 @@
+-- search_suggestions is the prefix index of the films and people, used by
+-- the suggest endpoint and the actor search. The films service refreshes
+-- it periodically and precomputes the popularity of the rows then.
+CREATE TABLE IF NOT EXISTS search_suggestions (
+    kind TEXT NOT NULL CHECK (kind IN ('film', 'person')),
+    id_item INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    thumbnail TEXT NOT NULL DEFAULT '',
+    popularity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    search_key tsvector GENERATED ALWAYS AS (to_tsvector('simple', title)) STORED,
+    PRIMARY KEY (kind, id_item)
+);
+
+CREATE INDEX IF NOT EXISTS search_suggestions_key_idx ON search_suggestions USING GIN (search_key);
+CREATE INDEX IF NOT EXISTS search_suggestions_popularity_idx ON search_suggestions(popularity DESC);
@@ -0,0 +1,7 @@
+-- film_views counts the users who have seen a film, a view is counted the
+-- first time it is recorded in the nearfilms of the user. The popularity
+-- sort of the film lists adds it to the number of ratings.
+CREATE TABLE IF NOT EXISTS film_views (
+    id_film INTEGER PRIMARY KEY,
+    views BIGINT NOT NULL DEFAULT 0
+);
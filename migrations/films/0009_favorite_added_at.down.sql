@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_favorite_film_added_idx;
+
+ALTER TABLE users_favorite_film DROP COLUMN IF EXISTS added_at;
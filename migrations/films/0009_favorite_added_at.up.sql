@@ -0,0 +1,6 @@
+-- added_at is when the user added the film to the favorites, the recently
+-- added sort of the favorites goes by it. The films added before it was
+-- recorded keep the time of the migration.
+ALTER TABLE users_favorite_film ADD COLUMN IF NOT EXISTS added_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS users_favorite_film_added_idx ON users_favorite_film(id_user, added_at, id_film);
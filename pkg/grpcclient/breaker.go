@@ -0,0 +1,86 @@
+package grpcclient
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrOpen is returned without calling the service while the breaker is open.
+var ErrOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+// IsFailure reports whether err means the service is in trouble. Errors
+// the service answers on purpose, like NOT_FOUND, do not count.
+func IsFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
+
+type breakerState int
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+// Breaker opens after failures failures in a row. While open, calls fail
+// fast; after timeout one probe call is let through, and its result
+// closes or reopens the breaker.
+type Breaker struct {
+	mutex    sync.Mutex
+	failures int
+	timeout  time.Duration
+	now      func() time.Time
+
+	state    breakerState
+	failed   int
+	openedAt time.Time
+}
+
+func NewBreaker(failures int, timeout time.Duration) *Breaker {
+	return &Breaker{failures: failures, timeout: timeout, now: time.Now}
+}
+
+// Allow reports whether a call may be made. Every allowed call must be
+// followed by Done.
+func (b *Breaker) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case open:
+		if b.now().Sub(b.openedAt) < b.timeout {
+			return false
+		}
+		b.state = halfOpen
+		return true
+	case halfOpen:
+		return false
+	}
+
+	return true
+}
+
+func (b *Breaker) Done(failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !failed {
+		b.state = closed
+		b.failed = 0
+		return
+	}
+
+	b.failed++
+	if b.state == halfOpen || b.failed >= b.failures {
+		b.state = open
+		b.openedAt = b.now()
+	}
+}
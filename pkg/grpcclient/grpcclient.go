@@ -0,0 +1,206 @@
+// Package grpcclient dials the gRPC APIs of the other services with the
+// deadlines, retries, circuit breaker, TLS and telemetry every
+// service-to-service call should have.
+package grpcclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/grpcserver"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/metrics"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTimeout         = 3 * time.Second
+	defaultInitialBackoff  = 100 * time.Millisecond
+	defaultMaxBackoff      = time.Second
+	defaultBreakerFailures = 5
+	defaultBreakerTimeout  = 10 * time.Second
+)
+
+var (
+	metricsOnce   sync.Once
+	clientMetrics *metrics.GrpcClientMetrics
+)
+
+// Dial connects to target. The connection is lazy, so a service that is
+// down at start is picked up once it comes back.
+func Dial(target string, cfg configs.GrpcClientCfg, lg *slog.Logger) (*grpc.ClientConn, error) {
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("grpc dial err: %w", err)
+	}
+
+	metricsOnce.Do(func() {
+		clientMetrics = metrics.GetGrpcClientMetrics()
+	})
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	breaker := NewBreaker(orDefault(cfg.BreakerFailures, defaultBreakerFailures), orDefaultDuration(cfg.BreakerTimeout, defaultBreakerTimeout))
+
+	conn, err := grpc.Dial(target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig(cfg)),
+		grpc.WithChainUnaryInterceptor(
+			contextInterceptor,
+			secretInterceptor(cfg.Secret),
+			telemetryInterceptor(lg.With("target", target), clientMetrics),
+			breakerInterceptor(breaker),
+			timeoutInterceptor(timeout),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("grpc dial err: %w", err)
+	}
+
+	return conn, nil
+}
+
+// serviceConfig retries calls failed with UNAVAILABLE, which is what a
+// restarting or overloaded service answers. Other codes are not retried,
+// because the call may have been done.
+func serviceConfig(cfg configs.GrpcClientCfg) string {
+	attempts := cfg.MaxAttempts
+	if attempts <= 1 {
+		return `{"methodConfig": [{"name": [{}]}]}`
+	}
+
+	return fmt.Sprintf(`{"methodConfig": [{"name": [{}], "retryPolicy": {`+
+		`"maxAttempts": %d, "initialBackoff": "%.3fs", "maxBackoff": "%.3fs", `+
+		`"backoffMultiplier": 2, "retryableStatusCodes": ["UNAVAILABLE"]}}]}`,
+		attempts,
+		orDefaultDuration(cfg.InitialBackoff, defaultInitialBackoff).Seconds(),
+		orDefaultDuration(cfg.MaxBackoff, defaultMaxBackoff).Seconds())
+}
+
+func transportCredentials(cfg configs.GrpcClientCfg) (credentials.TransportCredentials, error) {
+	if cfg.CaFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	ca, err := os.ReadFile(cfg.CaFile)
+	if err != nil {
+		return nil, fmt.Errorf("read ca err: %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(ca) {
+		return nil, errors.New("no certificates in ca file")
+	}
+
+	tlsConfig := &tls.Config{
+		RootCAs:    roots,
+		ServerName: cfg.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate err: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// contextInterceptor passes the user and request ids on to the service.
+func contextInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if userId, ok := ctx.Value(middleware.UserIDKey).(uint64); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcserver.UserIDHeader, strconv.FormatUint(userId, 10))
+	}
+	if requestId, ok := ctx.Value(middleware.RequestIDKey).(string); ok && requestId != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcserver.RequestIDHeader, requestId)
+	}
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// secretInterceptor sends the service secret, if set, with every call.
+func secretInterceptor(secret string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if secret != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, grpcserver.SecretHeader, secret)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// timeoutInterceptor gives calls without a deadline the configured one.
+// The deadline covers all the retries of the call.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, found := ctx.Deadline(); !found {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func breakerInterceptor(breaker *Breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !breaker.Allow() {
+			return ErrOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		breaker.Done(IsFailure(err))
+
+		return err
+	}
+}
+
+func telemetryInterceptor(lg *slog.Logger, m *metrics.GrpcClientMetrics) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		code := status.Code(err).String()
+
+		m.Time.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+		m.Hits.WithLabelValues(method, code).Inc()
+
+		if IsFailure(err) {
+			lg.Error("grpc call error", "method", method, "code", code, "time", time.Since(start).String(), "err", err.Error())
+		}
+
+		return err
+	}
+}
+
+func orDefault(value int, def int) int {
+	if value <= 0 {
+		return def
+	}
+
+	return value
+}
+
+func orDefaultDuration(value time.Duration, def time.Duration) time.Duration {
+	if value <= 0 {
+		return def
+	}
+
+	return value
+}
@@ -0,0 +1,110 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+	breaker := NewBreaker(2, time.Second)
+	breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if !breaker.Allow() {
+			t.Errorf("closed breaker should allow calls")
+			return
+		}
+		breaker.Done(true)
+	}
+
+	if breaker.Allow() {
+		t.Errorf("breaker should open after 2 failures")
+		return
+	}
+
+	now = now.Add(time.Second)
+	if !breaker.Allow() {
+		t.Errorf("breaker should let a probe through after the timeout")
+		return
+	}
+	if breaker.Allow() {
+		t.Errorf("breaker should let only one probe through")
+		return
+	}
+	breaker.Done(true)
+
+	if breaker.Allow() {
+		t.Errorf("failed probe should reopen the breaker")
+		return
+	}
+
+	now = now.Add(time.Second)
+	if !breaker.Allow() {
+		t.Errorf("breaker should let a probe through after the timeout")
+		return
+	}
+	breaker.Done(false)
+
+	if !breaker.Allow() {
+		t.Errorf("successful probe should close the breaker")
+		return
+	}
+}
+
+func TestIsFailure(t *testing.T) {
+	if IsFailure(nil) || IsFailure(status.Error(codes.NotFound, "")) || IsFailure(status.Error(codes.Unauthenticated, "")) {
+		t.Errorf("answers of the service should not be failures")
+		return
+	}
+	if !IsFailure(status.Error(codes.Unavailable, "")) || !IsFailure(errors.New("e")) {
+		t.Errorf("unavailable and unknown errors should be failures")
+		return
+	}
+}
+
+func TestDial(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cant listen: %s", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	conn, err := Dial(addr, configs.GrpcClientCfg{
+		Timeout:         time.Second,
+		MaxAttempts:     2,
+		InitialBackoff:  time.Millisecond,
+		BreakerFailures: 1,
+		BreakerTimeout:  time.Minute,
+	}, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	defer conn.Close()
+
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if status.Code(err) != codes.Unavailable || errors.Is(err, ErrOpen) {
+		t.Errorf("wanted unavailable from the service, had %v", err)
+		return
+	}
+
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if !errors.Is(err, ErrOpen) {
+		t.Errorf("wanted open breaker, had %v", err)
+		return
+	}
+}
@@ -0,0 +1,175 @@
+// Package grpcserver builds the gRPC servers of the services: every call
+// is logged, measured and recovered from panics, internal methods are kept
+// to the other services, and the health service and server reflection are
+// registered.
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/metrics"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys the services pass the request context in.
+const (
+	UserIDHeader    = "x-user-id"
+	RequestIDHeader = "x-request-id"
+	// SecretHeader carries the secret shared by the services.
+	SecretHeader = "x-service-secret"
+)
+
+// Internal keeps Methods, given as full method names, to the other
+// services: their calls carry Secret in SecretHeader. With an empty Secret
+// the methods are refused to everyone.
+type Internal struct {
+	Secret  string
+	Methods []string
+}
+
+var (
+	metricsOnce   sync.Once
+	serverMetrics *metrics.GrpcServerMetrics
+)
+
+type loggerKey struct{}
+
+// Logger returns the logger of the call, with its method and request id.
+func Logger(ctx context.Context, lg *slog.Logger) *slog.Logger {
+	if callLogger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return callLogger
+	}
+
+	return lg
+}
+
+// NewServer returns a server with the interceptors. The services register
+// themselves on it; the health service reports SERVING for the server as a
+// whole.
+func NewServer(lg *slog.Logger, internal Internal, opts ...grpc.ServerOption) *grpc.Server {
+	metricsOnce.Do(func() {
+		serverMetrics = metrics.GetGrpcServerMetrics()
+	})
+
+	if internal.Secret == "" && len(internal.Methods) > 0 {
+		lg.Warn("service secret is not set, internal methods are refused", "methods", internal.Methods)
+	}
+
+	opts = append(opts, grpc.ChainUnaryInterceptor(
+		requestInterceptor(lg, serverMetrics, internal.Secret),
+		recoveryInterceptor,
+		internalInterceptor(internal),
+	))
+	s := grpc.NewServer(opts...)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(s, healthServer)
+	reflection.Register(s)
+
+	return s
+}
+
+// requestInterceptor puts the request id the caller sent into the context,
+// then logs and measures the call. The user id is taken only from the other
+// services, which present the secret, so that a client cannot act as
+// another user by sending it.
+func requestInterceptor(lg *slog.Logger, m *metrics.GrpcServerMetrics, secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestId := first(md, RequestIDHeader)
+		if requestId == "" {
+			requestId = middleware.NewRequestID()
+		}
+		ctx = context.WithValue(ctx, middleware.RequestIDKey, requestId)
+
+		if fromService(ctx, secret) {
+			if userId, err := strconv.ParseUint(first(md, UserIDHeader), 10, 64); err == nil {
+				ctx = context.WithValue(ctx, middleware.UserIDKey, userId)
+			}
+		}
+
+		callLogger := lg.With("method", info.FullMethod, "request_id", requestId)
+		ctx = context.WithValue(ctx, loggerKey{}, callLogger)
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		m.Time.WithLabelValues(info.FullMethod, code.String()).Observe(time.Since(start).Seconds())
+		m.Hits.WithLabelValues(info.FullMethod, code.String()).Inc()
+
+		switch code {
+		case codes.OK:
+			callLogger.Info("grpc request", "time", time.Since(start).String())
+		case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+			callLogger.Error("grpc request", "code", code.String(), "time", time.Since(start).String(), "err", err.Error())
+		default:
+			callLogger.Info("grpc request", "code", code.String(), "time", time.Since(start).String())
+		}
+
+		return resp, err
+	}
+}
+
+// recoveryInterceptor answers INTERNAL instead of crashing the service.
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			Logger(ctx, slog.Default()).Error("grpc panic", "panic", fmt.Sprint(recovered), "stack", string(debug.Stack()))
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// internalInterceptor answers PERMISSION_DENIED to internal method calls
+// without the service secret.
+func internalInterceptor(internal Internal) grpc.UnaryServerInterceptor {
+	methods := make(map[string]bool, len(internal.Methods))
+	for _, method := range internal.Methods {
+		methods[method] = true
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if methods[info.FullMethod] && !fromService(ctx, internal.Secret) {
+			return nil, status.Error(codes.PermissionDenied, "internal method")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// fromService reports whether the call carries the service secret.
+func fromService(ctx context.Context, secret string) bool {
+	if secret == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	return subtle.ConstantTimeCompare([]byte(first(md, SecretHeader)), []byte(secret)) == 1
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
@@ -0,0 +1,140 @@
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/metrics"
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Panic"}
+
+	_, err := recoveryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("wanted Internal, had %v", err)
+		return
+	}
+}
+
+func TestRequestInterceptor(t *testing.T) {
+	metricsOnce.Do(func() {
+		serverMetrics = metrics.GetGrpcServerMetrics()
+	})
+	interceptor := requestInterceptor(slog.New(slog.NewJSONHandler(os.Stdout, nil)), serverMetrics, "s1")
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Call"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserIDHeader, "7", RequestIDHeader, "r1", SecretHeader, "s1"))
+
+	var userId interface{}
+	var requestId interface{}
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		userId = ctx.Value(middleware.UserIDKey)
+		requestId = ctx.Value(middleware.RequestIDKey)
+		return nil, nil
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if userId != uint64(7) || requestId != "r1" {
+		t.Errorf("context was not propagated, had user %v and request %v", userId, requestId)
+		return
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserIDHeader, "7", RequestIDHeader, "r2"))
+	_, _ = interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		userId = ctx.Value(middleware.UserIDKey)
+		requestId = ctx.Value(middleware.RequestIDKey)
+		return nil, nil
+	})
+	if userId != nil || requestId != "r2" {
+		t.Errorf("wanted the user of a client call to be dropped, had user %v and request %v", userId, requestId)
+		return
+	}
+
+	_, _ = interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		userId = ctx.Value(middleware.UserIDKey)
+		requestId = ctx.Value(middleware.RequestIDKey)
+		return nil, nil
+	})
+	if userId != nil || requestId == "" {
+		t.Errorf("wanted no user and a new request id, had user %v and request %v", userId, requestId)
+		return
+	}
+}
+
+func TestInternalInterceptor(t *testing.T) {
+	interceptor := internalInterceptor(Internal{Secret: "s1", Methods: []string{"/test/Internal"}})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	testCases := map[string]struct {
+		method string
+		md     metadata.MD
+		code   codes.Code
+	}{
+		"Public method":   {method: "/test/Call", md: metadata.Pairs(), code: codes.OK},
+		"No secret":       {method: "/test/Internal", md: metadata.Pairs(), code: codes.PermissionDenied},
+		"Wrong secret":    {method: "/test/Internal", md: metadata.Pairs(SecretHeader, "s2"), code: codes.PermissionDenied},
+		"Service call":    {method: "/test/Internal", md: metadata.Pairs(SecretHeader, "s1"), code: codes.OK},
+		"Secret in other": {method: "/test/Internal", md: metadata.Pairs(UserIDHeader, "s1"), code: codes.PermissionDenied},
+	}
+
+	for name, curr := range testCases {
+		ctx := metadata.NewIncomingContext(context.Background(), curr.md)
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: curr.method}, handler)
+		if status.Code(err) != curr.code {
+			t.Errorf("%s: wanted %s, had %v", name, curr.code, err)
+			return
+		}
+	}
+
+	_, err := internalInterceptor(Internal{Methods: []string{"/test/Internal"}})(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Internal"}, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("wanted PermissionDenied without a secret, had %v", err)
+		return
+	}
+}
+
+func TestHealth(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cant listen: %s", err)
+	}
+
+	s := NewServer(slog.New(slog.NewJSONHandler(os.Stdout, nil)), Internal{})
+	go func() {
+		_ = s.Serve(listener)
+	}()
+	defer s.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("cant dial: %s", err)
+	}
+	defer conn.Close()
+
+	response, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if response.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		t.Errorf("wanted SERVING, had %s", response.Status)
+		return
+	}
+}
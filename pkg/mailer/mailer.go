@@ -0,0 +1,194 @@
+package mailer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+const defaultQueueSize = 100
+
+var ErrQueueFull = errors.New("mail queue is full")
+
+// GetMailer returns the mailer selected by cfg.Driver. Letters are sent in
+// the background, so requests do not wait for the mail server.
+func GetMailer(cfg configs.MailCfg, lg *slog.Logger) (Mailer, error) {
+	mailer, err := getDriver(cfg, lg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAsyncMailer(mailer, cfg.QueueSize, lg), nil
+}
+
+func getDriver(cfg configs.MailCfg, lg *slog.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return &SMTPMailer{cfg: cfg}, nil
+	case "log", "":
+		path := cfg.Path
+		if path == "" {
+			path = "mail.log"
+		}
+
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			lg.Error("open mail log error", "err", err.Error())
+			return nil, fmt.Errorf("get mailer err: %w", err)
+		}
+
+		return NewLogMailer(file, cfg.From), nil
+	default:
+		return nil, fmt.Errorf("get mailer err: unknown driver %q", cfg.Driver)
+	}
+}
+
+func message(from string, to string, subject string, body string) []byte {
+	var s strings.Builder
+	s.WriteString("From: " + from + "\r\n")
+	s.WriteString("To: " + to + "\r\n")
+	s.WriteString("Subject: " + subject + "\r\n")
+	s.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	s.WriteString("MIME-Version: 1.0\r\n")
+	s.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	s.WriteString("\r\n")
+	s.WriteString(body)
+
+	return []byte(s.String())
+}
+
+// SMTPMailer sends letters through an SMTP server with PLAIN auth.
+type SMTPMailer struct {
+	cfg configs.MailCfg
+}
+
+func (m *SMTPMailer) Send(to string, subject string, body string) error {
+	if strings.ContainsAny(to, "\r\n") || strings.ContainsAny(subject, "\r\n") {
+		return fmt.Errorf("smtp send err: invalid header")
+	}
+
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	err := smtp.SendMail(addr, auth, m.cfg.From, []string{to}, message(m.cfg.From, to, subject, body))
+	if err != nil {
+		return fmt.Errorf("smtp send err: %w", err)
+	}
+
+	return nil
+}
+
+// LogMailer writes letters to out instead of sending them. It is meant
+// for development and tests.
+type LogMailer struct {
+	mutex sync.Mutex
+	out   io.Writer
+	from  string
+}
+
+func NewLogMailer(out io.Writer, from string) *LogMailer {
+	return &LogMailer{out: out, from: from}
+}
+
+func (m *LogMailer) Send(to string, subject string, body string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	_, err := m.out.Write(append(message(m.from, to, subject, body), "\r\n\r\n"...))
+	if err != nil {
+		return fmt.Errorf("log send err: %w", err)
+	}
+
+	return nil
+}
+
+type letter struct {
+	to      string
+	subject string
+	body    string
+}
+
+// AsyncMailer queues letters and sends them through next in a background
+// worker. Letters that cannot be sent are logged and dropped.
+type AsyncMailer struct {
+	next   Mailer
+	lg     *slog.Logger
+	mutex  sync.RWMutex
+	closed bool
+	queue  chan letter
+	done   chan struct{}
+}
+
+func NewAsyncMailer(next Mailer, size int, lg *slog.Logger) *AsyncMailer {
+	if size <= 0 {
+		size = defaultQueueSize
+	}
+
+	m := &AsyncMailer{
+		next:  next,
+		lg:    lg,
+		queue: make(chan letter, size),
+		done:  make(chan struct{}),
+	}
+	go m.run()
+
+	return m
+}
+
+// Send queues the letter. It fails only when the queue is full or closed.
+func (m *AsyncMailer) Send(to string, subject string, body string) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if m.closed {
+		return ErrQueueFull
+	}
+
+	select {
+	case m.queue <- letter{to: to, subject: subject, body: body}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+func (m *AsyncMailer) run() {
+	defer close(m.done)
+
+	for l := range m.queue {
+		err := m.next.Send(l.to, l.subject, l.body)
+		if err != nil {
+			m.lg.Error("send mail error", "err", err.Error())
+		}
+	}
+}
+
+// Close stops accepting letters and waits until the queued ones are sent.
+func (m *AsyncMailer) Close() {
+	m.mutex.Lock()
+	if !m.closed {
+		m.closed = true
+		close(m.queue)
+	}
+	m.mutex.Unlock()
+
+	<-m.done
+}
@@ -0,0 +1,82 @@
+package mailer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+func TestLogMailer(t *testing.T) {
+	var out bytes.Buffer
+	mailer := NewLogMailer(&out, "noreply@movie-hub.ru")
+
+	err := mailer.Send("user@mail.ru", "Subject", "Body")
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	letter := out.String()
+	for _, part := range []string{"From: noreply@movie-hub.ru\r\n", "To: user@mail.ru\r\n", "Subject: Subject\r\n", "\r\n\r\nBody"} {
+		if !strings.Contains(letter, part) {
+			t.Errorf("letter %q does not contain %q", letter, part)
+			return
+		}
+	}
+}
+
+func TestSMTPMailerHeaderInjection(t *testing.T) {
+	mailer := &SMTPMailer{cfg: configs.MailCfg{Host: "localhost", Port: 25}}
+
+	err := mailer.Send("user@mail.ru\r\nBcc: other@mail.ru", "Subject", "Body")
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
+
+type failingMailer struct{}
+
+func (failingMailer) Send(to string, subject string, body string) error {
+	return errors.New("smtp is down")
+}
+
+func TestAsyncMailer(t *testing.T) {
+	var out bytes.Buffer
+	lg := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	mailer := NewAsyncMailer(NewLogMailer(&out, "noreply@movie-hub.ru"), 2, lg)
+
+	for i := 0; i < 2; i++ {
+		err := mailer.Send("user@mail.ru", "Subject", "Body")
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+			return
+		}
+	}
+
+	mailer.Close()
+
+	if count := strings.Count(out.String(), "To: user@mail.ru\r\n"); count != 2 {
+		t.Errorf("expected 2 letters, got %d", count)
+		return
+	}
+
+	err := mailer.Send("user@mail.ru", "Subject", "Body")
+	if !errors.Is(err, ErrQueueFull) {
+		t.Errorf("expected closed mailer to refuse letters, got %v", err)
+		return
+	}
+
+	failing := NewAsyncMailer(failingMailer{}, 1, lg)
+	err = failing.Send("user@mail.ru", "Subject", "Body")
+	failing.Close()
+	if err != nil {
+		t.Errorf("failures of the driver must not reach the caller, got %s", err)
+		return
+	}
+}
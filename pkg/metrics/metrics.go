@@ -18,7 +18,7 @@ func GetMetrics() *Metrics {
 			Help:    "Request work time.",
 			Buckets: prometheus.LinearBuckets(0, 100, 6),
 		}, description),
- 
+
 		Hits: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "Hits_Req",
 			Help: "Step",
@@ -29,3 +29,84 @@ func GetMetrics() *Metrics {
 
 	return metrics
 }
+
+type AuthMetrics struct {
+	SigninFailures prometheus.Counter
+	Lockouts       *prometheus.CounterVec
+	Throttled      prometheus.Counter
+}
+
+func GetAuthMetrics() *AuthMetrics {
+	metrics := &AuthMetrics{
+		SigninFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "Signin_Failures",
+			Help: "Failed sign in attempts.",
+		}),
+
+		Lockouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "Signin_Lockouts",
+			Help: "Sign in lockouts by limited key.",
+		}, []string{"scope"}),
+
+		Throttled: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "Signin_Throttled",
+			Help: "Sign in attempts rejected while locked out.",
+		}),
+	}
+
+	prometheus.MustRegister(metrics.SigninFailures, metrics.Lockouts, metrics.Throttled)
+
+	return metrics
+}
+
+type GrpcClientMetrics struct {
+	Time *prometheus.HistogramVec
+	Hits *prometheus.CounterVec
+}
+
+func GetGrpcClientMetrics() *GrpcClientMetrics {
+	description := []string{"method", "code"}
+
+	metrics := &GrpcClientMetrics{
+		Time: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "Grpc_Client_Time",
+			Help:    "Outgoing gRPC call time in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}, description),
+
+		Hits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "Grpc_Client_Hits",
+			Help: "Outgoing gRPC calls.",
+		}, description),
+	}
+
+	prometheus.MustRegister(metrics.Time, metrics.Hits)
+
+	return metrics
+}
+
+type GrpcServerMetrics struct {
+	Time *prometheus.HistogramVec
+	Hits *prometheus.CounterVec
+}
+
+func GetGrpcServerMetrics() *GrpcServerMetrics {
+	description := []string{"method", "code"}
+
+	metrics := &GrpcServerMetrics{
+		Time: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "Grpc_Server_Time",
+			Help:    "Incoming gRPC call time in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}, description),
+
+		Hits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "Grpc_Server_Hits",
+			Help: "Incoming gRPC calls.",
+		}, description),
+	}
+
+	prometheus.MustRegister(metrics.Time, metrics.Hits)
+
+	return metrics
+}
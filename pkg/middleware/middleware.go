@@ -2,21 +2,101 @@ package middleware
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"log/slog"
 	"net/http"
+	"strings"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/pkg/requests"
+	"github.com/mailru/easyjson"
 )
 
 type contextKey string
 
-const UserIDKey contextKey = "userId"
+const (
+	UserIDKey contextKey = "userId"
+	// RequestIDKey holds the id of the request a gRPC call is made for, so
+	// that the logs of all the services it touches can be matched.
+	RequestIDKey contextKey = "requestId"
+)
+
+// RequestIDHeader carries the id of the request over HTTP.
+const RequestIDHeader = "X-Request-Id"
+
+// NewRequestID returns a random request id.
+func NewRequestID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
+
+// validRequestID accepts the ids a proxy may send: short and made of
+// letters, digits, dashes and underscores, so they are safe to log.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RequestID puts the id of the request into the context, so that the gRPC
+// calls made for it carry it on. The id a proxy sent is kept, otherwise a
+// new one is made; either way it is returned in the response.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestId := r.Header.Get(RequestIDHeader)
+		if !validRequestID(requestId) {
+			requestId = NewRequestID()
+		}
+
+		w.Header().Set(RequestIDHeader, requestId)
+		r = r.WithContext(context.WithValue(r.Context(), RequestIDKey, requestId))
+
+		next.ServeHTTP(w, r)
+	})
+}
 
 type Core interface {
 	GetUserId(ctx context.Context, sid string) (uint64, error)
+	VerifyAccessToken(ctx context.Context, accessToken string) (uint64, error)
+}
+
+// accessToken returns the access token from the Authorization header or,
+// for browsers, from the access_token cookie.
+func accessToken(r *http.Request) string {
+	if bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		return bearer
+	}
+
+	cookie, err := r.Cookie("access_token")
+	if err != nil {
+		return ""
+	}
+
+	return cookie.Value
 }
 
 func AuthCheck(next http.Handler, core Core, lg *slog.Logger) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := accessToken(r); token != "" {
+			userId, err := core.VerifyAccessToken(r.Context(), token)
+			if err == nil {
+				r = r.WithContext(context.WithValue(r.Context(), UserIDKey, userId))
+				next.ServeHTTP(w, r)
+				return
+			}
+			lg.Debug("access token rejected", "err", err.Error())
+		}
+
 		session, err := r.Cookie("session_id")
 		if errors.Is(err, http.ErrNoCookie) {
 			next.ServeHTTP(w, r)
@@ -35,3 +115,48 @@ func AuthCheck(next http.Handler, core Core, lg *slog.Logger) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+type PermissionCore interface {
+	CheckPermission(ctx context.Context, userId uint64, permission string) (bool, error)
+}
+
+// deny answers the way the handlers do: with the status in the body.
+func deny(w http.ResponseWriter, status int, lg *slog.Logger) {
+	jsonResponse, err := easyjson.Marshal(requests.Response{Status: status, Body: nil})
+	if err != nil {
+		lg.Error("failed to pack json", "err", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err = w.Write(jsonResponse); err != nil {
+		lg.Error("failed to send response", "err", err.Error())
+	}
+}
+
+// RequirePermission lets the request through only if the role of the user
+// grants the permission. It must be wrapped in AuthCheck, which puts the
+// user into the context.
+func RequirePermission(next http.Handler, core PermissionCore, permission string, lg *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userId, ok := r.Context().Value(UserIDKey).(uint64)
+		if !ok {
+			deny(w, http.StatusUnauthorized, lg)
+			return
+		}
+
+		allowed, err := core.CheckPermission(r.Context(), userId, permission)
+		if err != nil {
+			lg.Error("check permission error", "err", err.Error())
+			deny(w, http.StatusInternalServerError, lg)
+			return
+		}
+		if !allowed {
+			deny(w, http.StatusForbidden, lg)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	testCases := map[string]struct {
+		header string
+		keep   bool
+	}{
+		"No id":      {header: "", keep: false},
+		"Proxy id":   {header: "abc-123_X", keep: true},
+		"Unsafe id":  {header: "abc\ndef", keep: false},
+		"Too long":   {header: "0123456789012345678901234567890123456789012345678901234567890123456789", keep: false},
+		"Spaces":     {header: "a b", keep: false},
+		"Hex id":     {header: "0f0f0f0f0f0f0f0f", keep: true},
+		"Dashes id":  {header: "----", keep: true},
+		"Unicode id": {header: "идентификатор", keep: false},
+	}
+
+	for name, curr := range testCases {
+		var seen string
+		handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen, _ = r.Context().Value(RequestIDKey).(string)
+		}))
+
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if curr.header != "" {
+			r.Header.Set(RequestIDHeader, curr.header)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		if seen == "" || w.Header().Get(RequestIDHeader) != seen {
+			t.Errorf("%s: wanted the id in the context and the response, had %q and %q", name, seen, w.Header().Get(RequestIDHeader))
+			return
+		}
+		if (seen == curr.header) != curr.keep {
+			t.Errorf("%s: wanted keep %t, had %q", name, curr.keep, seen)
+			return
+		}
+	}
+}
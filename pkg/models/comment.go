@@ -1,11 +1,45 @@
 package models
 
+// CommentItem is a review of a film. The rating of the film is kept apart,
+// in RatingItem.
+//
 //easyjson:json
 type CommentItem struct {
+	Id         uint64 `json:"id"`
+	IdUser     uint64 `json:"id_user"`
+	Username   string `json:"name"`
+	IdFilm     uint64 `json:"id_film"`
+	Title      string `json:"title"`
+	Comment    string `json:"text"`
+	Spoiler    bool   `json:"spoiler"`
+	Photo      string `json:"photo"`
+	EditedAt   string `json:"edited_at,omitempty"`
+	Likes      uint64 `json:"likes"`
+	Dislikes   uint64 `json:"dislikes"`
+	ReplyCount uint64 `json:"reply_count"`
+	// Reaction is the one of the current user: 1, -1 or 0 for none.
+	Reaction int8 `json:"reaction"`
+}
+
+//easyjson:json
+type ReplyItem struct {
+	Id       uint64 `json:"id"`
+	ParentId uint64 `json:"parent_id"`
 	IdUser   uint64 `json:"id_user"`
 	Username string `json:"name"`
-	IdFilm   uint64 `json:"id_film"`
-	Rating   uint16 `json:"rating"`
-	Comment  string `json:"text"`
 	Photo    string `json:"photo"`
+	Text     string `json:"text"`
+	Date     string `json:"date"`
+}
+
+// ReportedComment is a review waiting in the moderation queue with the
+// reports that are not resolved yet.
+//
+//easyjson:json
+type ReportedComment struct {
+	Comment      CommentItem `json:"comment"`
+	Hidden       bool        `json:"hidden"`
+	ReportCount  uint64      `json:"report_count"`
+	LastReported string      `json:"last_reported"`
+	Reasons      []string    `json:"reasons"`
 }
@@ -4,5 +4,6 @@ import "time"
 
 type Csrf struct {
 	SID       string
+	Session   string
 	ExpiresAt time.Time
 }
@@ -0,0 +1,28 @@
+package models
+
+//easyjson:json
+type (
+	// FacetBucket is a value of a search facet and the number of the found
+	// items with it. Id is set for the values filtered by id.
+	FacetBucket struct {
+		Id    uint64 `json:"id,omitempty"`
+		Value string `json:"value"`
+		Count uint64 `json:"count"`
+	}
+
+	// FilmFacets break the found films down. A decade is its first year, a
+	// rating band is the integer part of the rating or "none" for films
+	// without ratings.
+	FilmFacets struct {
+		Genres    []FacetBucket `json:"genres"`
+		Mpaa      []FacetBucket `json:"mpaa"`
+		Countries []FacetBucket `json:"countries"`
+		Decades   []FacetBucket `json:"decades"`
+		Ratings   []FacetBucket `json:"ratings"`
+	}
+
+	ActorFacets struct {
+		Countries   []FacetBucket `json:"countries"`
+		Professions []FacetBucket `json:"professions"`
+	}
+)
@@ -10,6 +10,9 @@ type FilmItem struct {
 	Country     string  `json:"country"`
 	Mpaa        string  `json:"mpaa"`
 	Rating      float64 `json:"rating"`
+	// Snippet is the part of the info matched by a search, with the
+	// matched words in <b> tags.
+	Snippet     string  `json:"snippet,omitempty"`
 }
 
 type NearFilm struct {
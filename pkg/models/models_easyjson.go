@@ -54,6 +54,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels(in *jlex
 			out.Email = string(in.String())
 		case "role":
 			out.Role = string(in.String())
+		case "email_verified":
+			out.EmailVerified = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -113,6 +115,11 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels(out *jwr
 		out.RawString(prefix)
 		out.String(string(in.Role))
 	}
+	{
+		const prefix string = ",\"email_verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EmailVerified))
+	}
 	out.RawByte('}')
 }
 
@@ -139,7 +146,481 @@ func (v *UserItem) UnmarshalJSON(data []byte) error {
 func (v *UserItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jlexer.Lexer, out *ProfessionItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jlexer.Lexer, out *SuggestItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(SuggestItems, 0, 1)
+			} else {
+				*out = SuggestItems{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 SuggestItem
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jwriter.Writer, in SuggestItems) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestItems) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestItems) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jlexer.Lexer, out *SuggestItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = string(in.String())
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail":
+			out.Thumbnail = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jwriter.Writer, in SuggestItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jlexer.Lexer, out *ReportedComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment":
+			(out.Comment).UnmarshalEasyJSON(in)
+		case "hidden":
+			out.Hidden = bool(in.Bool())
+		case "report_count":
+			out.ReportCount = uint64(in.Uint64())
+		case "last_reported":
+			out.LastReported = string(in.String())
+		case "reasons":
+			if in.IsNull() {
+				in.Skip()
+				out.Reasons = nil
+			} else {
+				in.Delim('[')
+				if out.Reasons == nil {
+					if !in.IsDelim(']') {
+						out.Reasons = make([]string, 0, 4)
+					} else {
+						out.Reasons = []string{}
+					}
+				} else {
+					out.Reasons = (out.Reasons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 string
+					v4 = string(in.String())
+					out.Reasons = append(out.Reasons, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jwriter.Writer, in ReportedComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix[1:])
+		(in.Comment).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"hidden\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Hidden))
+	}
+	{
+		const prefix string = ",\"report_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ReportCount))
+	}
+	{
+		const prefix string = ",\"last_reported\":"
+		out.RawString(prefix)
+		out.String(string(in.LastReported))
+	}
+	{
+		const prefix string = ",\"reasons\":"
+		out.RawString(prefix)
+		if in.Reasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Reasons {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v6))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportedComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportedComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportedComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportedComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jlexer.Lexer, out *ReplyItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "parent_id":
+			out.ParentId = uint64(in.Uint64())
+		case "id_user":
+			out.IdUser = uint64(in.Uint64())
+		case "name":
+			out.Username = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "date":
+			out.Date = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jwriter.Writer, in ReplyItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"parent_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ParentId))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdUser))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix)
+		out.String(string(in.Date))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReplyItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReplyItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReplyItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReplyItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jlexer.Lexer, out *RatingItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id_user":
+			out.IdUser = uint64(in.Uint64())
+		case "id_film":
+			out.IdFilm = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint16(in.Uint16())
+		case "edited_at":
+			out.EditedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jwriter.Writer, in RatingItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.IdUser))
+	}
+	{
+		const prefix string = ",\"id_film\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.IdFilm))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Rating))
+	}
+	if in.EditedAt != "" {
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.String(string(in.EditedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RatingItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RatingItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RatingItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RatingItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jlexer.Lexer, out *ProfessionItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -172,7 +653,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jwriter.Writer, in ProfessionItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jwriter.Writer, in ProfessionItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -192,27 +673,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v ProfessionItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ProfessionItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels1(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ProfessionItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ProfessionItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels1(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jlexer.Lexer, out *GenreItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jlexer.Lexer, out *GenreItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -245,7 +726,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jwriter.Writer, in GenreItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jwriter.Writer, in GenreItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -265,27 +746,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v GenreItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GenreItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels2(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GenreItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GenreItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels2(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jlexer.Lexer, out *FilmItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(in *jlexer.Lexer, out *FilmItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -320,6 +801,8 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jle
 			out.Mpaa = string(in.String())
 		case "rating":
 			out.Rating = float64(in.Float64())
+		case "snippet":
+			out.Snippet = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -330,7 +813,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jwriter.Writer, in FilmItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(out *jwriter.Writer, in FilmItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -374,33 +857,378 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(out *jw
 		out.RawString(prefix)
 		out.Float64(float64(in.Rating))
 	}
+	if in.Snippet != "" {
+		const prefix string = ",\"snippet\":"
+		out.RawString(prefix)
+		out.String(string(in.Snippet))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v FilmItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FilmItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels3(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FilmItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FilmItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels3(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels8(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(in *jlexer.Lexer, out *FilmFacets) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]FacetBucket, 0, 2)
+					} else {
+						out.Genres = []FacetBucket{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 FacetBucket
+					(v7).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "mpaa":
+			if in.IsNull() {
+				in.Skip()
+				out.Mpaa = nil
+			} else {
+				in.Delim('[')
+				if out.Mpaa == nil {
+					if !in.IsDelim(']') {
+						out.Mpaa = make([]FacetBucket, 0, 2)
+					} else {
+						out.Mpaa = []FacetBucket{}
+					}
+				} else {
+					out.Mpaa = (out.Mpaa)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 FacetBucket
+					(v8).UnmarshalEasyJSON(in)
+					out.Mpaa = append(out.Mpaa, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "countries":
+			if in.IsNull() {
+				in.Skip()
+				out.Countries = nil
+			} else {
+				in.Delim('[')
+				if out.Countries == nil {
+					if !in.IsDelim(']') {
+						out.Countries = make([]FacetBucket, 0, 2)
+					} else {
+						out.Countries = []FacetBucket{}
+					}
+				} else {
+					out.Countries = (out.Countries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 FacetBucket
+					(v9).UnmarshalEasyJSON(in)
+					out.Countries = append(out.Countries, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "decades":
+			if in.IsNull() {
+				in.Skip()
+				out.Decades = nil
+			} else {
+				in.Delim('[')
+				if out.Decades == nil {
+					if !in.IsDelim(']') {
+						out.Decades = make([]FacetBucket, 0, 2)
+					} else {
+						out.Decades = []FacetBucket{}
+					}
+				} else {
+					out.Decades = (out.Decades)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 FacetBucket
+					(v10).UnmarshalEasyJSON(in)
+					out.Decades = append(out.Decades, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ratings":
+			if in.IsNull() {
+				in.Skip()
+				out.Ratings = nil
+			} else {
+				in.Delim('[')
+				if out.Ratings == nil {
+					if !in.IsDelim(']') {
+						out.Ratings = make([]FacetBucket, 0, 2)
+					} else {
+						out.Ratings = []FacetBucket{}
+					}
+				} else {
+					out.Ratings = (out.Ratings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 FacetBucket
+					(v11).UnmarshalEasyJSON(in)
+					out.Ratings = append(out.Ratings, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(out *jwriter.Writer, in FilmFacets) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix[1:])
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.Genres {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				(v13).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"mpaa\":"
+		out.RawString(prefix)
+		if in.Mpaa == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Mpaa {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"countries\":"
+		out.RawString(prefix)
+		if in.Countries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Countries {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				(v17).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"decades\":"
+		out.RawString(prefix)
+		if in.Decades == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Decades {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				(v19).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ratings\":"
+		out.RawString(prefix)
+		if in.Ratings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Ratings {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmFacets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmFacets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmFacets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmFacets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels9(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(in *jlexer.Lexer, out *FacetBucket) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "value":
+			out.Value = string(in.String())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(out *jwriter.Writer, in FacetBucket) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"value\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FacetBucket) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FacetBucket) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FacetBucket) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FacetBucket) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels10(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jlexer.Lexer, out *DayItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(in *jlexer.Lexer, out *DayItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -437,7 +1265,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jwriter.Writer, in DayItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(out *jwriter.Writer, in DayItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -467,27 +1295,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v DayItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DayItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels4(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DayItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DayItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels4(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels11(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jlexer.Lexer, out *CrewItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels12(in *jlexer.Lexer, out *CrewItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -528,7 +1356,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jwriter.Writer, in CrewItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels12(out *jwriter.Writer, in CrewItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -568,27 +1396,27 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v CrewItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CrewItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels5(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CrewItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CrewItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels5(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels12(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jlexer.Lexer, out *CommentItem) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels13(in *jlexer.Lexer, out *CommentItem) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -607,18 +1435,32 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jle
 			continue
 		}
 		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
 		case "id_user":
 			out.IdUser = uint64(in.Uint64())
 		case "name":
 			out.Username = string(in.String())
 		case "id_film":
 			out.IdFilm = uint64(in.Uint64())
-		case "rating":
-			out.Rating = uint16(in.Uint16())
+		case "title":
+			out.Title = string(in.String())
 		case "text":
 			out.Comment = string(in.String())
+		case "spoiler":
+			out.Spoiler = bool(in.Bool())
 		case "photo":
 			out.Photo = string(in.String())
+		case "edited_at":
+			out.EditedAt = string(in.String())
+		case "likes":
+			out.Likes = uint64(in.Uint64())
+		case "dislikes":
+			out.Dislikes = uint64(in.Uint64())
+		case "reply_count":
+			out.ReplyCount = uint64(in.Uint64())
+		case "reaction":
+			out.Reaction = int8(in.Int8())
 		default:
 			in.SkipRecursive()
 		}
@@ -629,13 +1471,18 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jwriter.Writer, in CommentItem) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels13(out *jwriter.Writer, in CommentItem) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id_user\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"id_user\":"
+		out.RawString(prefix)
 		out.Uint64(uint64(in.IdUser))
 	}
 	{
@@ -649,47 +1496,77 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(out *jw
 		out.Uint64(uint64(in.IdFilm))
 	}
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.Uint16(uint16(in.Rating))
+		out.String(string(in.Title))
 	}
 	{
 		const prefix string = ",\"text\":"
 		out.RawString(prefix)
 		out.String(string(in.Comment))
 	}
+	{
+		const prefix string = ",\"spoiler\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Spoiler))
+	}
 	{
 		const prefix string = ",\"photo\":"
 		out.RawString(prefix)
 		out.String(string(in.Photo))
 	}
+	if in.EditedAt != "" {
+		const prefix string = ",\"edited_at\":"
+		out.RawString(prefix)
+		out.String(string(in.EditedAt))
+	}
+	{
+		const prefix string = ",\"likes\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Likes))
+	}
+	{
+		const prefix string = ",\"dislikes\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Dislikes))
+	}
+	{
+		const prefix string = ",\"reply_count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.ReplyCount))
+	}
+	{
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix)
+		out.Int8(int8(in.Reaction))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CommentItem) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentItem) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels6(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentItem) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels6(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels13(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jlexer.Lexer, out *Character) {
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels14(in *jlexer.Lexer, out *Character) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -726,7 +1603,7 @@ func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(in *jle
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jwriter.Writer, in Character) {
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels14(out *jwriter.Writer, in Character) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -756,23 +1633,160 @@ func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(out *jw
 // MarshalJSON supports json.Marshaler interface
 func (v Character) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Character) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels7(w, v)
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Character) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(&r, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Character) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels7(l, v)
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels14(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels15(in *jlexer.Lexer, out *ActorFacets) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "countries":
+			if in.IsNull() {
+				in.Skip()
+				out.Countries = nil
+			} else {
+				in.Delim('[')
+				if out.Countries == nil {
+					if !in.IsDelim(']') {
+						out.Countries = make([]FacetBucket, 0, 2)
+					} else {
+						out.Countries = []FacetBucket{}
+					}
+				} else {
+					out.Countries = (out.Countries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 FacetBucket
+					(v22).UnmarshalEasyJSON(in)
+					out.Countries = append(out.Countries, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "professions":
+			if in.IsNull() {
+				in.Skip()
+				out.Professions = nil
+			} else {
+				in.Delim('[')
+				if out.Professions == nil {
+					if !in.IsDelim(']') {
+						out.Professions = make([]FacetBucket, 0, 2)
+					} else {
+						out.Professions = []FacetBucket{}
+					}
+				} else {
+					out.Professions = (out.Professions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 FacetBucket
+					(v23).UnmarshalEasyJSON(in)
+					out.Professions = append(out.Professions, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels15(out *jwriter.Writer, in ActorFacets) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"countries\":"
+		out.RawString(prefix[1:])
+		if in.Countries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v24, v25 := range in.Countries {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				(v25).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"professions\":"
+		out.RawString(prefix)
+		if in.Professions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Professions {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActorFacets) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActorFacets) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComGoParkMailRu20232VkladyshiPkgModels15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActorFacets) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActorFacets) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComGoParkMailRu20232VkladyshiPkgModels15(l, v)
 }
@@ -0,0 +1,25 @@
+package models
+
+//easyjson:json
+type RatingItem struct {
+	IdUser   uint64 `json:"id_user"`
+	IdFilm   uint64 `json:"id_film"`
+	Rating   uint16 `json:"rating"`
+	EditedAt string `json:"edited_at,omitempty"`
+}
+
+// RatingStats is the precomputed rating of a film. Histogram[i] is the
+// number of ratings equal to i+1.
+type RatingStats struct {
+	Count     uint64
+	Sum       uint64
+	Histogram []uint64
+}
+
+func (stats *RatingStats) Average() float64 {
+	if stats.Count == 0 {
+		return 0
+	}
+
+	return float64(stats.Sum) / float64(stats.Count)
+}
@@ -0,0 +1,15 @@
+package models
+
+// SuggestItem is a film or a person suggested by a search prefix. Kind is
+// "film" or "person", Thumbnail is the poster or the photo.
+//
+//easyjson:json
+type SuggestItem struct {
+	Kind      string `json:"kind"`
+	Id        uint64 `json:"id"`
+	Title     string `json:"title"`
+	Thumbnail string `json:"thumbnail"`
+}
+
+//easyjson:json
+type SuggestItems []SuggestItem
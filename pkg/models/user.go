@@ -11,4 +11,13 @@ type UserItem struct {
 	RegistrationDate string `json:"registration_date"`
 	Email            string `json:"email"`
 	Role             string `json:"role"`
+	EmailVerified    bool   `json:"email_verified"`
+}
+
+// UserData is everything the films service keeps about a user.
+type UserData struct {
+	FavoriteFilms  []uint64
+	FavoriteActors []uint64
+	Ratings        []RatingItem
+	NearFilms      []uint64
 }
@@ -0,0 +1,183 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+var ErrExchange = errors.New("oauth exchange failed")
+
+// Identity is the user as the provider knows them. Subject is stable for
+// the user within the provider.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Provider runs the OAuth2 authorization code flow with PKCE.
+type Provider interface {
+	// AuthCodeURL returns the page of the provider to send the user to.
+	AuthCodeURL(ctx context.Context, state string, challenge string) (string, error)
+	// Exchange trades the parameters of the callback for the identity of
+	// the user.
+	Exchange(ctx context.Context, callback url.Values, verifier string) (*Identity, error)
+}
+
+// GetProviders returns the providers of the config by name.
+func GetProviders(cfg configs.OAuthCfg) (map[string]Provider, error) {
+	providers := make(map[string]Provider, len(cfg.Providers))
+	for name, providerCfg := range cfg.Providers {
+		switch providerCfg.Type {
+		case "vk":
+			providers[name] = NewVK(providerCfg)
+		case "yandex":
+			providers[name] = NewYandex(providerCfg)
+		case "oidc":
+			providers[name] = NewOIDC(providerCfg)
+		default:
+			return nil, fmt.Errorf("get providers err: unknown type %q of %s", providerCfg.Type, name)
+		}
+	}
+
+	return providers, nil
+}
+
+// NewVerifier returns a random PKCE code verifier.
+func NewVerifier() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("new verifier err: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// Challenge returns the S256 code challenge of the verifier.
+func Challenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+type endpoint struct {
+	AuthURL  string
+	TokenURL string
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// client is the part of the flow every provider shares.
+type client struct {
+	cfg  configs.OAuthProviderCfg
+	http *http.Client
+}
+
+func newClient(cfg configs.OAuthProviderCfg) client {
+	return client{cfg: cfg, http: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (c *client) authCodeURL(e endpoint, state string, challenge string, extra url.Values) string {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {c.cfg.ClientId},
+		"redirect_uri":          {c.cfg.RedirectUrl},
+		"state":                 {state},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+	if len(c.cfg.Scopes) > 0 {
+		query.Set("scope", strings.Join(c.cfg.Scopes, " "))
+	}
+	for key, values := range extra {
+		query[key] = values
+	}
+
+	separator := "?"
+	if strings.Contains(e.AuthURL, "?") {
+		separator = "&"
+	}
+
+	return e.AuthURL + separator + query.Encode()
+}
+
+func (c *client) exchange(ctx context.Context, e endpoint, code string, verifier string, extra url.Values) (string, error) {
+	if code == "" {
+		return "", fmt.Errorf("%w: no code", ErrExchange)
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {c.cfg.RedirectUrl},
+		"client_id":     {c.cfg.ClientId},
+		"code_verifier": {verifier},
+	}
+	if c.cfg.ClientSecret != "" {
+		form.Set("client_secret", c.cfg.ClientSecret)
+	}
+	for key, values := range extra {
+		form[key] = values
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("exchange err: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+
+	var token tokenResponse
+	if err := c.do(request, &token); err != nil {
+		return "", err
+	}
+	if token.Error != "" {
+		return "", fmt.Errorf("%w: %s %s", ErrExchange, token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("%w: no access token", ErrExchange)
+	}
+
+	return token.AccessToken, nil
+}
+
+// do sends the request and decodes the JSON answer into value.
+func (c *client) do(request *http.Request, value interface{}) error {
+	response, err := c.http.Do(request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrExchange, err.Error())
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrExchange, err.Error())
+	}
+
+	err = json.Unmarshal(body, value)
+	if err != nil && response.StatusCode == http.StatusOK {
+		return fmt.Errorf("%w: %s", ErrExchange, err.Error())
+	}
+	if err != nil {
+		return fmt.Errorf("%w: status %d", ErrExchange, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+// stubOIDC is a provider that issues one code for the challenge it was
+// given and accepts it only with the matching verifier.
+func stubOIDC() *httptest.Server {
+	var challenge string
+
+	mx := http.NewServeMux()
+	server := httptest.NewServer(mx)
+
+	mx.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+		})
+	})
+	mx.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		challenge = r.URL.Query().Get("code_challenge")
+		callback := r.URL.Query().Get("redirect_uri") + "?code=c1&state=" + url.QueryEscape(r.URL.Query().Get("state"))
+		http.Redirect(w, r, callback, http.StatusFound)
+	})
+	mx.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "c1" || Challenge(r.FormValue("code_verifier")) != challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "a1", "token_type": "Bearer"})
+	})
+	mx.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer a1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"sub":"s1","email":"e1@mail.ru","email_verified":"true","name":"n1"}`))
+	})
+
+	return server
+}
+
+func TestOIDCFlow(t *testing.T) {
+	server := stubOIDC()
+	defer server.Close()
+
+	provider := NewOIDC(configs.OAuthProviderCfg{
+		ClientId:    "client",
+		RedirectUrl: "http://localhost/callback",
+		Issuer:      server.URL,
+	})
+
+	verifier, err := NewVerifier()
+	if err != nil {
+		t.Fatalf("cant create verifier: %s", err)
+	}
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "st1", Challenge(verifier))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	noRedirect := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	response, err := noRedirect.Get(authURL)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	response.Body.Close()
+
+	location, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if location.Query().Get("state") != "st1" {
+		t.Errorf("state was not passed back: %s", location)
+		return
+	}
+
+	_, err = provider.Exchange(context.Background(), location.Query(), "wrong")
+	if !errors.Is(err, ErrExchange) {
+		t.Errorf("wanted exchange error for wrong verifier, had %v", err)
+		return
+	}
+
+	identity, err := provider.Exchange(context.Background(), location.Query(), verifier)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	want := Identity{Subject: "s1", Email: "e1@mail.ru", EmailVerified: true, Name: "n1"}
+	if *identity != want {
+		t.Errorf("results not match, want %v, have %v", want, *identity)
+		return
+	}
+}
+
+func TestGetProviders(t *testing.T) {
+	providers, err := GetProviders(configs.OAuthCfg{Providers: map[string]configs.OAuthProviderCfg{
+		"vk":     {Type: "vk"},
+		"yandex": {Type: "yandex"},
+	}})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if len(providers) != 2 {
+		t.Errorf("wanted 2 providers, had %d", len(providers))
+		return
+	}
+
+	_, err = GetProviders(configs.OAuthCfg{Providers: map[string]configs.OAuthProviderCfg{"x": {Type: "x"}}})
+	if err == nil {
+		t.Errorf("expected error, got nil")
+		return
+	}
+}
@@ -0,0 +1,243 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+// VK is VK ID. Its token endpoint wants the device_id it appends to the
+// callback, and the user info is posted for rather than fetched.
+type VK struct {
+	client
+	endpoint endpoint
+	infoURL  string
+}
+
+func NewVK(cfg configs.OAuthProviderCfg) *VK {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"vkid.personal_info", "email"}
+	}
+
+	return &VK{
+		client: newClient(cfg),
+		endpoint: endpoint{
+			AuthURL:  "https://id.vk.com/authorize",
+			TokenURL: "https://id.vk.com/oauth2/auth",
+		},
+		infoURL: "https://id.vk.com/oauth2/user_info",
+	}
+}
+
+func (p *VK) AuthCodeURL(ctx context.Context, state string, challenge string) (string, error) {
+	return p.authCodeURL(p.endpoint, state, challenge, nil), nil
+}
+
+type vkUserInfo struct {
+	User struct {
+		UserId    string `json:"user_id"`
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Email     string `json:"email"`
+	} `json:"user"`
+}
+
+func (p *VK) Exchange(ctx context.Context, callback url.Values, verifier string) (*Identity, error) {
+	accessToken, err := p.exchange(ctx, p.endpoint, callback.Get("code"), verifier, url.Values{
+		"device_id": {callback.Get("device_id")},
+		"state":     {callback.Get("state")},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{"client_id": {p.cfg.ClientId}, "access_token": {accessToken}}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.infoURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("vk exchange err: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var info vkUserInfo
+	if err := p.do(request, &info); err != nil {
+		return nil, err
+	}
+	if info.User.UserId == "" {
+		return nil, fmt.Errorf("%w: no user id", ErrExchange)
+	}
+
+	return &Identity{
+		Subject: info.User.UserId,
+		Email:   info.User.Email,
+		Name:    strings.TrimSpace(info.User.FirstName + " " + info.User.LastName),
+	}, nil
+}
+
+// Yandex is Yandex ID. The default email of a Yandex account is confirmed.
+type Yandex struct {
+	client
+	endpoint endpoint
+	infoURL  string
+}
+
+func NewYandex(cfg configs.OAuthProviderCfg) *Yandex {
+	return &Yandex{
+		client: newClient(cfg),
+		endpoint: endpoint{
+			AuthURL:  "https://oauth.yandex.ru/authorize",
+			TokenURL: "https://oauth.yandex.ru/token",
+		},
+		infoURL: "https://login.yandex.ru/info?format=json",
+	}
+}
+
+func (p *Yandex) AuthCodeURL(ctx context.Context, state string, challenge string) (string, error) {
+	return p.authCodeURL(p.endpoint, state, challenge, nil), nil
+}
+
+type yandexUserInfo struct {
+	Id           string `json:"id"`
+	DefaultEmail string `json:"default_email"`
+	RealName     string `json:"real_name"`
+}
+
+func (p *Yandex) Exchange(ctx context.Context, callback url.Values, verifier string) (*Identity, error) {
+	accessToken, err := p.exchange(ctx, p.endpoint, callback.Get("code"), verifier, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, p.infoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("yandex exchange err: %w", err)
+	}
+	request.Header.Set("Authorization", "OAuth "+accessToken)
+
+	var info yandexUserInfo
+	if err := p.do(request, &info); err != nil {
+		return nil, err
+	}
+	if info.Id == "" {
+		return nil, fmt.Errorf("%w: no user id", ErrExchange)
+	}
+
+	return &Identity{
+		Subject:       info.Id,
+		Email:         info.DefaultEmail,
+		EmailVerified: info.DefaultEmail != "",
+		Name:          info.RealName,
+	}, nil
+}
+
+// OIDC is any OpenID Connect provider. Its endpoints are discovered from
+// the issuer on first use, and the identity is read from the userinfo
+// endpoint, so the ID token is not verified here.
+type OIDC struct {
+	client
+
+	mutex     sync.Mutex
+	discovery *oidcDiscovery
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+func NewOIDC(cfg configs.OAuthProviderCfg) *OIDC {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &OIDC{client: newClient(cfg)}
+}
+
+func (p *OIDC) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.cfg.Issuer, "/")
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc discovery err: %w", err)
+	}
+
+	discovery := &oidcDiscovery{}
+	if err := p.do(request, discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch %s", ErrExchange, discovery.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.UserinfoEndpoint == "" {
+		return nil, fmt.Errorf("%w: incomplete discovery document", ErrExchange)
+	}
+
+	p.discovery = discovery
+	return discovery, nil
+}
+
+func (p *OIDC) AuthCodeURL(ctx context.Context, state string, challenge string) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return p.authCodeURL(endpoint{AuthURL: discovery.AuthorizationEndpoint}, state, challenge, nil), nil
+}
+
+type oidcUserInfo struct {
+	Subject       string          `json:"sub"`
+	Email         string          `json:"email"`
+	EmailVerified json.RawMessage `json:"email_verified"`
+	Name          string          `json:"name"`
+}
+
+func (p *OIDC) Exchange(ctx context.Context, callback url.Values, verifier string) (*Identity, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := p.exchange(ctx, endpoint{TokenURL: discovery.TokenEndpoint}, callback.Get("code"), verifier, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discovery.UserinfoEndpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("oidc exchange err: %w", err)
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	var info oidcUserInfo
+	if err := p.do(request, &info); err != nil {
+		return nil, err
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrExchange)
+	}
+
+	// Some providers send email_verified as a string.
+	verified, _ := strconv.ParseBool(strings.Trim(string(info.EmailVerified), `"`))
+
+	return &Identity{
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: verified,
+		Name:          info.Name,
+	}, nil
+}
@@ -0,0 +1,236 @@
+// Package pagination pages the lists by keyset. A page starts after the
+// cursor of the previous one, an opaque string with the sort key of its
+// last row, so deep pages are as fast as the first one and rows do not
+// shift between pages when the list changes. Clients counting pages get
+// the offset pagination still.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrBadCursor = errors.New("bad cursor")
+
+// MaxLimit is the largest page size a client may ask for.
+const MaxLimit = 100
+
+// Column is a column of the sort key of a list. Type is the SQL type the
+// values of the cursor are cast to.
+type Column struct {
+	Expr string
+	Type string
+}
+
+// valid tells if the value of a cursor is a value of the type of the
+// column, so that the cast of it in the query does not fail.
+func (c Column) valid(value string) bool {
+	var err error
+	switch c.Type {
+	case "BIGINT":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "INTEGER":
+		_, err = strconv.ParseInt(value, 10, 32)
+	case "DOUBLE PRECISION":
+		_, err = strconv.ParseFloat(value, 64)
+	case "DATE":
+		_, err = time.Parse(time.DateOnly, value)
+	case "TIMESTAMP":
+		_, err = time.Parse("2006-01-02 15:04:05.999999999", value)
+	case "TEXT":
+		return utf8.ValidString(value) && !strings.ContainsRune(value, 0)
+	default:
+		return false
+	}
+
+	return err == nil
+}
+
+// Order is the sort key of a list. The columns together must be unique,
+// so the last one is usually the id.
+type Order struct {
+	Columns []Column
+	Desc    bool
+}
+
+// By is the ORDER BY clause of the order.
+func (o Order) By() string {
+	direction := " ASC"
+	if o.Desc {
+		direction = " DESC"
+	}
+
+	columns := make([]string, len(o.Columns))
+	for i, column := range o.Columns {
+		columns[i] = column.Expr + direction
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// Key selects the sort key of the rows as text, to make the next cursor of.
+func (o Order) Key() string {
+	columns := make([]string, len(o.Columns))
+	for i, column := range o.Columns {
+		columns[i] = column.Expr + "::TEXT"
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+// KeyDest returns the key of a row and the destinations to scan it into.
+func (o Order) KeyDest() ([]string, []interface{}) {
+	key := make([]string, len(o.Columns))
+	dest := make([]interface{}, len(o.Columns))
+	for i := range key {
+		dest[i] = &key[i]
+	}
+
+	return key, dest
+}
+
+// after is the condition on the rows after the key in the params from
+// paramNum on.
+func (o Order) after(paramNum int) string {
+	columns := make([]string, len(o.Columns))
+	values := make([]string, len(o.Columns))
+	for i, column := range o.Columns {
+		columns[i] = column.Expr
+		values[i] = "$" + strconv.Itoa(paramNum+i) + "::" + column.Type
+	}
+
+	operator := " > "
+	if o.Desc {
+		operator = " < "
+	}
+
+	return "(" + strings.Join(columns, ", ") + ")" + operator + "(" + strings.Join(values, ", ") + ")"
+}
+
+// Page is a page of a list. After is the key of the last row of the
+// previous page, it is nil for the first page and for the offset
+// pagination.
+type Page struct {
+	After  []string
+	Offset uint64
+	Limit  uint64
+}
+
+// FromQuery reads the page from the cursor parameter of the query, or
+// from the page number and the page size in sizeParam. The page size is
+// at most MaxLimit.
+func FromQuery(query url.Values, sizeParam string, defaultLimit uint64) (Page, error) {
+	limit, err := strconv.ParseUint(query.Get(sizeParam), 10, 64)
+	if err != nil {
+		limit = 0
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		page := FromNumber(1, limit, defaultLimit)
+		page.After, err = Decode(cursor)
+		if err != nil {
+			return Page{}, err
+		}
+		return page, nil
+	}
+
+	number, err := strconv.ParseUint(query.Get("page"), 10, 64)
+	if err != nil {
+		number = 0
+	}
+
+	return FromNumber(number, limit, defaultLimit), nil
+}
+
+// FromNumber is the page with the number in the pages of the size, for the
+// lists paged by number only. The page 0 is the first one, the size 0 is
+// defaultLimit and the size is at most MaxLimit.
+func FromNumber(number uint64, size uint64, defaultLimit uint64) Page {
+	if size == 0 {
+		size = defaultLimit
+	}
+	if size > MaxLimit {
+		size = MaxLimit
+	}
+	if number == 0 {
+		number = 1
+	}
+	// The offset is a BIGINT, the pages past the largest one are empty.
+	if size > 0 && number-1 > math.MaxInt64/size {
+		number = math.MaxInt64/size + 1
+	}
+
+	return Page{Offset: (number - 1) * size, Limit: size}
+}
+
+// Write writes the condition on the rows after the cursor, the order and
+// the limit of the page to s, which ends with the conditions of the list,
+// and returns the params of them from paramNum on. One more row than the
+// limit is fetched to learn if there is a next page, see Full.
+func (p Page) Write(s *strings.Builder, o Order, hasWhere bool, paramNum int) ([]interface{}, error) {
+	var params []interface{}
+	if p.After != nil {
+		if len(p.After) != len(o.Columns) {
+			return nil, ErrBadCursor
+		}
+		for i, column := range o.Columns {
+			if !column.valid(p.After[i]) {
+				return nil, ErrBadCursor
+			}
+		}
+		if !hasWhere {
+			s.WriteString("WHERE ")
+		} else {
+			s.WriteString("AND ")
+		}
+		s.WriteString(o.after(paramNum) + " ")
+		for _, value := range p.After {
+			params = append(params, value)
+		}
+		paramNum += len(p.After)
+	}
+
+	// The limit is a BIGINT, the largest one is no limit anyway.
+	limit := uint64(math.MaxInt64)
+	if p.Limit < limit {
+		limit = p.Limit + 1
+	}
+	s.WriteString("ORDER BY " + o.By() + " " +
+		"LIMIT $" + strconv.Itoa(paramNum) + " OFFSET $" + strconv.Itoa(paramNum+1))
+	params = append(params, limit, p.Offset)
+
+	return params, nil
+}
+
+// Full tells if a page with n rows is full, so the next row fetched
+// belongs to the next page.
+func (p Page) Full(n int) bool {
+	return uint64(n) >= p.Limit
+}
+
+// Encode makes the cursor of the page after the row with the key.
+func Encode(key []string) string {
+	data, _ := json.Marshal(key)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func Decode(cursor string) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrBadCursor
+	}
+
+	var key []string
+	if err := json.Unmarshal(data, &key); err != nil || len(key) == 0 {
+		return nil, ErrBadCursor
+	}
+
+	return key, nil
+}
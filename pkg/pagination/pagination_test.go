@@ -0,0 +1,163 @@
+package pagination
+
+import (
+	"errors"
+	"math"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testOrder = Order{
+	Columns: []Column{{Expr: "film.release_date", Type: "DATE"}, {Expr: "film.id", Type: "BIGINT"}},
+	Desc:    true,
+}
+
+func TestFromQuery(t *testing.T) {
+	cursor := Encode([]string{"2023-01-01", "5"})
+	testCases := map[string]struct {
+		query string
+		page  Page
+		err   error
+	}{
+		"Default":    {query: "", page: Page{Limit: 8}},
+		"Page":       {query: "page=3&page_size=10", page: Page{Offset: 20, Limit: 10}},
+		"Zero page":  {query: "page=0&page_size=0", page: Page{Limit: 8}},
+		"Large page": {query: "page=2&page_size=1000", page: Page{Offset: MaxLimit, Limit: MaxLimit}},
+		"Cursor":     {query: "cursor=" + cursor + "&page=3", page: Page{After: []string{"2023-01-01", "5"}, Limit: 8}},
+		"Bad cursor": {query: "cursor=%21%21", err: ErrBadCursor},
+		"Empty key":  {query: "cursor=" + Encode([]string{}), err: ErrBadCursor},
+	}
+
+	for name, curr := range testCases {
+		query, _ := url.ParseQuery(curr.query)
+		page, err := FromQuery(query, "page_size", 8)
+		if !errors.Is(err, curr.err) {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.err, err)
+			continue
+		}
+		if !reflect.DeepEqual(page, curr.page) {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.page, page)
+		}
+	}
+}
+
+func TestFromNumber(t *testing.T) {
+	testCases := map[string]struct {
+		number uint64
+		size   uint64
+		page   Page
+	}{
+		"Default":   {number: 0, size: 0, page: Page{Limit: 8}},
+		"Page":      {number: 3, size: 10, page: Page{Offset: 20, Limit: 10}},
+		"Large":     {number: 2, size: 1000, page: Page{Offset: MaxLimit, Limit: MaxLimit}},
+		"Last page": {number: math.MaxUint64, size: 10, page: Page{Offset: math.MaxInt64 / 10 * 10, Limit: 10}},
+	}
+
+	for name, curr := range testCases {
+		page := FromNumber(curr.number, curr.size, 8)
+		if !reflect.DeepEqual(page, curr.page) {
+			t.Errorf("%s: results not match, want %v, have %v", name, curr.page, page)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var s strings.Builder
+	params, err := Page{Offset: 16, Limit: 8}.Write(&s, testOrder, false, 1)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	want := "ORDER BY film.release_date DESC, film.id DESC LIMIT $1 OFFSET $2"
+	if s.String() != want {
+		t.Errorf("results not match, want %v, have %v", want, s.String())
+	}
+	if !reflect.DeepEqual(params, []interface{}{uint64(9), uint64(16)}) {
+		t.Errorf("results not match, want %v, have %v", []interface{}{9, 16}, params)
+	}
+
+	s.Reset()
+	params, err = Page{After: []string{"2023-01-01", "5"}, Limit: 8}.Write(&s, testOrder, true, 2)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	want = "AND (film.release_date, film.id) < ($2::DATE, $3::BIGINT) " +
+		"ORDER BY film.release_date DESC, film.id DESC LIMIT $4 OFFSET $5"
+	if s.String() != want {
+		t.Errorf("results not match, want %v, have %v", want, s.String())
+	}
+	if !reflect.DeepEqual(params, []interface{}{"2023-01-01", "5", uint64(9), uint64(0)}) {
+		t.Errorf("results not match, want %v, have %v", []interface{}{"2023-01-01", "5", 9, 0}, params)
+	}
+
+	s.Reset()
+	params, err = Page{Limit: math.MaxUint64}.Write(&s, testOrder, false, 1)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(params, []interface{}{uint64(math.MaxInt64), uint64(0)}) {
+		t.Errorf("results not match, want %v, have %v", []interface{}{uint64(math.MaxInt64), 0}, params)
+	}
+
+	_, err = Page{After: []string{"5"}, Limit: 8}.Write(&s, testOrder, true, 2)
+	if !errors.Is(err, ErrBadCursor) {
+		t.Errorf("results not match, want %v, have %v", ErrBadCursor, err)
+	}
+}
+
+func TestWriteBadCursor(t *testing.T) {
+	order := Order{Columns: []Column{
+		{Expr: "a", Type: "BIGINT"},
+		{Expr: "b", Type: "INTEGER"},
+		{Expr: "c", Type: "DOUBLE PRECISION"},
+		{Expr: "d", Type: "DATE"},
+		{Expr: "e", Type: "TIMESTAMP"},
+		{Expr: "f", Type: "TEXT"},
+	}}
+	valid := []string{"-5", "7", "4.5", "2023-01-01", "2023-01-01 10:00:00.5", "Брэд"}
+
+	testCases := map[string]struct {
+		column int
+		value  string
+	}{
+		"Bad bigint":    {column: 0, value: "5 OR 1=1"},
+		"Bad integer":   {column: 1, value: "3000000000"},
+		"Bad double":    {column: 2, value: "high"},
+		"Bad date":      {column: 3, value: "2023-13-01"},
+		"Bad timestamp": {column: 4, value: "yesterday"},
+		"Bad text":      {column: 5, value: "a\x00b"},
+	}
+
+	var s strings.Builder
+	_, err := Page{After: valid, Limit: 8}.Write(&s, order, false, 1)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	for name, curr := range testCases {
+		after := append([]string{}, valid...)
+		after[curr.column] = curr.value
+		s.Reset()
+		_, err := Page{After: after, Limit: 8}.Write(&s, order, false, 1)
+		if !errors.Is(err, ErrBadCursor) {
+			t.Errorf("%s: results not match, want %v, have %v", name, ErrBadCursor, err)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	key := []string{"2023-01-01 10:00:00.5", "-3", "id, with \"quotes\""}
+	have, err := Decode(Encode(key))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(have, key) {
+		t.Errorf("results not match, want %v, have %v", key, have)
+	}
+}
@@ -0,0 +1,126 @@
+// Package ratingstats keeps film_rating_stats in step with the ratings
+// table. Callers change ratings and the stats in the same transaction.
+package ratingstats
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+const MaxRating = 10
+
+// ErrNoRating is returned by Update when the user has not rated the film.
+var ErrNoRating = errors.New("no rating")
+
+type Tx interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// Add counts a new rating of the film.
+func Add(tx Tx, filmId uint64, rating uint16) error {
+	return apply(tx, filmId, rating, 1)
+}
+
+// Remove uncounts a deleted rating of the film.
+func Remove(tx Tx, filmId uint64, rating uint16) error {
+	return apply(tx, filmId, rating, -1)
+}
+
+// Delete runs a "DELETE FROM ratings ... RETURNING id_film, rating"
+// query and uncounts the ratings it deleted.
+func Delete(tx Tx, query string, args ...interface{}) error {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return fmt.Errorf("delete ratings err: %w", err)
+	}
+	defer rows.Close()
+
+	type deleted struct {
+		filmId uint64
+		rating uint16
+	}
+	var ratings []deleted
+	for rows.Next() {
+		var item deleted
+		if err := rows.Scan(&item.filmId, &item.rating); err != nil {
+			return fmt.Errorf("delete ratings scan err: %w", err)
+		}
+		ratings = append(ratings, item)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("delete ratings err: %w", err)
+	}
+	rows.Close()
+
+	for _, item := range ratings {
+		if err := Remove(tx, item.filmId, item.rating); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Update replaces the rating of the user to the film. The previous one is
+// kept in ratings_history.
+func Update(tx Tx, filmId uint64, userId uint64, rating uint16) error {
+	var previous uint16
+	err := tx.QueryRow("SELECT rating FROM ratings "+
+		"WHERE id_user = $1 AND id_film = $2 FOR UPDATE", userId, filmId).Scan(&previous)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNoRating
+		}
+		return fmt.Errorf("update rating err: %w", err)
+	}
+
+	_, err = tx.Exec("UPDATE ratings SET rating = $3, edited_at = CURRENT_TIMESTAMP "+
+		"WHERE id_user = $1 AND id_film = $2", userId, filmId, rating)
+	if err != nil {
+		return fmt.Errorf("update rating err: %w", err)
+	}
+
+	return Replaced(tx, filmId, userId, previous, rating)
+}
+
+// Replaced counts the change of the rating of the user to the film from
+// previous to rating. The previous one is kept in ratings_history.
+func Replaced(tx Tx, filmId uint64, userId uint64, previous uint16, rating uint16) error {
+	_, err := tx.Exec("INSERT INTO ratings_history(id_user, id_film, rating) "+
+		"VALUES ($1, $2, $3)", userId, filmId, previous)
+	if err != nil {
+		return fmt.Errorf("update rating history err: %w", err)
+	}
+
+	if err := Remove(tx, filmId, previous); err != nil {
+		return err
+	}
+
+	return Add(tx, filmId, rating)
+}
+
+// apply changes the stats by delta ratings equal to rating. Ratings
+// outside 1-10 are not counted.
+func apply(tx Tx, filmId uint64, rating uint16, delta int) error {
+	if rating < 1 || rating > MaxRating {
+		return nil
+	}
+
+	_, err := tx.Exec("INSERT INTO film_rating_stats(id_film) VALUES ($1) ON CONFLICT (id_film) DO NOTHING", filmId)
+	if err != nil {
+		return fmt.Errorf("update rating stats err: %w", err)
+	}
+
+	_, err = tx.Exec("UPDATE film_rating_stats SET rating_count = rating_count + $2::INTEGER, "+
+		"rating_sum = rating_sum + $2::INTEGER * $3::INTEGER, "+
+		"histogram[$3::INTEGER] = histogram[$3::INTEGER] + $2::INTEGER "+
+		"WHERE id_film = $1", filmId, delta, rating)
+	if err != nil {
+		return fmt.Errorf("update rating stats err: %w", err)
+	}
+
+	return nil
+}
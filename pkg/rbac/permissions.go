@@ -0,0 +1,11 @@
+package rbac
+
+// Permissions are granted to roles in the role_permission table of the
+// authorization database. The names here must match the rows there.
+const (
+	AddFilm          = "films.add"
+	ModerateComments = "comments.moderate"
+	ReadUsers        = "users.read"
+	ChangeRole       = "users.change_role"
+	ManageMfaRoles   = "mfa.manage_roles"
+)
@@ -11,14 +11,33 @@ type (
 	}
 
 	SigninRequest struct {
-		Login    string `json:"login"`
-		Password string `json:"password"`
+		Login      string `json:"login"`
+		Password   string `json:"password"`
+		RememberMe bool   `json:"remember_me"`
 	}
 
 	CommentRequest struct {
+		FilmId  uint64 `json:"film_id"`
+		Title   string `json:"title"`
+		Text    string `json:"text"`
+		Spoiler bool   `json:"spoiler"`
+	}
+
+	RatingRequest struct {
 		FilmId uint64 `json:"film_id"`
 		Rating uint16 `json:"rating"`
-		Text   string `json:"text"`
+	}
+
+	ReplyRequest struct {
+		CommentId uint64 `json:"comment_id"`
+		Text      string `json:"text"`
+	}
+
+	// ReactionRequest sets the reaction of the user to the comment: 1 is a
+	// like, -1 is a dislike and 0 takes the reaction back.
+	ReactionRequest struct {
+		CommentId uint64 `json:"comment_id"`
+		Reaction  int8   `json:"reaction"`
 	}
 
 	EditProfileRequest struct {
@@ -37,6 +56,8 @@ type (
 		Mpaa       string   `json:"mpaa"`
 		Genres     []uint32 `json:"genres"`
 		Actors     []string `json:"actors"`
+		Sort       string   `json:"sort"`
+		Order      string   `json:"order"`
 		Page       uint64   `json:"page"`
 		PerPage    uint64   `json:"per_page"`
 	}
@@ -56,8 +77,64 @@ type (
 		Role  string `json:"role"`
 	}
 
+	RevokeSessionRequest struct {
+		Id string `json:"id"`
+	}
+
+	DeleteAccountRequest struct {
+		Password string `json:"password"`
+		Code     string `json:"code"`
+	}
+
+	VerifyEmailRequest struct {
+		Token string `json:"token"`
+	}
+
+	ForgotPasswordRequest struct {
+		Email string `json:"email"`
+	}
+
+	ResetPasswordRequest struct {
+		Token    string `json:"token"`
+		Password string `json:"password"`
+	}
+
+	SigninMfaRequest struct {
+		MfaToken string `json:"mfa_token"`
+		Code     string `json:"code"`
+	}
+
+	RefreshTokenRequest struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	TotpCodeRequest struct {
+		Code string `json:"code"`
+	}
+
+	RoleMfaRequest struct {
+		Role     string `json:"role"`
+		Required bool   `json:"required"`
+	}
+
 	DeleteCommentRequest struct {
-		IdUser    uint64 `json:"user_id"`
-		IdFilm    uint64 `json:"film_id"`
+		IdFilm uint64 `json:"film_id"`
+	}
+
+	DeleteRatingRequest struct {
+		IdFilm uint64 `json:"film_id"`
+	}
+
+	ReportRequest struct {
+		CommentId uint64 `json:"comment_id"`
+		Reason    string `json:"reason"`
+	}
+
+	// ModerateRequest hides, restores or deletes the comment. The reason
+	// is kept in the moderation log.
+	ModerateRequest struct {
+		CommentId uint64 `json:"comment_id"`
+		Action    string `json:"action"`
+		Reason    string `json:"reason"`
 	}
 )
\ No newline at end of file
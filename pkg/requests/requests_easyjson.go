@@ -18,7 +18,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jlexer.Lexer, out *UsersResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jlexer.Lexer, out *VerifyEmailRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -37,29 +37,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jl
 			continue
 		}
 		switch key {
-		case "users":
-			if in.IsNull() {
-				in.Skip()
-				out.Users = nil
-			} else {
-				in.Delim('[')
-				if out.Users == nil {
-					if !in.IsDelim(']') {
-						out.Users = make([]models.UserItem, 0, 0)
-					} else {
-						out.Users = []models.UserItem{}
-					}
-				} else {
-					out.Users = (out.Users)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 models.UserItem
-					(v1).UnmarshalEasyJSON(in)
-					out.Users = append(out.Users, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "token":
+			out.Token = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -70,53 +49,42 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(in *jl
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(out *jwriter.Writer, in UsersResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(out *jwriter.Writer, in VerifyEmailRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"users\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix[1:])
-		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Users {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				(v3).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Token))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UsersResponse) MarshalJSON() ([]byte, error) {
+func (v VerifyEmailRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v VerifyEmailRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UsersResponse) UnmarshalJSON(data []byte) error {
+func (v *VerifyEmailRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *VerifyEmailRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *jlexer.Lexer, out *SubcribeResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *jlexer.Lexer, out *UsersStatisticsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -135,8 +103,12 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *j
 			continue
 		}
 		switch key {
-		case "subscribe":
-			out.IsSubcribed = bool(in.Bool())
+		case "genre_id":
+			out.GenreId = uint64(in.Uint64())
+		case "count":
+			out.Count = uint64(in.Uint64())
+		case "avg":
+			out.Avg = float64(in.Float64())
 		default:
 			in.SkipRecursive()
 		}
@@ -147,42 +119,52 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(out *jwriter.Writer, in SubcribeResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(out *jwriter.Writer, in UsersStatisticsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"subscribe\":"
+		const prefix string = ",\"genre_id\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsSubcribed))
+		out.Uint64(uint64(in.GenreId))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Count))
+	}
+	{
+		const prefix string = ",\"avg\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Avg))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SubcribeResponse) MarshalJSON() ([]byte, error) {
+func (v UsersStatisticsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SubcribeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UsersStatisticsResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SubcribeResponse) UnmarshalJSON(data []byte) error {
+func (v *UsersStatisticsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SubcribeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UsersStatisticsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests1(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *jlexer.Lexer, out *SignupRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *jlexer.Lexer, out *UsersResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -201,16 +183,33 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *j
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "password":
-			out.Password = string(in.String())
-		case "birth_date":
-			out.BirthDate = string(in.String())
-		case "name":
-			out.Name = string(in.String())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]models.UserItem, 0, 0)
+					} else {
+						out.Users = []models.UserItem{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 models.UserItem
+					(v1).UnmarshalEasyJSON(in)
+					out.Users = append(out.Users, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "next_cursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -221,62 +220,63 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(out *jwriter.Writer, in SignupRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(out *jwriter.Writer, in UsersResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"users\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
-	}
-	{
-		const prefix string = ",\"email\":"
-		out.RawString(prefix)
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"password\":"
-		out.RawString(prefix)
-		out.String(string(in.Password))
+		if in.Users == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Users {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	{
-		const prefix string = ",\"birth_date\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.String(string(in.BirthDate))
+		out.Uint64(uint64(in.Total))
 	}
-	{
-		const prefix string = ",\"name\":"
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.NextCursor))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SignupRequest) MarshalJSON() ([]byte, error) {
+func (v UsersResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SignupRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v UsersResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SignupRequest) UnmarshalJSON(data []byte) error {
+func (v *UsersResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SignupRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *UsersResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests2(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *jlexer.Lexer, out *SigninRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *jlexer.Lexer, out *TotpEnrollResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -295,10 +295,10 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *j
 			continue
 		}
 		switch key {
-		case "login":
-			out.Login = string(in.String())
-		case "password":
-			out.Password = string(in.String())
+		case "secret":
+			out.Secret = string(in.String())
+		case "uri":
+			out.Uri = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -309,47 +309,47 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(out *jwriter.Writer, in SigninRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(out *jwriter.Writer, in TotpEnrollResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"login\":"
+		const prefix string = ",\"secret\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Login))
+		out.String(string(in.Secret))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"uri\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.String(string(in.Uri))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SigninRequest) MarshalJSON() ([]byte, error) {
+func (v TotpEnrollResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SigninRequest) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TotpEnrollResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SigninRequest) UnmarshalJSON(data []byte) error {
+func (v *TotpEnrollResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SigninRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TotpEnrollResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests3(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *jlexer.Lexer, out *Response) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *jlexer.Lexer, out *TotpCodeRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -368,16 +368,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *j
 			continue
 		}
 		switch key {
-		case "status":
-			out.Status = int(in.Int())
-		case "body":
-			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
-				m.UnmarshalEasyJSON(in)
-			} else if m, ok := out.Body.(json.Unmarshaler); ok {
-				_ = m.UnmarshalJSON(in.Raw())
-			} else {
-				out.Body = in.Interface()
-			}
+		case "code":
+			out.Code = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -388,53 +380,42 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(out *jwriter.Writer, in Response) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(out *jwriter.Writer, in TotpCodeRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"status\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Status))
-	}
-	{
-		const prefix string = ",\"body\":"
-		out.RawString(prefix)
-		if m, ok := in.Body.(easyjson.Marshaler); ok {
-			m.MarshalEasyJSON(out)
-		} else if m, ok := in.Body.(json.Marshaler); ok {
-			out.Raw(m.MarshalJSON())
-		} else {
-			out.Raw(json.Marshal(in.Body))
-		}
+		out.String(string(in.Code))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Response) MarshalJSON() ([]byte, error) {
+func (v TotpCodeRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TotpCodeRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Response) UnmarshalJSON(data []byte) error {
+func (v *TotpCodeRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TotpCodeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests4(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *jlexer.Lexer, out *ProfileResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *jlexer.Lexer, out *TokenResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -453,16 +434,12 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *j
 			continue
 		}
 		switch key {
-		case "email":
-			out.Email = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "login":
-			out.Login = string(in.String())
-		case "photo":
-			out.Photo = string(in.String())
-		case "birthday":
-			out.BirthDate = string(in.String())
+		case "access_token":
+			out.AccessToken = string(in.String())
+		case "refresh_token":
+			out.RefreshToken = string(in.String())
+		case "expires_at":
+			out.ExpiresAt = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -473,62 +450,52 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(out *jwriter.Writer, in ProfileResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(out *jwriter.Writer, in TokenResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"access_token\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Email))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"login\":"
-		out.RawString(prefix)
-		out.String(string(in.Login))
+		out.String(string(in.AccessToken))
 	}
 	{
-		const prefix string = ",\"photo\":"
+		const prefix string = ",\"refresh_token\":"
 		out.RawString(prefix)
-		out.String(string(in.Photo))
+		out.String(string(in.RefreshToken))
 	}
 	{
-		const prefix string = ",\"birthday\":"
+		const prefix string = ",\"expires_at\":"
 		out.RawString(prefix)
-		out.String(string(in.BirthDate))
+		out.String(string(in.ExpiresAt))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+func (v TokenResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TokenResponse) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+func (v *TokenResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TokenResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests5(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *jlexer.Lexer, out *FindFilmRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *jlexer.Lexer, out *SuggestResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -547,68 +514,29 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *j
 			continue
 		}
 		switch key {
-		case "title":
-			out.Title = string(in.String())
-		case "date_from":
-			out.DateFrom = string(in.String())
-		case "date_to":
-			out.DateTo = string(in.String())
-		case "rating_from":
-			out.RatingFrom = float32(in.Float32())
-		case "rating_to":
-			out.RatingTo = float32(in.Float32())
-		case "mpaa":
-			out.Mpaa = string(in.String())
-		case "genres":
-			if in.IsNull() {
-				in.Skip()
-				out.Genres = nil
-			} else {
-				in.Delim('[')
-				if out.Genres == nil {
-					if !in.IsDelim(']') {
-						out.Genres = make([]uint32, 0, 16)
-					} else {
-						out.Genres = []uint32{}
-					}
-				} else {
-					out.Genres = (out.Genres)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 uint32
-					v4 = uint32(in.Uint32())
-					out.Genres = append(out.Genres, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "actors":
+		case "items":
 			if in.IsNull() {
 				in.Skip()
-				out.Actors = nil
+				out.Items = nil
 			} else {
 				in.Delim('[')
-				if out.Actors == nil {
+				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Actors = make([]string, 0, 4)
+						out.Items = make([]models.SuggestItem, 0, 1)
 					} else {
-						out.Actors = []string{}
+						out.Items = []models.SuggestItem{}
 					}
 				} else {
-					out.Actors = (out.Actors)[:0]
+					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v5 string
-					v5 = string(in.String())
-					out.Actors = append(out.Actors, v5)
+					var v4 models.SuggestItem
+					(v4).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v4)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "page":
-			out.Page = uint64(in.Uint64())
-		case "per_page":
-			out.PerPage = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -619,21 +547,2163 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(out *jwriter.Writer, in FindFilmRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(out *jwriter.Writer, in SuggestResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"items\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"date_from\":"
-		out.RawString(prefix)
-		out.String(string(in.DateFrom))
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Items {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
-	{
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *jlexer.Lexer, out *SubcribeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "subscribe":
+			out.IsSubcribed = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *jwriter.Writer, in SubcribeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"subscribe\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsSubcribed))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SubcribeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SubcribeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SubcribeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SubcribeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *jlexer.Lexer, out *SignupRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "birth_date":
+			out.BirthDate = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *jwriter.Writer, in SignupRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"birth_date\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SignupRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SignupRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SignupRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SignupRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *jlexer.Lexer, out *SigninResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mfa_required":
+			out.MfaRequired = bool(in.Bool())
+		case "mfa_token":
+			out.MfaToken = string(in.String())
+		case "mfa_enrollment_required":
+			out.MfaEnrollmentRequired = bool(in.Bool())
+		case "access_token":
+			out.AccessToken = string(in.String())
+		case "refresh_token":
+			out.RefreshToken = string(in.String())
+		case "expires_at":
+			out.ExpiresAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *jwriter.Writer, in SigninResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mfa_required\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.MfaRequired))
+	}
+	if in.MfaToken != "" {
+		const prefix string = ",\"mfa_token\":"
+		out.RawString(prefix)
+		out.String(string(in.MfaToken))
+	}
+	{
+		const prefix string = ",\"mfa_enrollment_required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.MfaEnrollmentRequired))
+	}
+	if in.AccessToken != "" {
+		const prefix string = ",\"access_token\":"
+		out.RawString(prefix)
+		out.String(string(in.AccessToken))
+	}
+	if in.RefreshToken != "" {
+		const prefix string = ",\"refresh_token\":"
+		out.RawString(prefix)
+		out.String(string(in.RefreshToken))
+	}
+	if in.ExpiresAt != "" {
+		const prefix string = ",\"expires_at\":"
+		out.RawString(prefix)
+		out.String(string(in.ExpiresAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SigninResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SigninResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SigninResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SigninResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *jlexer.Lexer, out *SigninRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "login":
+			out.Login = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "remember_me":
+			out.RememberMe = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(out *jwriter.Writer, in SigninRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"remember_me\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.RememberMe))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SigninRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SigninRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SigninRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SigninRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *jlexer.Lexer, out *SigninMfaRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "mfa_token":
+			out.MfaToken = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(out *jwriter.Writer, in SigninMfaRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"mfa_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.MfaToken))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SigninMfaRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SigninMfaRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SigninMfaRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SigninMfaRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *jlexer.Lexer, out *SessionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
+			} else {
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]SessionItem, 0, 0)
+					} else {
+						out.Sessions = []SessionItem{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 SessionItem
+					(v7).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out *jwriter.Writer, in SessionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sessions\":"
+		out.RawString(prefix[1:])
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Sessions {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *jlexer.Lexer, out *SessionItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		case "created_at":
+			out.CreatedAt = string(in.String())
+		case "last_seen":
+			out.LastSeen = string(in.String())
+		case "user_agent":
+			out.UserAgent = string(in.String())
+		case "ip":
+			out.Ip = string(in.String())
+		case "current":
+			out.Current = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(out *jwriter.Writer, in SessionItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"last_seen\":"
+		out.RawString(prefix)
+		out.String(string(in.LastSeen))
+	}
+	{
+		const prefix string = ",\"user_agent\":"
+		out.RawString(prefix)
+		out.String(string(in.UserAgent))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.Ip))
+	}
+	{
+		const prefix string = ",\"current\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Current))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SessionItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SessionItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SessionItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SessionItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *jlexer.Lexer, out *RoleMfaRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "role":
+			out.Role = string(in.String())
+		case "required":
+			out.Required = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out *jwriter.Writer, in RoleMfaRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RoleMfaRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RoleMfaRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RoleMfaRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RoleMfaRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *jlexer.Lexer, out *RevokeSessionsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "revoked":
+			out.Revoked = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out *jwriter.Writer, in RevokeSessionsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"revoked\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Revoked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RevokeSessionsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RevokeSessionsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RevokeSessionsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RevokeSessionsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *jlexer.Lexer, out *RevokeSessionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(out *jwriter.Writer, in RevokeSessionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Id))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RevokeSessionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RevokeSessionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RevokeSessionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RevokeSessionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *jlexer.Lexer, out *Response) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = int(in.Int())
+		case "body":
+			if m, ok := out.Body.(easyjson.Unmarshaler); ok {
+				m.UnmarshalEasyJSON(in)
+			} else if m, ok := out.Body.(json.Unmarshaler); ok {
+				_ = m.UnmarshalJSON(in.Raw())
+			} else {
+				out.Body = in.Interface()
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out *jwriter.Writer, in Response) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		if m, ok := in.Body.(easyjson.Marshaler); ok {
+			m.MarshalEasyJSON(out)
+		} else if m, ok := in.Body.(json.Marshaler); ok {
+			out.Raw(m.MarshalJSON())
+		} else {
+			out.Raw(json.Marshal(in.Body))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Response) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Response) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Response) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Response) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *jlexer.Lexer, out *ResetPasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out *jwriter.Writer, in ResetPasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResetPasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResetPasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResetPasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResetPasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(in *jlexer.Lexer, out *ReportRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.CommentId = uint64(in.Uint64())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(out *jwriter.Writer, in ReportRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CommentId))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReportRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReportRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReportRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReportRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests19(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(in *jlexer.Lexer, out *ReplyRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.CommentId = uint64(in.Uint64())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(out *jwriter.Writer, in ReplyRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CommentId))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReplyRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReplyRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReplyRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReplyRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests20(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(in *jlexer.Lexer, out *RepliesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "replies":
+			if in.IsNull() {
+				in.Skip()
+				out.Replies = nil
+			} else {
+				in.Delim('[')
+				if out.Replies == nil {
+					if !in.IsDelim(']') {
+						out.Replies = make([]models.ReplyItem, 0, 0)
+					} else {
+						out.Replies = []models.ReplyItem{}
+					}
+				} else {
+					out.Replies = (out.Replies)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 models.ReplyItem
+					(v10).UnmarshalEasyJSON(in)
+					out.Replies = append(out.Replies, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(out *jwriter.Writer, in RepliesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix[1:])
+		if in.Replies == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Replies {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RepliesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RepliesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RepliesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RepliesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests21(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(in *jlexer.Lexer, out *RefreshTokenRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "refresh_token":
+			out.RefreshToken = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(out *jwriter.Writer, in RefreshTokenRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"refresh_token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RefreshToken))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RefreshTokenRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RefreshTokenRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RefreshTokenRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RefreshTokenRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests22(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(in *jlexer.Lexer, out *RecoveryCodesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "recovery_codes":
+			if in.IsNull() {
+				in.Skip()
+				out.RecoveryCodes = nil
+			} else {
+				in.Delim('[')
+				if out.RecoveryCodes == nil {
+					if !in.IsDelim(']') {
+						out.RecoveryCodes = make([]string, 0, 4)
+					} else {
+						out.RecoveryCodes = []string{}
+					}
+				} else {
+					out.RecoveryCodes = (out.RecoveryCodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.RecoveryCodes = append(out.RecoveryCodes, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(out *jwriter.Writer, in RecoveryCodesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"recovery_codes\":"
+		out.RawString(prefix[1:])
+		if in.RecoveryCodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.RecoveryCodes {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RecoveryCodesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RecoveryCodesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RecoveryCodesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RecoveryCodesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests23(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(in *jlexer.Lexer, out *ReactionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.CommentId = uint64(in.Uint64())
+		case "reaction":
+			out.Reaction = int8(in.Int8())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(out *jwriter.Writer, in ReactionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CommentId))
+	}
+	{
+		const prefix string = ",\"reaction\":"
+		out.RawString(prefix)
+		out.Int8(int8(in.Reaction))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReactionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReactionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReactionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReactionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests24(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(in *jlexer.Lexer, out *RatingRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmId = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint16(in.Uint16())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(out *jwriter.Writer, in RatingRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmId))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Rating))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RatingRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RatingRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RatingRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RatingRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests25(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(in *jlexer.Lexer, out *ProfileResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "photo":
+			out.Photo = string(in.String())
+		case "birthday":
+			out.BirthDate = string(in.String())
+		case "email_verified":
+			out.EmailVerified = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(out *jwriter.Writer, in ProfileResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"photo\":"
+		out.RawString(prefix)
+		out.String(string(in.Photo))
+	}
+	{
+		const prefix string = ",\"birthday\":"
+		out.RawString(prefix)
+		out.String(string(in.BirthDate))
+	}
+	{
+		const prefix string = ",\"email_verified\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.EmailVerified))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProfileResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProfileResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProfileResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests26(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(in *jlexer.Lexer, out *ModerationQueueResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]models.ReportedComment, 0, 0)
+					} else {
+						out.Comments = []models.ReportedComment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 models.ReportedComment
+					(v16).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(out *jwriter.Writer, in ModerationQueueResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Comments {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationQueueResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationQueueResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationQueueResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationQueueResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests27(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(in *jlexer.Lexer, out *ModerateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comment_id":
+			out.CommentId = uint64(in.Uint64())
+		case "action":
+			out.Action = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(out *jwriter.Writer, in ModerateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comment_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.CommentId))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests28(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(in *jlexer.Lexer, out *ForgotPasswordRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(out *jwriter.Writer, in ForgotPasswordRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ForgotPasswordRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ForgotPasswordRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ForgotPasswordRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ForgotPasswordRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests29(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(in *jlexer.Lexer, out *FindFilmResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "films":
+			if in.IsNull() {
+				in.Skip()
+				out.Films = nil
+			} else {
+				in.Delim('[')
+				if out.Films == nil {
+					if !in.IsDelim(']') {
+						out.Films = make([]models.FilmItem, 0, 0)
+					} else {
+						out.Films = []models.FilmItem{}
+					}
+				} else {
+					out.Films = (out.Films)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 models.FilmItem
+					(v19).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "facets":
+			(out.Facets).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(out *jwriter.Writer, in FindFilmResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"films\":"
+		out.RawString(prefix[1:])
+		if in.Films == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Films {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Total))
+	}
+	{
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		(in.Facets).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FindFilmResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FindFilmResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FindFilmResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FindFilmResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests30(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(in *jlexer.Lexer, out *FindFilmRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "date_from":
+			out.DateFrom = string(in.String())
+		case "date_to":
+			out.DateTo = string(in.String())
+		case "rating_from":
+			out.RatingFrom = float32(in.Float32())
+		case "rating_to":
+			out.RatingTo = float32(in.Float32())
+		case "mpaa":
+			out.Mpaa = string(in.String())
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]uint32, 0, 16)
+					} else {
+						out.Genres = []uint32{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 uint32
+					v22 = uint32(in.Uint32())
+					out.Genres = append(out.Genres, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]string, 0, 4)
+					} else {
+						out.Actors = []string{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 string
+					v23 = string(in.String())
+					out.Actors = append(out.Actors, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sort":
+			out.Sort = string(in.String())
+		case "order":
+			out.Order = string(in.String())
+		case "page":
+			out.Page = uint64(in.Uint64())
+		case "per_page":
+			out.PerPage = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(out *jwriter.Writer, in FindFilmRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"date_from\":"
+		out.RawString(prefix)
+		out.String(string(in.DateFrom))
+	}
+	{
 		const prefix string = ",\"date_to\":"
 		out.RawString(prefix)
 		out.String(string(in.DateTo))
@@ -660,68 +2730,190 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v6, v7 := range in.Genres {
-				if v6 > 0 {
+			for v24, v25 := range in.Genres {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint32(uint32(v25))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
+		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Actors {
+				if v26 > 0 {
 					out.RawByte(',')
 				}
-				out.Uint32(uint32(v7))
+				out.String(string(v27))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix)
+		out.String(string(in.Order))
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Page))
+	}
+	{
+		const prefix string = ",\"per_page\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.PerPage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FindFilmRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FindFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FindFilmRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FindFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests31(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(in *jlexer.Lexer, out *FindActorResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actors":
+			if in.IsNull() {
+				in.Skip()
+				out.Actors = nil
+			} else {
+				in.Delim('[')
+				if out.Actors == nil {
+					if !in.IsDelim(']') {
+						out.Actors = make([]models.Character, 0, 1)
+					} else {
+						out.Actors = []models.Character{}
+					}
+				} else {
+					out.Actors = (out.Actors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 models.Character
+					(v28).UnmarshalEasyJSON(in)
+					out.Actors = append(out.Actors, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-			out.RawByte(']')
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "facets":
+			(out.Facets).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(out *jwriter.Writer, in FindActorResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
 		const prefix string = ",\"actors\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		if in.Actors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.Actors {
-				if v8 > 0 {
+			for v29, v30 := range in.Actors {
+				if v29 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v9))
+				(v30).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"page\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.Page))
+		out.Uint64(uint64(in.Total))
 	}
 	{
-		const prefix string = ",\"per_page\":"
+		const prefix string = ",\"facets\":"
 		out.RawString(prefix)
-		out.Uint64(uint64(in.PerPage))
+		(in.Facets).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FindFilmRequest) MarshalJSON() ([]byte, error) {
+func (v FindActorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FindFilmRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(w, v)
+func (v FindActorResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FindFilmRequest) UnmarshalJSON(data []byte) error {
+func (v *FindActorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FindFilmRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests6(l, v)
+func (v *FindActorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests32(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *jlexer.Lexer, out *FindActorRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(in *jlexer.Lexer, out *FindActorRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -760,9 +2952,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *j
 					out.Career = (out.Career)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Career = append(out.Career, v10)
+					var v31 string
+					v31 = string(in.String())
+					out.Career = append(out.Career, v31)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -783,9 +2975,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *j
 					out.Films = (out.Films)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v11 string
-					v11 = string(in.String())
-					out.Films = append(out.Films, v11)
+					var v32 string
+					v32 = string(in.String())
+					out.Films = append(out.Films, v32)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -806,7 +2998,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *jwriter.Writer, in FindActorRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(out *jwriter.Writer, in FindActorRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -827,11 +3019,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v12, v13 := range in.Career {
-				if v12 > 0 {
+			for v33, v34 := range in.Career {
+				if v33 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v13))
+				out.String(string(v34))
 			}
 			out.RawByte(']')
 		}
@@ -843,11 +3035,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v14, v15 := range in.Films {
-				if v14 > 0 {
+			for v35, v36 := range in.Films {
+				if v35 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v15))
+				out.String(string(v36))
 			}
 			out.RawByte(']')
 		}
@@ -873,27 +3065,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(out *
 // MarshalJSON supports json.Marshaler interface
 func (v FindActorRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FindActorRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FindActorRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FindActorRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests7(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests33(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *jlexer.Lexer, out *FilmsResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(in *jlexer.Lexer, out *FilmsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -920,6 +3112,8 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *j
 			out.CollectionName = string(in.String())
 		case "total":
 			out.Total = uint64(in.Uint64())
+		case "next_cursor":
+			out.NextCursor = string(in.String())
 		case "films":
 			if in.IsNull() {
 				in.Skip()
@@ -936,9 +3130,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *j
 					out.Films = (out.Films)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 models.FilmItem
-					(v16).UnmarshalEasyJSON(in)
-					out.Films = append(out.Films, v16)
+					var v37 models.FilmItem
+					(v37).UnmarshalEasyJSON(in)
+					out.Films = append(out.Films, v37)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -953,7 +3147,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *jwriter.Writer, in FilmsResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(out *jwriter.Writer, in FilmsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -977,6 +3171,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *
 		out.RawString(prefix)
 		out.Uint64(uint64(in.Total))
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	{
 		const prefix string = ",\"films\":"
 		out.RawString(prefix)
@@ -984,11 +3183,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Films {
-				if v17 > 0 {
+			for v38, v39 := range in.Films {
+				if v38 > 0 {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				(v39).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -999,27 +3198,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(out *
 // MarshalJSON supports json.Marshaler interface
 func (v FilmsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FilmsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FilmsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FilmsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests8(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests34(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *jlexer.Lexer, out *FilmResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(in *jlexer.Lexer, out *FilmResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1056,9 +3255,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 					out.Genres = (out.Genres)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 models.GenreItem
-					(v19).UnmarshalEasyJSON(in)
-					out.Genres = append(out.Genres, v19)
+					var v40 models.GenreItem
+					(v40).UnmarshalEasyJSON(in)
+					out.Genres = append(out.Genres, v40)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1083,9 +3282,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 					out.Directors = (out.Directors)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v20 models.CrewItem
-					(v20).UnmarshalEasyJSON(in)
-					out.Directors = append(out.Directors, v20)
+					var v41 models.CrewItem
+					(v41).UnmarshalEasyJSON(in)
+					out.Directors = append(out.Directors, v41)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1106,9 +3305,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 					out.Scenarists = (out.Scenarists)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v21 models.CrewItem
-					(v21).UnmarshalEasyJSON(in)
-					out.Scenarists = append(out.Scenarists, v21)
+					var v42 models.CrewItem
+					(v42).UnmarshalEasyJSON(in)
+					out.Scenarists = append(out.Scenarists, v42)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1129,9 +3328,32 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 					out.Characters = (out.Characters)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v22 models.Character
-					(v22).UnmarshalEasyJSON(in)
-					out.Characters = append(out.Characters, v22)
+					var v43 models.Character
+					(v43).UnmarshalEasyJSON(in)
+					out.Characters = append(out.Characters, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "rating_distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]uint64, 0, 8)
+					} else {
+						out.Distribution = []uint64{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v44 uint64
+					v44 = uint64(in.Uint64())
+					out.Distribution = append(out.Distribution, v44)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1146,7 +3368,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(in *j
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *jwriter.Writer, in FilmResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(out *jwriter.Writer, in FilmResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1162,11 +3384,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v23, v24 := range in.Genres {
-				if v23 > 0 {
+			for v45, v46 := range in.Genres {
+				if v45 > 0 {
 					out.RawByte(',')
 				}
-				(v24).MarshalEasyJSON(out)
+				(v46).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1188,74 +3410,250 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(out *
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v25, v26 := range in.Directors {
-				if v25 > 0 {
+			for v47, v48 := range in.Directors {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"scenarists\":"
+		out.RawString(prefix)
+		if in.Scenarists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v49, v50 := range in.Scenarists {
+				if v49 > 0 {
+					out.RawByte(',')
+				}
+				(v50).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"actors\":"
+		out.RawString(prefix)
+		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v51, v52 := range in.Characters {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				(v52).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"rating_distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Distribution {
+				if v53 > 0 {
 					out.RawByte(',')
 				}
-				(v26).MarshalEasyJSON(out)
+				out.Uint64(uint64(v54))
 			}
 			out.RawByte(']')
 		}
 	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FilmResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FilmResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FilmResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FilmResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests35(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(in *jlexer.Lexer, out *ExportRating) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmId = uint64(in.Uint64())
+		case "rating":
+			out.Rating = uint16(in.Uint16())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(out *jwriter.Writer, in ExportRating) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmId))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Uint16(uint16(in.Rating))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportRating) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportRating) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportRating) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportRating) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests36(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(in *jlexer.Lexer, out *ExportComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.FilmId = uint64(in.Uint64())
+		case "title":
+			out.Title = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "spoiler":
+			out.Spoiler = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(out *jwriter.Writer, in ExportComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"film_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.FilmId))
+	}
 	{
-		const prefix string = ",\"scenarists\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		if in.Scenarists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v27, v28 := range in.Scenarists {
-				if v27 > 0 {
-					out.RawByte(',')
-				}
-				(v28).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"actors\":"
+		const prefix string = ",\"text\":"
 		out.RawString(prefix)
-		if in.Characters == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Characters {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				(v30).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"spoiler\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Spoiler))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FilmResponse) MarshalJSON() ([]byte, error) {
+func (v ExportComment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FilmResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(w, v)
+func (v ExportComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FilmResponse) UnmarshalJSON(data []byte) error {
+func (v *ExportComment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FilmResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests9(l, v)
+func (v *ExportComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests37(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *jlexer.Lexer, out *EditProfileRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(in *jlexer.Lexer, out *EditProfileRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1297,7 +3695,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(out *jwriter.Writer, in EditProfileRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(out *jwriter.Writer, in EditProfileRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1327,27 +3725,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(out
 // MarshalJSON supports json.Marshaler interface
 func (v EditProfileRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v EditProfileRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *EditProfileRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *EditProfileRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests10(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests38(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *jlexer.Lexer, out *DeleteCommentRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(in *jlexer.Lexer, out *DeleteRatingRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1366,8 +3764,6 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *
 			continue
 		}
 		switch key {
-		case "user_id":
-			out.IdUser = uint64(in.Uint64())
 		case "film_id":
 			out.IdFilm = uint64(in.Uint64())
 		default:
@@ -1380,18 +3776,79 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(out *jwriter.Writer, in DeleteCommentRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(out *jwriter.Writer, in DeleteRatingRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user_id\":"
+		const prefix string = ",\"film_id\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.IdUser))
+		out.Uint64(uint64(in.IdFilm))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteRatingRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteRatingRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteRatingRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteRatingRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests39(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(in *jlexer.Lexer, out *DeleteCommentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "film_id":
+			out.IdFilm = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(out *jwriter.Writer, in DeleteCommentRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
 		const prefix string = ",\"film_id\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		out.Uint64(uint64(in.IdFilm))
 	}
 	out.RawByte('}')
@@ -1400,27 +3857,100 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(out
 // MarshalJSON supports json.Marshaler interface
 func (v DeleteCommentRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeleteCommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DeleteCommentRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DeleteCommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests11(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests40(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(in *jlexer.Lexer, out *DeleteAccountRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "password":
+			out.Password = string(in.String())
+		case "code":
+			out.Code = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(out *jwriter.Writer, in DeleteAccountRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix)
+		out.String(string(in.Code))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeleteAccountRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteAccountRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteAccountRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests41(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *jlexer.Lexer, out *CommentResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(in *jlexer.Lexer, out *CommentResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1455,13 +3985,17 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *
 					out.Comments = (out.Comments)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 models.CommentItem
-					(v34).UnmarshalEasyJSON(in)
-					out.Comments = append(out.Comments, v34)
+					var v58 models.CommentItem
+					(v58).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v58)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
+		case "total":
+			out.Total = uint64(in.Uint64())
+		case "next_cursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1472,7 +4006,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out *jwriter.Writer, in CommentResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(out *jwriter.Writer, in CommentResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1483,42 +4017,52 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Comments {
-				if v35 > 0 {
+			for v59, v60 := range in.Comments {
+				if v59 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				(v60).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Total))
+	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CommentResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests12(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests42(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *jlexer.Lexer, out *CommentRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(in *jlexer.Lexer, out *CommentRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1539,10 +4083,12 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *
 		switch key {
 		case "film_id":
 			out.FilmId = uint64(in.Uint64())
-		case "rating":
-			out.Rating = uint16(in.Uint16())
+		case "title":
+			out.Title = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "spoiler":
+			out.Spoiler = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1553,7 +4099,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(out *jwriter.Writer, in CommentRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(out *jwriter.Writer, in CommentRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1563,42 +4109,47 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(out
 		out.Uint64(uint64(in.FilmId))
 	}
 	{
-		const prefix string = ",\"rating\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.Uint16(uint16(in.Rating))
+		out.String(string(in.Title))
 	}
 	{
 		const prefix string = ",\"text\":"
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
+	{
+		const prefix string = ",\"spoiler\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Spoiler))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CommentRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CommentRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CommentRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CommentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests13(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests43(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *jlexer.Lexer, out *ChangeRoleRequest) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(in *jlexer.Lexer, out *ChangeRoleRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1631,7 +4182,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out *jwriter.Writer, in ChangeRoleRequest) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(out *jwriter.Writer, in ChangeRoleRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1651,27 +4202,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(out
 // MarshalJSON supports json.Marshaler interface
 func (v ChangeRoleRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ChangeRoleRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ChangeRoleRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ChangeRoleRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests14(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests44(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *jlexer.Lexer, out *CalendarResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(in *jlexer.Lexer, out *CalendarResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1712,9 +4263,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *
 					out.Days = (out.Days)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v37 models.DayItem
-					(v37).UnmarshalEasyJSON(in)
-					out.Days = append(out.Days, v37)
+					var v61 models.DayItem
+					(v61).UnmarshalEasyJSON(in)
+					out.Days = append(out.Days, v61)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1729,7 +4280,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out *jwriter.Writer, in CalendarResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(out *jwriter.Writer, in CalendarResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1755,11 +4306,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.Days {
-				if v38 > 0 {
+			for v62, v63 := range in.Days {
+				if v62 > 0 {
 					out.RawByte(',')
 				}
-				(v39).MarshalEasyJSON(out)
+				(v63).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1770,27 +4321,27 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(out
 // MarshalJSON supports json.Marshaler interface
 func (v CalendarResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CalendarResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CalendarResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CalendarResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests15(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests45(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *jlexer.Lexer, out *AuthCheckResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(in *jlexer.Lexer, out *AuthCheckResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1823,7 +4374,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(out *jwriter.Writer, in AuthCheckResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(out *jwriter.Writer, in AuthCheckResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1843,27 +4394,93 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(out
 // MarshalJSON supports json.Marshaler interface
 func (v AuthCheckResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AuthCheckResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AuthCheckResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AuthCheckResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests16(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests46(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(in *jlexer.Lexer, out *AddReplyResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(out *jwriter.Writer, in AddReplyResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Id))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddReplyResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddReplyResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddReplyResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddReplyResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests47(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *jlexer.Lexer, out *ActorsResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(in *jlexer.Lexer, out *ActorsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1898,15 +4515,17 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *
 					out.Actors = (out.Actors)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 models.Character
-					(v40).UnmarshalEasyJSON(in)
-					out.Actors = append(out.Actors, v40)
+					var v64 models.Character
+					(v64).UnmarshalEasyJSON(in)
+					out.Actors = append(out.Actors, v64)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
 		case "total":
 			out.Total = uint64(in.Uint64())
+		case "next_cursor":
+			out.NextCursor = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1917,7 +4536,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out *jwriter.Writer, in ActorsResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(out *jwriter.Writer, in ActorsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1928,11 +4547,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v41, v42 := range in.Actors {
-				if v41 > 0 {
+			for v65, v66 := range in.Actors {
+				if v65 > 0 {
 					out.RawByte(',')
 				}
-				(v42).MarshalEasyJSON(out)
+				(v66).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1942,33 +4561,38 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(out
 		out.RawString(prefix)
 		out.Uint64(uint64(in.Total))
 	}
+	if in.NextCursor != "" {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.NextCursor))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ActorsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ActorsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ActorsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ActorsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests17(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests48(l, v)
 }
-func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *jlexer.Lexer, out *ActorResponse) {
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(in *jlexer.Lexer, out *ActorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2007,9 +4631,9 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *
 					out.Career = (out.Career)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v43 models.ProfessionItem
-					(v43).UnmarshalEasyJSON(in)
-					out.Career = append(out.Career, v43)
+					var v67 models.ProfessionItem
+					(v67).UnmarshalEasyJSON(in)
+					out.Career = append(out.Career, v67)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2030,7 +4654,7 @@ func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(in *
 		in.Consumed()
 	}
 }
-func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out *jwriter.Writer, in ActorResponse) {
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(out *jwriter.Writer, in ActorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2051,11 +4675,11 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v44, v45 := range in.Career {
-				if v44 > 0 {
+			for v68, v69 := range in.Career {
+				if v68 > 0 {
 					out.RawByte(',')
 				}
-				(v45).MarshalEasyJSON(out)
+				(v69).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2081,23 +4705,383 @@ func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(out
 // MarshalJSON supports json.Marshaler interface
 func (v ActorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ActorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(w, v)
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ActorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(&r, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ActorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests18(l, v)
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests49(l, v)
+}
+func easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(in *jlexer.Lexer, out *AccountExportResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "exported_at":
+			out.ExportedAt = string(in.String())
+		case "profile":
+			(out.Profile).UnmarshalEasyJSON(in)
+		case "role":
+			out.Role = string(in.String())
+		case "subscribed":
+			out.Subscribed = bool(in.Bool())
+		case "linked_accounts":
+			if in.IsNull() {
+				in.Skip()
+				out.LinkedAccounts = nil
+			} else {
+				in.Delim('[')
+				if out.LinkedAccounts == nil {
+					if !in.IsDelim(']') {
+						out.LinkedAccounts = make([]string, 0, 4)
+					} else {
+						out.LinkedAccounts = []string{}
+					}
+				} else {
+					out.LinkedAccounts = (out.LinkedAccounts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 string
+					v70 = string(in.String())
+					out.LinkedAccounts = append(out.LinkedAccounts, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "sessions":
+			if in.IsNull() {
+				in.Skip()
+				out.Sessions = nil
+			} else {
+				in.Delim('[')
+				if out.Sessions == nil {
+					if !in.IsDelim(']') {
+						out.Sessions = make([]SessionItem, 0, 0)
+					} else {
+						out.Sessions = []SessionItem{}
+					}
+				} else {
+					out.Sessions = (out.Sessions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v71 SessionItem
+					(v71).UnmarshalEasyJSON(in)
+					out.Sessions = append(out.Sessions, v71)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "favorite_films":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteFilms = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteFilms == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteFilms = make([]uint64, 0, 8)
+					} else {
+						out.FavoriteFilms = []uint64{}
+					}
+				} else {
+					out.FavoriteFilms = (out.FavoriteFilms)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v72 uint64
+					v72 = uint64(in.Uint64())
+					out.FavoriteFilms = append(out.FavoriteFilms, v72)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "favorite_actors":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteActors = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteActors == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteActors = make([]uint64, 0, 8)
+					} else {
+						out.FavoriteActors = []uint64{}
+					}
+				} else {
+					out.FavoriteActors = (out.FavoriteActors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 uint64
+					v73 = uint64(in.Uint64())
+					out.FavoriteActors = append(out.FavoriteActors, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "ratings":
+			if in.IsNull() {
+				in.Skip()
+				out.Ratings = nil
+			} else {
+				in.Delim('[')
+				if out.Ratings == nil {
+					if !in.IsDelim(']') {
+						out.Ratings = make([]ExportRating, 0, 4)
+					} else {
+						out.Ratings = []ExportRating{}
+					}
+				} else {
+					out.Ratings = (out.Ratings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v74 ExportRating
+					(v74).UnmarshalEasyJSON(in)
+					out.Ratings = append(out.Ratings, v74)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "seen_films":
+			if in.IsNull() {
+				in.Skip()
+				out.SeenFilms = nil
+			} else {
+				in.Delim('[')
+				if out.SeenFilms == nil {
+					if !in.IsDelim(']') {
+						out.SeenFilms = make([]uint64, 0, 8)
+					} else {
+						out.SeenFilms = []uint64{}
+					}
+				} else {
+					out.SeenFilms = (out.SeenFilms)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v75 uint64
+					v75 = uint64(in.Uint64())
+					out.SeenFilms = append(out.SeenFilms, v75)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]ExportComment, 0, 1)
+					} else {
+						out.Comments = []ExportComment{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 ExportComment
+					(v76).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(out *jwriter.Writer, in AccountExportResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"exported_at\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ExportedAt))
+	}
+	{
+		const prefix string = ",\"profile\":"
+		out.RawString(prefix)
+		(in.Profile).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"subscribed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Subscribed))
+	}
+	{
+		const prefix string = ",\"linked_accounts\":"
+		out.RawString(prefix)
+		if in.LinkedAccounts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v77, v78 := range in.LinkedAccounts {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v78))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"sessions\":"
+		out.RawString(prefix)
+		if in.Sessions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v79, v80 := range in.Sessions {
+				if v79 > 0 {
+					out.RawByte(',')
+				}
+				(v80).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"favorite_films\":"
+		out.RawString(prefix)
+		if in.FavoriteFilms == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v81, v82 := range in.FavoriteFilms {
+				if v81 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v82))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"favorite_actors\":"
+		out.RawString(prefix)
+		if in.FavoriteActors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.FavoriteActors {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v84))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"ratings\":"
+		out.RawString(prefix)
+		if in.Ratings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v85, v86 := range in.Ratings {
+				if v85 > 0 {
+					out.RawByte(',')
+				}
+				(v86).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"seen_films\":"
+		out.RawString(prefix)
+		if in.SeenFilms == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v87, v88 := range in.SeenFilms {
+				if v87 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v88))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Comments {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				(v90).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AccountExportResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AccountExportResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson11d1a9baEncodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AccountExportResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AccountExportResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson11d1a9baDecodeGithubComGoParkMailRu20232VkladyshiPkgRequests50(l, v)
 }
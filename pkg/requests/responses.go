@@ -23,6 +23,7 @@ type (
 		PageSize       uint64            `json:"page_size"`
 		CollectionName string            `json:"collection_name"`
 		Total          uint64            `json:"total"`
+		NextCursor     string            `json:"next_cursor,omitempty"`
 		Films          []models.FilmItem `json:"films"`
 	}
 
@@ -34,6 +35,8 @@ type (
 		Directors  []models.CrewItem  `json:"directors"`
 		Scenarists []models.CrewItem  `json:"scenarists"`
 		Characters []models.Character `json:"actors"`
+		// Distribution[i] is the number of ratings equal to i+1.
+		Distribution []uint64 `json:"rating_distribution"`
 	}
 
 	ActorResponse struct {
@@ -46,20 +49,56 @@ type (
 	}
 
 	ActorsResponse struct {
+		Actors     []models.Character `json:"actors"`
+		Total      uint64             `json:"total"`
+		NextCursor string             `json:"next_cursor,omitempty"`
+	}
+
+	// FindFilmResponse is a page of the found films. Total and the facets
+	// count all the films found by the filters.
+	FindFilmResponse struct {
+		Films  []models.FilmItem `json:"films"`
+		Total  uint64            `json:"total"`
+		Facets models.FilmFacets `json:"facets"`
+	}
+
+	FindActorResponse struct {
 		Actors []models.Character `json:"actors"`
 		Total  uint64             `json:"total"`
+		Facets models.ActorFacets `json:"facets"`
 	}
 
 	CommentResponse struct {
-		Comments []models.CommentItem `json:"comment"`
+		Comments   []models.CommentItem `json:"comment"`
+		Total      uint64               `json:"total"`
+		NextCursor string               `json:"next_cursor,omitempty"`
+	}
+
+	RepliesResponse struct {
+		Replies    []models.ReplyItem `json:"replies"`
+		NextCursor string             `json:"next_cursor,omitempty"`
+	}
+
+	AddReplyResponse struct {
+		Id uint64 `json:"id"`
+	}
+
+	ModerationQueueResponse struct {
+		Comments   []models.ReportedComment `json:"comments"`
+		NextCursor string                   `json:"next_cursor,omitempty"`
+	}
+
+	SuggestResponse struct {
+		Items []models.SuggestItem `json:"items"`
 	}
 
 	ProfileResponse struct {
-		Email     string `json:"email"`
-		Name      string `json:"name"`
-		Login     string `json:"login"`
-		Photo     string `json:"photo"`
-		BirthDate string `json:"birthday"`
+		Email         string `json:"email"`
+		Name          string `json:"name"`
+		Login         string `json:"login"`
+		Photo         string `json:"photo"`
+		BirthDate     string `json:"birthday"`
+		EmailVerified bool   `json:"email_verified"`
 	}
 
 	AuthCheckResponse struct {
@@ -79,7 +118,9 @@ type (
 	}
 
 	UsersResponse struct {
-		Users []models.UserItem `json:"users"`
+		Users      []models.UserItem `json:"users"`
+		Total      uint64            `json:"total"`
+		NextCursor string            `json:"next_cursor,omitempty"`
 	}
 
 	UsersStatisticsResponse struct {
@@ -87,6 +128,73 @@ type (
 		Count   uint64  `json:"count"`
 		Avg     float64 `json:"avg"`
 	}
+
+	SigninResponse struct {
+		MfaRequired           bool   `json:"mfa_required"`
+		MfaToken              string `json:"mfa_token,omitempty"`
+		MfaEnrollmentRequired bool   `json:"mfa_enrollment_required"`
+		AccessToken           string `json:"access_token,omitempty"`
+		RefreshToken          string `json:"refresh_token,omitempty"`
+		ExpiresAt             string `json:"expires_at,omitempty"`
+	}
+
+	TokenResponse struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresAt    string `json:"expires_at"`
+	}
+
+	TotpEnrollResponse struct {
+		Secret string `json:"secret"`
+		Uri    string `json:"uri"`
+	}
+
+	RecoveryCodesResponse struct {
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+
+	SessionItem struct {
+		Id        string `json:"id"`
+		CreatedAt string `json:"created_at"`
+		LastSeen  string `json:"last_seen"`
+		UserAgent string `json:"user_agent"`
+		Ip        string `json:"ip"`
+		Current   bool   `json:"current"`
+	}
+
+	SessionsResponse struct {
+		Sessions []SessionItem `json:"sessions"`
+	}
+
+	RevokeSessionsResponse struct {
+		Revoked int `json:"revoked"`
+	}
+
+	ExportRating struct {
+		FilmId uint64 `json:"film_id"`
+		Rating uint16 `json:"rating"`
+	}
+
+	ExportComment struct {
+		FilmId  uint64 `json:"film_id"`
+		Title   string `json:"title"`
+		Text    string `json:"text"`
+		Spoiler bool   `json:"spoiler"`
+	}
+
+	AccountExportResponse struct {
+		ExportedAt     string          `json:"exported_at"`
+		Profile        ProfileResponse `json:"profile"`
+		Role           string          `json:"role"`
+		Subscribed     bool            `json:"subscribed"`
+		LinkedAccounts []string        `json:"linked_accounts"`
+		Sessions       []SessionItem   `json:"sessions"`
+		FavoriteFilms  []uint64        `json:"favorite_films"`
+		FavoriteActors []uint64        `json:"favorite_actors"`
+		Ratings        []ExportRating  `json:"ratings"`
+		SeenFilms      []uint64        `json:"seen_films"`
+		Comments       []ExportComment `json:"comments"`
+	}
 )
 
 type Collector struct {
@@ -0,0 +1,34 @@
+// Package search builds text search queries from user input.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// PrefixQuery turns the user input into a to_tsquery expression that
+// matches every word as a prefix, so that "star wa" finds "Star Wars".
+// Words starting with a minus are excluded as websearch_to_tsquery does.
+// Only letters and digits get into the expression, so it is safe to pass
+// any input. It returns an empty string if there are no words.
+func PrefixQuery(input string) string {
+	var terms []string
+	for _, field := range strings.Fields(input) {
+		negated := strings.HasPrefix(field, "-")
+		words := strings.FieldsFunc(field, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			if strings.EqualFold(word, "or") {
+				continue
+			}
+			if negated {
+				terms = append(terms, "!"+word)
+				continue
+			}
+			terms = append(terms, word+":*")
+		}
+	}
+
+	return strings.Join(terms, " & ")
+}
@@ -0,0 +1,23 @@
+package search
+
+import "testing"
+
+func TestPrefixQuery(t *testing.T) {
+	testCases := map[string]string{
+		"":                      "",
+		"star wa":               "star:* & wa:*",
+		"  star   wars!  ":      "star:* & wars:*",
+		"spider-man":            "spider:* & man:*",
+		"star -wars":            "star:* & !wars",
+		"\"star wars\" or trek": "star:* & wars:* & trek:*",
+		"'); DROP TABLE film":   "DROP:* & TABLE:* & film:*",
+		"!!! &|":                "",
+	}
+
+	for input, want := range testCases {
+		have := PrefixQuery(input)
+		if have != want {
+			t.Errorf("results not match for %q, want %q, have %q", input, want, have)
+		}
+	}
+}
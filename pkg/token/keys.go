@@ -0,0 +1,113 @@
+package token
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2023_2_Vkladyshi/configs"
+)
+
+// KeyRing is the set of keys of the authorization service. The first key
+// of the config signs new tokens; the others are only published, so that
+// tokens signed before a rotation stay valid until they expire.
+type KeyRing struct {
+	Active Key
+	Keys   []Key
+}
+
+func GetKeyRing(cfg configs.TokenCfg, lg *slog.Logger) (*KeyRing, error) {
+	ring := &KeyRing{}
+	for _, keyCfg := range cfg.Keys {
+		seed, err := base64.StdEncoding.DecodeString(keyCfg.Seed)
+		if err != nil {
+			return nil, fmt.Errorf("get key ring err: %w", err)
+		}
+
+		key, err := NewKey(keyCfg.Kid, seed)
+		if err != nil {
+			return nil, fmt.Errorf("get key ring err: %w", err)
+		}
+		ring.Keys = append(ring.Keys, key)
+	}
+
+	if len(ring.Keys) == 0 {
+		lg.Warn("signing keys are not set, access tokens will not survive a restart")
+		key, err := NewKey("ephemeral-"+time.Now().Format("20060102150405"), nil)
+		if err != nil {
+			return nil, fmt.Errorf("get key ring err: %w", err)
+		}
+		ring.Keys = append(ring.Keys, key)
+	}
+
+	ring.Active = ring.Keys[0]
+
+	return ring, nil
+}
+
+type FetchKeys func(ctx context.Context) ([]Key, error)
+
+// RemoteKeySet is a KeySet of another service. Keys are fetched lazily,
+// refreshed every refresh interval and refetched when a token is signed
+// with an unknown key, but not more often than once a minRefetch.
+type RemoteKeySet struct {
+	set        *KeySet
+	fetch      FetchKeys
+	refresh    time.Duration
+	minRefetch time.Duration
+
+	mutex     sync.Mutex
+	fetchedAt time.Time
+}
+
+func NewRemoteKeySet(fetch FetchKeys, refresh time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		set:        NewKeySet(),
+		fetch:      fetch,
+		refresh:    refresh,
+		minRefetch: 10 * time.Second,
+	}
+}
+
+// update refetches the keys when they are due. The fetch is claimed under
+// the mutex but made outside of it, so concurrent callers keep verifying
+// with the current keys instead of waiting for a slow key endpoint.
+func (s *RemoteKeySet) update(ctx context.Context, force bool) error {
+	s.mutex.Lock()
+	since := time.Since(s.fetchedAt)
+	if since < s.minRefetch || (!force && since < s.refresh) {
+		s.mutex.Unlock()
+		return nil
+	}
+	s.fetchedAt = time.Now()
+	s.mutex.Unlock()
+
+	keys, err := s.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("update keys err: %w", err)
+	}
+
+	s.set.Replace(keys)
+	return nil
+}
+
+// Verify verifies the token with the current keys of the remote service.
+func (s *RemoteKeySet) Verify(ctx context.Context, token string) (*Claims, error) {
+	if err := s.update(ctx, s.set.Len() == 0); err != nil {
+		return nil, err
+	}
+
+	claims, err := s.set.Verify(token, time.Now())
+	if err != ErrUnknownKey {
+		return claims, err
+	}
+
+	if err := s.update(ctx, true); err != nil {
+		return nil, err
+	}
+
+	return s.set.Verify(token, time.Now())
+}
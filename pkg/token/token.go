@@ -0,0 +1,195 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Access tokens are JWTs signed with Ed25519 ("EdDSA" in RFC 8037). The
+// authorization service signs them, other services verify them with the
+// public keys it publishes.
+
+const Algorithm = "EdDSA"
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token expired")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+type Claims struct {
+	Subject   string `json:"sub"`
+	UserId    uint64 `json:"uid"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Key is a signing key. Private is set only in the authorization service.
+type Key struct {
+	Kid     string
+	Public  ed25519.PublicKey
+	Private ed25519.PrivateKey
+}
+
+// NewKey creates a key from a 32 byte seed, or from a random seed if seed
+// is nil.
+func NewKey(kid string, seed []byte) (Key, error) {
+	if seed == nil {
+		seed = make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return Key{}, fmt.Errorf("new key err: %w", err)
+		}
+	}
+	if len(seed) != ed25519.SeedSize {
+		return Key{}, fmt.Errorf("new key err: seed of %s must be %d bytes", kid, ed25519.SeedSize)
+	}
+
+	private := ed25519.NewKeyFromSeed(seed)
+	return Key{Kid: kid, Public: private.Public().(ed25519.PublicKey), Private: private}, nil
+}
+
+var encoding = base64.RawURLEncoding
+
+func encodeSegment(value interface{}) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(raw), nil
+}
+
+// Sign returns the claims as a compact JWT signed with key.
+func Sign(key Key, claims Claims) (string, error) {
+	if key.Private == nil {
+		return "", fmt.Errorf("sign err: key %s has no private part", key.Kid)
+	}
+
+	head, err := encodeSegment(header{Alg: Algorithm, Typ: "JWT", Kid: key.Kid})
+	if err != nil {
+		return "", fmt.Errorf("sign err: %w", err)
+	}
+
+	body, err := encodeSegment(claims)
+	if err != nil {
+		return "", fmt.Errorf("sign err: %w", err)
+	}
+
+	signingInput := head + "." + body
+	signature := ed25519.Sign(key.Private, []byte(signingInput))
+
+	return signingInput + "." + encoding.EncodeToString(signature), nil
+}
+
+// KeySet holds the public keys tokens are verified with. It is safe for
+// concurrent use and can be replaced as a whole when keys rotate.
+type KeySet struct {
+	mutex sync.RWMutex
+	keys  map[string]ed25519.PublicKey
+}
+
+func NewKeySet(keys ...Key) *KeySet {
+	set := &KeySet{}
+	set.Replace(keys)
+	return set
+}
+
+func (s *KeySet) Replace(keys []Key) {
+	byKid := make(map[string]ed25519.PublicKey, len(keys))
+	for _, key := range keys {
+		byKid[key.Kid] = key.Public
+	}
+
+	s.mutex.Lock()
+	s.keys = byKid
+	s.mutex.Unlock()
+}
+
+func (s *KeySet) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return len(s.keys)
+}
+
+func (s *KeySet) key(kid string) (ed25519.PublicKey, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	key, found := s.keys[kid]
+	return key, found
+}
+
+// Kid returns the key ID a token claims to be signed with, without
+// verifying anything.
+func Kid(token string) (string, error) {
+	head, _, found := strings.Cut(token, ".")
+	if !found {
+		return "", ErrInvalidToken
+	}
+
+	raw, err := encoding.DecodeString(head)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	var h header
+	if err := json.Unmarshal(raw, &h); err != nil || h.Alg != Algorithm {
+		return "", ErrInvalidToken
+	}
+
+	return h.Kid, nil
+}
+
+// Verify checks the signature and the expiry of the token at time now and
+// returns its claims.
+func (s *KeySet) Verify(token string, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	kid, err := Kid(token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, found := s.key(kid)
+	if !found {
+		return nil, ErrUnknownKey
+	}
+
+	signature, err := encoding.DecodeString(parts[2])
+	if err != nil || !ed25519.Verify(key, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrInvalidToken
+	}
+
+	raw, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	claims := &Claims{}
+	if err := json.Unmarshal(raw, claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrExpiredToken
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,141 @@
+package token
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSignVerify(t *testing.T) {
+	key, err := NewKey("k1", nil)
+	if err != nil {
+		t.Fatalf("cant create key: %s", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	signed, err := Sign(key, Claims{Subject: "l1", UserId: 7, IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	set := NewKeySet(key)
+	claims, err := set.Verify(signed, now)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if claims.Subject != "l1" || claims.UserId != 7 {
+		t.Errorf("wrong claims: %+v", claims)
+		return
+	}
+
+	if _, err := set.Verify(signed, now.Add(time.Minute)); err != ErrExpiredToken {
+		t.Errorf("wanted expired token, had %v", err)
+		return
+	}
+
+	parts := strings.Split(signed, ".")
+	signature, _ := encoding.DecodeString(parts[2])
+	signature[len(signature)/2] ^= 0xff
+	tampered := parts[0] + "." + parts[1] + "." + encoding.EncodeToString(signature)
+	if _, err := set.Verify(tampered, now); err != ErrInvalidToken {
+		t.Errorf("wanted invalid token, had %v", err)
+		return
+	}
+
+	forged, _ := encodeSegment(Claims{Subject: "admin", UserId: 1, IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()})
+	tampered = parts[0] + "." + forged + "." + parts[2]
+	if _, err := set.Verify(tampered, now); err != ErrInvalidToken {
+		t.Errorf("wanted invalid token, had %v", err)
+		return
+	}
+
+	other, _ := NewKey("k2", nil)
+	if _, err := NewKeySet(other).Verify(signed, now); err != ErrUnknownKey {
+		t.Errorf("wanted unknown key, had %v", err)
+		return
+	}
+}
+
+func TestRemoteKeySet(t *testing.T) {
+	oldKey, _ := NewKey("k1", nil)
+	newKey, _ := NewKey("k2", nil)
+
+	published := []Key{oldKey}
+	fetches := 0
+	remote := NewRemoteKeySet(func(ctx context.Context) ([]Key, error) {
+		fetches++
+		return published, nil
+	}, time.Hour)
+	remote.minRefetch = 0
+
+	signed, _ := Sign(oldKey, Claims{Subject: "l1", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	if _, err := remote.Verify(context.Background(), signed); err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	published = []Key{newKey, oldKey}
+	signed, _ = Sign(newKey, Claims{Subject: "l1", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	if _, err := remote.Verify(context.Background(), signed); err != nil {
+		t.Errorf("wanted rotated key fetched, had %s", err)
+		return
+	}
+
+	if fetches != 2 {
+		t.Errorf("wanted 2 fetches, had %d", fetches)
+		return
+	}
+}
+
+func TestRemoteKeySetSlowFetch(t *testing.T) {
+	key, _ := NewKey("k1", nil)
+	unknown, _ := NewKey("k2", nil)
+
+	release := make(chan struct{})
+	var fetches atomic.Int32
+	remote := NewRemoteKeySet(func(ctx context.Context) ([]Key, error) {
+		if fetches.Add(1) > 1 {
+			<-release
+		}
+		return []Key{key}, nil
+	}, time.Hour)
+	remote.minRefetch = 0
+
+	signed, _ := Sign(key, Claims{Subject: "l1", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	if _, err := remote.Verify(context.Background(), signed); err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	stranger, _ := Sign(unknown, Claims{Subject: "l2", ExpiresAt: time.Now().Add(time.Minute).Unix()})
+	blocked := make(chan struct{})
+	go func() {
+		defer close(blocked)
+		remote.Verify(context.Background(), stranger)
+	}()
+
+	verified := make(chan error)
+	go func() {
+		for fetches.Load() < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		_, err := remote.Verify(context.Background(), signed)
+		verified <- err
+	}()
+
+	select {
+	case err := <-verified:
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("verify waited for a slow fetch of the keys")
+	}
+
+	close(release)
+	<-blocked
+}